@@ -0,0 +1,137 @@
+// internal/analyzer/adversaries.go
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// maxRevertedAuthorLookups bounds the revisions fetched to find whom the target
+// reverted, when the edit summary does not name the reverted user
+const maxRevertedAuthorLookups = 20
+
+// revertedUserPattern matches the user link MediaWiki puts in rollback and undo
+// summaries, "[[Special:Contributions/Name|Name]]", under the local namespace name
+var revertedUserPattern = regexp.MustCompile(`\[\[(?:Special|Spécial|Spezial|Especial):(?:Contributions|Beiträge|Contribuciones)/([^|\]]+)`)
+
+// GetAdversaries ranks the users who revert username most, and checks whether
+// username reverts them back. The revoked contributions analysis cannot be skipped:
+// it is where the reverters come from.
+func (ua *UserAnalyzer) GetAdversaries(username string, config RevokedAnalysisConfig) (*models.AdversaryReport, error) {
+	profile, err := ua.GetUserProfileWithConfig(username, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions, err := ua.fetchContributions(profile.Username)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve contributions: %w", err)
+	}
+
+	report := &models.AdversaryReport{
+		Username:     profile.Username,
+		SampleSize:   profile.SampleSize,
+		RevokedCount: profile.RevokedCount,
+		Language:     ua.client.Language(),
+		RetrievedAt:  time.Now(),
+	}
+	report.Adversaries = rankAdversaries(profile.RevokedContribs, ua.findRevertedUsers(profile.Username, contributions))
+	for _, adversary := range report.Adversaries {
+		if adversary.Reciprocal {
+			report.ReciprocalCount++
+		}
+	}
+
+	return report, nil
+}
+
+// findRevertedUsers counts, per user, the reverts username made of their edits.
+// The reverted user is read from the edit summary, or else is the author of the
+// revision the revert replaced.
+func (ua *UserAnalyzer) findRevertedUsers(username string, contributions []models.WikiContribution) map[string]int {
+	username = utils.NormalizeUsername(username)
+	reverted := make(map[string]int)
+	lookups := 0
+
+	for _, contrib := range contributions {
+		if revertTypeFromTags(contrib.Tags) == "" && !ua.detectRevert(contrib.Comment) {
+			continue
+		}
+
+		revertedUser := ""
+		if match := revertedUserPattern.FindStringSubmatch(contrib.Comment); match != nil {
+			revertedUser = match[1]
+		} else if contrib.ParentID > 0 && lookups < maxRevertedAuthorLookups {
+			lookups++
+			parent, err := ua.client.GetRevisionInfo(contrib.ParentID, "")
+			if err != nil {
+				logf("⚠️ [USER ANALYZER] Unable to retrieve revision %d: %v\n", contrib.ParentID, err)
+				continue
+			}
+			// A revision-deleted author is no one in particular
+			if parent.UserHidden {
+				continue
+			}
+			revertedUser = parent.User
+		}
+
+		revertedUser = utils.NormalizeUsername(revertedUser)
+		if revertedUser == "" || revertedUser == username {
+			continue
+		}
+		reverted[revertedUser]++
+	}
+
+	return reverted
+}
+
+// rankAdversaries builds the adversary list from the target's revoked contributions,
+// most reverts first, and marks the reverters the target reverts in turn
+func rankAdversaries(revoked []models.RevokedContribution, revertedUsers map[string]int) []models.Adversary {
+	adversaries := make(map[string]*models.Adversary)
+	for _, contrib := range revoked {
+		// Light and tag-based detections only know a revert happened, not who made it
+		if contrib.RevokedBy == "detected" || contrib.RevokedBy == "system_detected" {
+			continue
+		}
+		name := utils.NormalizeUsername(contrib.RevokedBy)
+		if name == "" {
+			continue
+		}
+
+		adversary, exists := adversaries[name]
+		if !exists {
+			adversary = &models.Adversary{Username: name}
+			adversaries[name] = adversary
+		}
+		adversary.RevertCount++
+		if !utils.Contains(adversary.Pages, contrib.PageTitle) {
+			adversary.Pages = append(adversary.Pages, contrib.PageTitle)
+		}
+		if contrib.RevokedAt.After(adversary.LastRevert) {
+			adversary.LastRevert = contrib.RevokedAt
+		}
+	}
+
+	ranked := make([]models.Adversary, 0, len(adversaries))
+	for name, adversary := range adversaries {
+		adversary.RevertRatio = float64(adversary.RevertCount) / float64(len(revoked))
+		adversary.RevertedBack = revertedUsers[name]
+		adversary.Reciprocal = adversary.RevertedBack > 0
+		ranked = append(ranked, *adversary)
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].RevertCount != ranked[j].RevertCount {
+			return ranked[i].RevertCount > ranked[j].RevertCount
+		}
+		return ranked[i].Username < ranked[j].Username
+	})
+
+	return ranked
+}
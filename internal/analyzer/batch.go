@@ -0,0 +1,136 @@
+// internal/analyzer/batch.go
+package analyzer
+
+import (
+	"errors"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+)
+
+// Batch entity statuses recorded in a manifest
+const (
+	BatchCompleted = "completed"
+	BatchFailed    = "failed"
+)
+
+// defaultBatchRetryDelay is the wait before the first retry of a failed entity,
+// doubled before each further one
+const defaultBatchRetryDelay = 2 * time.Second
+
+// BatchManifest records the outcome of each entity of a batch, so that an
+// interrupted or partly failed sweep is resumed instead of started over
+type BatchManifest struct {
+	Kind      string                  `json:"kind"`
+	Language  string                  `json:"language"`
+	UpdatedAt time.Time               `json:"updated_at"`
+	Entities  map[string]*BatchEntity `json:"entities"`
+}
+
+// BatchEntity is the manifest entry of one user or page
+type BatchEntity struct {
+	Status    string    `json:"status"`
+	Attempts  int       `json:"attempts"`
+	Error     string    `json:"error,omitempty"`
+	Output    string    `json:"output,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BatchOptions configures RunBatch
+type BatchOptions struct {
+	Retries    int                                                       // Extra attempts for an entity whose processing fails
+	RetryDelay time.Duration                                             // Wait before the first retry, doubled before each further one (default: 2s)
+	Checkpoint func(manifest *BatchManifest) error                       // Persists the manifest after each processed entity, may be nil
+	Progress   func(index, total int, entity string, entry *BatchEntity) // Called after each processed entity, may be nil
+}
+
+// BatchSummary counts what a batch run did
+type BatchSummary struct {
+	Completed int
+	Failed    int
+	Skipped   int // Already completed in the manifest
+}
+
+// NewBatchManifest starts an empty manifest for a batch of kind entities (users,
+// pages) on language's wiki
+func NewBatchManifest(kind, language string) *BatchManifest {
+	return &BatchManifest{
+		Kind:     kind,
+		Language: language,
+		Entities: make(map[string]*BatchEntity),
+	}
+}
+
+// retryableBatchError reports whether another attempt at an entity could
+// succeed where err failed
+func retryableBatchError(err error) bool {
+	return !errors.Is(err, ErrNoData) &&
+		!errors.Is(err, client.ErrBudgetExhausted) &&
+		!errors.Is(err, client.ErrDryRun)
+}
+
+// RunBatch processes entities in order, recording each outcome in the manifest
+// and checkpointing it after every entity: a crash loses at most the entity in
+// progress. Entities the manifest already records as completed are skipped, and
+// failed ones are tried again. process returns the path of the entity's saved
+// result. Failures are retried Retries times with a growing delay, except
+// ErrNoData, an exhausted API budget and a dry run, which another attempt would
+// not change; they are then recorded and left for a later run. Only a failed checkpoint stops the batch.
+func RunBatch(entities []string, manifest *BatchManifest, options BatchOptions, process func(entity string) (string, error)) (*BatchSummary, error) {
+	summary := &BatchSummary{}
+	retryDelay := options.RetryDelay
+	if retryDelay <= 0 {
+		retryDelay = defaultBatchRetryDelay
+	}
+
+	for i, entity := range entities {
+		entry, exists := manifest.Entities[entity]
+		if exists && entry.Status == BatchCompleted {
+			summary.Skipped++
+			continue
+		}
+		if !exists {
+			entry = &BatchEntity{}
+			manifest.Entities[entity] = entry
+		}
+
+		var output string
+		var err error
+		delay := retryDelay
+		for attempt := 0; attempt <= options.Retries; attempt++ {
+			if attempt > 0 {
+				time.Sleep(delay)
+				delay *= 2
+			}
+			entry.Attempts++
+			if output, err = process(entity); err == nil || !retryableBatchError(err) {
+				break
+			}
+		}
+
+		entry.UpdatedAt = time.Now()
+		if err != nil {
+			entry.Status = BatchFailed
+			entry.Error = err.Error()
+			entry.Output = ""
+			summary.Failed++
+		} else {
+			entry.Status = BatchCompleted
+			entry.Error = ""
+			entry.Output = output
+			summary.Completed++
+		}
+
+		manifest.UpdatedAt = entry.UpdatedAt
+		if options.Checkpoint != nil {
+			if err := options.Checkpoint(manifest); err != nil {
+				return summary, err
+			}
+		}
+		if options.Progress != nil {
+			options.Progress(i+1, len(entities), entity, entry)
+		}
+	}
+
+	return summary, nil
+}
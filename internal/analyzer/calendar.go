@@ -0,0 +1,37 @@
+// internal/analyzer/calendar.go
+package analyzer
+
+import (
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// BuildActivityCalendar counts a user's contributions per UTC day, every day
+// from the first contribution to the last, inactive days included, as heatmaps
+// expect
+func BuildActivityCalendar(contributions []models.Contribution) []models.DailyActivity {
+	if len(contributions) == 0 {
+		return []models.DailyActivity{}
+	}
+
+	counts := make(map[time.Time]int)
+	var first, last time.Time
+	for _, contrib := range contributions {
+		timestamp := contrib.Timestamp.UTC()
+		day := time.Date(timestamp.Year(), timestamp.Month(), timestamp.Day(), 0, 0, 0, 0, time.UTC)
+		counts[day]++
+		if first.IsZero() || day.Before(first) {
+			first = day
+		}
+		if day.After(last) {
+			last = day
+		}
+	}
+
+	var calendar []models.DailyActivity
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		calendar = append(calendar, models.DailyActivity{Date: day, EditCount: counts[day]})
+	}
+	return calendar
+}
@@ -0,0 +1,201 @@
+// internal/analyzer/coi.go
+package analyzer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Conflict of interest thresholds
+const (
+	coiMinEdits            = 5   // Entity edits needed before the concentration means anything
+	coiMinRatio            = 0.8 // Share of all edits made to the entity and its related pages
+	coiMinPromotionalEdits = 2   // Sampled edits adding promotional wording
+	maxCOISample           = 10  // Entity article edits whose added text is checked for tone
+)
+
+// promotionalPattern matches the peacock terms, the wording typical of edits
+// made on behalf of the subject
+var promotionalPattern = buildPromotionalPattern(peacockTerms)
+
+func buildPromotionalPattern(terms []string) *regexp.Regexp {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = regexp.QuoteMeta(term)
+	}
+	return regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// findPromotionalTerms lists the distinct promotional terms found in text
+func findPromotionalTerms(text string) []string {
+	var terms []string
+	for _, match := range promotionalPattern.FindAllString(text, -1) {
+		term := strings.ToLower(match)
+		if !utils.Contains(terms, term) {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// coiSubject returns the entity a contribution is about: the title without its
+// namespace prefix and subpage, so "Talk:Acme Corp" and "Draft:Acme Corp/History"
+// are both about "Acme Corp". User pages are about the editor, not an entity.
+func coiSubject(contrib models.WikiContribution) string {
+	if contrib.NS == 2 || contrib.NS == 3 {
+		return ""
+	}
+
+	title := contrib.Title
+	if contrib.NS != 0 {
+		if colon := strings.Index(title, ":"); colon >= 0 {
+			title = title[colon+1:]
+		}
+	}
+	if slash := strings.Index(title, "/"); slash > 0 {
+		title = title[:slash]
+	}
+	return strings.TrimSpace(title)
+}
+
+// isAboutEntity reports whether a subject is the entity or a page named after it
+// ("Acme Corp products"). Short entity names only match exactly.
+func isAboutEntity(subject, entity string) bool {
+	if subject == "" {
+		return false
+	}
+	if subject == entity {
+		return true
+	}
+	return utf8.RuneCountInString(entity) >= 4 && strings.Contains(subject, entity)
+}
+
+// addedText returns the lines of child that are absent from parent
+func addedText(parentText, childText string) string {
+	parentLines := make(map[string]bool)
+	for _, line := range strings.Split(parentText, "\n") {
+		parentLines[line] = true
+	}
+
+	var added []string
+	for _, line := range strings.Split(childText, "\n") {
+		if !parentLines[line] {
+			added = append(added, line)
+		}
+	}
+	return strings.Join(added, "\n")
+}
+
+// analyzeConflictOfInterest looks for a user whose edits center on a single entity
+// page (with its talk page, subpages and pages named after it), then checks a
+// sample of their article edits to it for promotional wording. It returns nil for
+// users whose edits are not concentrated on one entity.
+func (ua *UserAnalyzer) analyzeConflictOfInterest(username string, contributions []models.WikiContribution) *models.ConflictOfInterest {
+	editsBySubject := make(map[string]int)
+	for _, contrib := range contributions {
+		if subject := coiSubject(contrib); subject != "" {
+			editsBySubject[subject]++
+		}
+	}
+
+	entity := ""
+	for subject, count := range editsBySubject {
+		if count > editsBySubject[entity] || (count == editsBySubject[entity] && subject < entity) {
+			entity = subject
+		}
+	}
+	if entity == "" {
+		return nil
+	}
+
+	coi := &models.ConflictOfInterest{
+		Entity:     entity,
+		TotalEdits: len(contributions),
+	}
+
+	var entityContribs []models.WikiContribution
+	for _, contrib := range contributions {
+		if !isAboutEntity(coiSubject(contrib), entity) {
+			continue
+		}
+		coi.EntityEdits++
+		entityContribs = append(entityContribs, contrib)
+		if contrib.Title != entity && !utils.Contains(coi.RelatedPages, contrib.Title) {
+			coi.RelatedPages = append(coi.RelatedPages, contrib.Title)
+		}
+	}
+	sort.Strings(coi.RelatedPages)
+	coi.Ratio = float64(coi.EntityEdits) / float64(coi.TotalEdits)
+
+	if coi.EntityEdits < coiMinEdits || coi.Ratio < coiMinRatio {
+		return nil
+	}
+
+	// "John Smith (actor)" edited by John Smith
+	baseEntity := entity
+	if paren := strings.Index(baseEntity, " ("); paren > 0 {
+		baseEntity = baseEntity[:paren]
+	}
+	coi.MatchesUsername = utils.NormalizeUsername(baseEntity) == utils.NormalizeUsername(username)
+
+	ua.sampleCOITone(coi, entityContribs)
+
+	return coi
+}
+
+// sampleCOITone fetches a sample of the user's article edits to the entity with
+// their parents and counts those whose added text reads as promotional
+func (ua *UserAnalyzer) sampleCOITone(coi *models.ConflictOfInterest, entityContribs []models.WikiContribution) {
+	var sample []models.WikiContribution
+	for _, contrib := range entityContribs {
+		if contrib.NS != 0 {
+			continue
+		}
+		sample = append(sample, contrib)
+		if len(sample) >= maxCOISample {
+			break
+		}
+	}
+	if len(sample) == 0 {
+		return
+	}
+
+	revisionIDs := make([]int, 0, len(sample)*2)
+	for _, contrib := range sample {
+		revisionIDs = append(revisionIDs, contrib.RevID)
+		if contrib.ParentID != 0 {
+			revisionIDs = append(revisionIDs, contrib.ParentID)
+		}
+	}
+
+	contents, err := ua.client.GetRevisionsContent(revisionIDs)
+	if err != nil {
+		return
+	}
+
+	for _, contrib := range sample {
+		childText, exists := contents[contrib.RevID]
+		if !exists {
+			continue
+		}
+		// Page creations have no parent: all their text is added
+		parentText := contents[contrib.ParentID]
+
+		coi.SampledEdits++
+		terms := findPromotionalTerms(addedText(parentText, childText))
+		if len(terms) == 0 {
+			continue
+		}
+		coi.PromotionalEdits++
+		for _, term := range terms {
+			if !utils.Contains(coi.PromotionalTerms, term) {
+				coi.PromotionalTerms = append(coi.PromotionalTerms, term)
+			}
+		}
+	}
+}
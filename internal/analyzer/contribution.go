@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/intMeric/wikipedia-analyser/internal/client"
@@ -14,14 +15,29 @@ import (
 
 // ContributionAnalyzer analyzes Wikipedia contributions/revisions
 type ContributionAnalyzer struct {
-	client        *client.WikipediaClient
-	analysisDepth string
+	client            *client.WikipediaClient
+	analysisDepth     string
+	maxAuthorProfiles int                            // Budget of full author profiles for this analyzer
+	maxNestedPages    int                            // Pages scanned for reverts per author profile
+	authorProfiles    map[string]*authorProfileEntry // Authors already profiled (no re-analysis)
+	profileLookups    int                            // Full author profiles fetched so far
+	profilesMu        sync.Mutex                     // Guards authorProfiles and profileLookups for concurrent scans
+}
+
+// authorProfileEntry is an author's profile, fetched once even when several
+// contributions by the same author are analyzed concurrently
+type authorProfileEntry struct {
+	once    sync.Once
+	profile *models.UserProfile
+	err     error
 }
 
 type ContributionAnalysisOptions struct {
-	AnalysisDepth  string // "basic", "standard", "deep"
-	IncludeContent bool
-	IncludeContext bool
+	AnalysisDepth     string // "basic", "standard", "deep"
+	IncludeContent    bool
+	IncludeContext    bool
+	MaxAuthorProfiles int // Maximum number of full author profiles fetched (default 25)
+	MaxNestedPages    int // Maximum pages scanned for reverts per author profile (default 3)
 }
 
 // NewContributionAnalyzer creates a new contribution analyzer
@@ -32,18 +48,40 @@ func NewContributionAnalyzer(client *client.WikipediaClient, options Contributio
 	}
 
 	return &ContributionAnalyzer{
-		client:        client,
-		analysisDepth: depth,
+		client:            client,
+		analysisDepth:     depth,
+		maxAuthorProfiles: utils.SetOrDefault(options.MaxAuthorProfiles, 25),
+		maxNestedPages:    utils.SetOrDefault(options.MaxNestedPages, 3),
+		authorProfiles:    make(map[string]*authorProfileEntry),
 	}
 }
 
 // GetContributionProfile retrieves and analyzes a complete contribution profile
 func (ca *ContributionAnalyzer) GetContributionProfile(revisionID int, pageTitle string) (*models.ContributionProfile, error) {
+	// Revision links without a title (?oldid=N, Special:Diff/N): find the page
+	if pageTitle == "" {
+		title, err := ca.client.GetRevisionPageTitle(revisionID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find the page of revision %d: %w", revisionID, err)
+		}
+		pageTitle = title
+	}
+
+	// Revision 0 stands for the latest revision of the page
+	if revisionID == 0 {
+		latest, err := ca.client.GetRevisionInfo(0, pageTitle)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve the latest revision of %s: %w", pageTitle, err)
+		}
+		revisionID = latest.RevID
+	}
+
 	// 1. Get page revisions to find our specific revision
 	revisions, err := ca.client.GetPageRevisions(pageTitle, 500)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve page revisions: %w", err)
 	}
+	revisions = ensureChronologicalOrder(revisions, true, pageTitle)
 
 	// Find the specific revision
 	var targetRevision *models.WikiRevision
@@ -73,7 +111,8 @@ func (ca *ContributionAnalyzer) GetContributionProfile(revisionID int, pageTitle
 		Comment:     targetRevision.Comment,
 		Size:        targetRevision.Size,
 		IsMinor:     targetRevision.Minor == "true",
-		IsRevert:    ca.detectRevert(targetRevision.Comment),
+		IsRevert:    ca.isRevertRevision(*targetRevision),
+		Tags:        targetRevision.Tags,
 		RetrievedAt: time.Now(),
 	}
 
@@ -83,27 +122,68 @@ func (ca *ContributionAnalyzer) GetContributionProfile(revisionID int, pageTitle
 		profile.Timestamp = timestamp
 	}
 
+	// Time taken to revert: seconds mean a patrolling tool, not a human review
+	if profile.IsRevert {
+		revisionsByID := make(map[int]models.WikiRevision, len(revisions))
+		for _, rev := range revisions {
+			revisionsByID[rev.RevID] = rev
+		}
+		if delay, known := revertDelay(*targetRevision, revisionsByID); known {
+			profile.RevertDelaySeconds = int(delay.Seconds())
+			profile.ToolAssistedRevert = delay < toolAssistedRevertDelay
+		}
+	}
+
 	// 4. Analyze author
 	profile.Author, err = ca.analyzeAuthor(*targetRevision)
 	if err != nil {
 		return nil, fmt.Errorf("unable to analyze author: %w", err)
 	}
 
+	var coverage coverageReport
+	switch {
+	case profile.Author.IsHidden:
+		coverage.skipped("Author profile", "author hidden by revision deletion")
+	case profile.Author.IsAnonymous:
+		coverage.skipped("Author profile", "anonymous editor")
+	case profile.Author.ProfileError != "":
+		coverage.unavailable("Author profile", profile.Author.ProfileError)
+	case profile.Author.ProfileSkipped:
+		coverage.skipped("Author profile", "author profile budget exhausted")
+	default:
+		coverage.ran("Author profile")
+	}
+
 	// 5. Get content analysis if requested
 	if ca.analysisDepth == "standard" || ca.analysisDepth == "deep" {
 		profile.ContentAnalysis = ca.analyzeContentFromRevision(*targetRevision, revisions)
+		coverage.ran("Content analysis")
+		switch {
+		case profile.ContentAnalysis.TextChanges.ContentCompared:
+			coverage.ran("Text comparison with parent")
+		case targetRevision.ParentID == 0:
+			coverage.skipped("Text comparison with parent", "page creation")
+		default:
+			coverage.unavailable("Text comparison with parent", "revision content hidden or not retrieved, sizes used instead")
+		}
+	} else {
+		coverage.skipped("Content analysis", "basic depth")
 	}
 
 	// 6. Analyze context if deep analysis requested
 	if ca.analysisDepth == "deep" {
 		profile.ContextAnalysis = ca.analyzeContext(*targetRevision, *pageInfo, revisions)
+		coverage.ran("Context analysis")
+	} else {
+		coverage.skipped("Context analysis", "deep depth only")
 	}
+	profile.Coverage = coverage.result(ca.client)
 
 	// 7. Calculate quality metrics
 	profile.QualityMetrics = ca.analyzeQuality(profile)
 
 	// 8. Calculate suspicion score
-	profile.SuspicionScore, profile.SuspicionFlags = ca.calculateSuspicionScore(profile)
+	profile.SuspicionScore, profile.SuspicionFlags, profile.ScoreBreakdown = ca.calculateSuspicionScore(profile)
 
 	return profile, nil
 }
@@ -117,6 +197,12 @@ func (ca *ContributionAnalyzer) analyzeAuthor(revision models.WikiRevision) (mod
 		IsRegistered: revision.UserID > 0,
 	}
 
+	// Revision-deleted author: nothing can be looked up
+	if revision.UserHidden {
+		author.IsHidden = true
+		return author, nil
+	}
+
 	// Skip detailed analysis for anonymous users
 	if author.IsAnonymous {
 		return author, nil
@@ -131,13 +217,8 @@ func (ca *ContributionAnalyzer) analyzeAuthor(revision models.WikiRevision) (mod
 	author.EditCount = userInfo.EditCount
 	author.Groups = userInfo.Groups
 
-	// Parse registration date if available
-	if userInfo.Registration != "" {
-		regDate, err := time.Parse("2006-01-02T15:04:05Z", userInfo.Registration)
-		if err == nil {
-			author.RegistrationDate = &regDate
-		}
-	}
+	// Parse registration date (estimated from first edit for very old accounts)
+	author.RegistrationDate, author.RegistrationEst = resolveRegistrationDate(ca.client, userInfo)
 
 	// Check if user is blocked
 	author.IsBlocked = userInfo.BlockedBy != ""
@@ -146,15 +227,59 @@ func (ca *ContributionAnalyzer) analyzeAuthor(revision models.WikiRevision) (mod
 	author.RecentActivity = ca.analyzeRecentUserActivity(revision.User)
 
 	// Calculate basic author suspicion score
-	userAnalyzer := NewUserAnalyzer(ca.client)
-	userProfile, err := userAnalyzer.GetUserProfile(revision.User)
-	if err == nil {
+	userProfile, err := ca.getAuthorProfile(revision.User)
+	switch {
+	case err != nil:
+		author.ProfileError = err.Error()
+	case userProfile != nil:
 		author.SuspicionScore = userProfile.SuspicionScore
+	default:
+		author.ProfileSkipped = true
 	}
 
 	return author, nil
 }
 
+// getAuthorProfile returns the author's user profile, bounded by the analyzer's budget:
+// nil and no error once the budget is spent. Each author is profiled at most once, and
+// the nested revert analysis is kept shallow so analyzing many contributions does not
+// re-score whole pages transitively.
+func (ca *ContributionAnalyzer) getAuthorProfile(username string) (*models.UserProfile, error) {
+	ca.profilesMu.Lock()
+	entry, exists := ca.authorProfiles[username]
+	if !exists {
+		if ca.profileLookups >= ca.maxAuthorProfiles {
+			ca.profilesMu.Unlock()
+			return nil, nil
+		}
+		ca.profileLookups++
+		entry = &authorProfileEntry{}
+		ca.authorProfiles[username] = entry
+	}
+	ca.profilesMu.Unlock()
+
+	// Cache failures too, so a missing user is not retried
+	entry.once.Do(func() {
+		entry.profile, entry.err = ca.fetchAuthorProfile(username)
+	})
+	return entry.profile, entry.err
+}
+
+// fetchAuthorProfile runs the user analysis behind getAuthorProfile
+func (ca *ContributionAnalyzer) fetchAuthorProfile(username string) (*models.UserProfile, error) {
+	// Basic depth skips the nested revert analysis entirely
+	var nestedConfig *RevokedAnalysisConfig
+	if ca.analysisDepth != "basic" {
+		config := GetDefaultRevokedAnalysisConfig()
+		config.MaxPagesToAnalyze = utils.Min(config.MaxPagesToAnalyze, ca.maxNestedPages)
+		config.EnableDeepAnalysis = false
+		nestedConfig = &config
+	}
+
+	userAnalyzer := NewUserAnalyzer(ca.client)
+	return userAnalyzer.GetUserProfileWithConfig(username, nestedConfig)
+}
+
 // analyzeRecentUserActivity analyzes recent activity of the user
 func (ca *ContributionAnalyzer) analyzeRecentUserActivity(username string) models.RecentUserActivity {
 	activity := models.RecentUserActivity{}
@@ -210,13 +335,7 @@ func (ca *ContributionAnalyzer) analyzeContentFromRevision(revision models.WikiR
 	content := models.ContributionContent{}
 
 	// Find parent revision for comparison
-	var parentRevision *models.WikiRevision
-	for _, rev := range allRevisions {
-		if rev.RevID == revision.ParentID {
-			parentRevision = &rev
-			break
-		}
-	}
+	parentRevision := ca.findParentRevision(revision, allRevisions)
 
 	// Basic text analysis from size difference
 	content.TextChanges = models.TextChangeAnalysis{
@@ -228,19 +347,62 @@ func (ca *ContributionAnalyzer) analyzeContentFromRevision(revision models.WikiR
 	content.TextChanges.WordsAdded = content.TextChanges.CharsAdded / 5
 	content.TextChanges.WordsRemoved = content.TextChanges.CharsRemoved / 5
 
-	// Analyze comment for content indicators
-	content.TextChanges.IsStructural = ca.isStructuralEdit(revision.Comment)
-	content.TextChanges.IsTrivial = ca.isTrivialEdit(revision.Comment) ||
+	// Analyze comment for content indicators. A "/* Section */" marker only
+	// names the section edited: "/* Formatting */" says nothing of the change
+	_, summary := utils.SplitEditSummary(revision.Comment)
+	content.TextChanges.IsStructural = ca.isStructuralEdit(summary)
+	content.TextChanges.IsTrivial = ca.isTrivialEdit(summary) ||
 		(content.TextChanges.CharsAdded < 50 && content.TextChanges.CharsRemoved < 50)
 
+	// Compare with the parent content to detect cosmetic-only edits, summaries
+	// that understate the change and single loaded words slipped into the text
+	var addedTextPOVWords []string
+	var diffSections []string
+	if revision.ParentID != 0 {
+		contents, err := ca.client.GetRevisionsContent([]int{revision.RevID, revision.ParentID})
+		if err == nil {
+			childText, childExists := contents[revision.RevID]
+			parentText, parentExists := contents[revision.ParentID]
+			if childExists && parentExists {
+				// Exact word counts replace the estimate
+				content.TextChanges.ContentCompared = true
+				addedWords, removedWords := changedWords(parentText, childText)
+				content.TextChanges.WordsAdded, content.TextChanges.WordsRemoved = len(addedWords), len(removedWords)
+				addedTextPOVWords = addedPOVTerms(addedWords)
+				diffSections = changedSections(parentText, childText)
+
+				if stealthChanges := detectStealthChanges(addedWords, removedWords); len(stealthChanges) > 0 {
+					content.TextChanges.StealthPOV = true
+					content.TextChanges.StealthChanges = stealthChanges
+				}
+
+				content.TextChanges.RemovedMaintenanceTags, content.TextChanges.MaintenanceAddressed = removedMaintenanceTags(parentText, childText)
+
+				if isCosmeticChange(parentText, childText) {
+					content.TextChanges.IsCosmetic = true
+					content.TextChanges.IsTrivial = true
+				} else if isMisleadingSummary(summary, content.TextChanges.WordsAdded, content.TextChanges.WordsRemoved) {
+					content.TextChanges.MisleadingSummary = true
+					content.TextChanges.IsTrivial = false
+				}
+			}
+		}
+	}
+	content.TextChanges.SectionsAffected = sectionsAffected(revision.Comment, diffSections)
+
 	// Basic language analysis
 	content.LanguageAnalysis = models.LanguageAnalysis{
 		Language:     ca.client.Language(),
 		ToneAnalysis: "neutral", // Default
 	}
 
-	// Analyze comment for bias indicators
-	content.LanguageAnalysis.POVWords = ca.findPOVWords(revision.Comment)
+	// Analyze comment and added text for bias indicators
+	content.LanguageAnalysis.POVWords = ca.findPOVWords(summary)
+	for _, word := range addedTextPOVWords {
+		if !utils.Contains(content.LanguageAnalysis.POVWords, word) {
+			content.LanguageAnalysis.POVWords = append(content.LanguageAnalysis.POVWords, word)
+		}
+	}
 	content.LanguageAnalysis.BiasScore = float64(len(content.LanguageAnalysis.POVWords)) / 10.0
 
 	if len(content.LanguageAnalysis.POVWords) > 0 {
@@ -248,7 +410,7 @@ func (ca *ContributionAnalyzer) analyzeContentFromRevision(revision models.WikiR
 	}
 
 	// Determine content type
-	content.ContentType = ca.determineContentType(revision.Comment, content.TextChanges)
+	content.ContentType = ca.determineContentType(summary, content.TextChanges)
 
 	return content
 }
@@ -264,8 +426,10 @@ func (ca *ContributionAnalyzer) analyzeContext(revision models.WikiRevision, pag
 	timestamp, _ := time.Parse("2006-01-02T15:04:05Z", revision.Timestamp)
 	context.TimingContext = ca.analyzeTimingContext(timestamp, allRevisions, revision.RevID)
 
-	// Analyze author context
-	context.AuthorContext = ca.analyzeAuthorContext(revision.User)
+	// Analyze author context (not available when the author is revision-deleted)
+	if !revision.UserHidden {
+		context.AuthorContext = ca.analyzeAuthorContext(revision.User)
+	}
 
 	// Find related edits
 	context.RelatedEdits = ca.findRelatedEdits(revision, allRevisions)
@@ -441,7 +605,7 @@ func (ca *ContributionAnalyzer) analyzeConflictContext(revision models.WikiRevis
 	context := models.ConflictContextInfo{}
 
 	// Check if this edit is a revert
-	if ca.detectRevert(revision.Comment) {
+	if ca.isRevertRevision(revision) {
 		context.IsContested = true
 		context.ConflictSeverity = 0.7
 	}
@@ -457,7 +621,7 @@ func (ca *ContributionAnalyzer) analyzeConflictContext(revision models.WikiRevis
 
 		revTime, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
 		if revTime.After(revisionTime.Add(-24*time.Hour)) && revTime.Before(revisionTime.Add(24*time.Hour)) {
-			if ca.detectRevert(rev.Comment) {
+			if ca.isRevertRevision(rev) {
 				recentReverts++
 			}
 		}
@@ -582,74 +746,109 @@ func (ca *ContributionAnalyzer) analyzeCompliance(profile *models.ContributionPr
 }
 
 // calculateSuspicionScore calculates suspicion score and flags
-func (ca *ContributionAnalyzer) calculateSuspicionScore(profile *models.ContributionProfile) (int, []string) {
-	score := 0
-	flags := []string{}
+func (ca *ContributionAnalyzer) calculateSuspicionScore(profile *models.ContributionProfile) (int, []string, *models.ScoreBreakdown) {
+	card := &scoreCard{}
 
 	// Check author suspicion
 	if profile.Author.SuspicionScore > 0 {
-		score += profile.Author.SuspicionScore / 2 // Dilute author score
+		// Dilute author score
+		card.addPoints("AUTHOR_SUSPICION", profile.Author.SuspicionScore/2,
+			fmt.Sprintf("half of the author's suspicion score (%d/100)", profile.Author.SuspicionScore))
 	}
 
 	// Check for reverts
-	if profile.IsRevert {
-		score += 15
-		flags = append(flags, "REVERT_EDIT")
+	if profile.ToolAssistedRevert {
+		card.add("TOOL_ASSISTED_REVERT", 5, fmt.Sprintf("revert made %ds after the reverted edit, with a tool", profile.RevertDelaySeconds))
+	} else if profile.IsRevert {
+		card.add("REVERT_EDIT", 15, "the edit is a revert")
 	}
 
 	// Check for rapid editing
 	if profile.Author.RecentActivity.EditsLast24h > 50 {
-		score += 20
-		flags = append(flags, "RAPID_EDITING")
+		card.add("RAPID_EDITING", 20, fmt.Sprintf("%d author edits in the last 24h", profile.Author.RecentActivity.EditsLast24h))
 	}
 
 	// Check for anonymous editing
 	if profile.Author.IsAnonymous {
-		score += 5
-		flags = append(flags, "ANONYMOUS_EDIT")
+		card.add("ANONYMOUS_EDIT", 5, "edit made from an IP address")
 	}
 
 	// Check for new account
 	if profile.Author.RegistrationDate != nil {
 		daysSinceReg := int(time.Since(*profile.Author.RegistrationDate).Hours() / 24)
 		if daysSinceReg < 7 {
-			score += 15
-			flags = append(flags, "NEW_ACCOUNT")
+			card.add("NEW_ACCOUNT", 15, fmt.Sprintf("account %d days old", daysSinceReg))
 		}
 	}
 
 	// Check for bias indicators
 	if profile.ContentAnalysis.LanguageAnalysis.BiasScore > 0.3 {
-		score += 10
-		flags = append(flags, "POTENTIAL_BIAS")
+		card.add("POTENTIAL_BIAS", 10, fmt.Sprintf("bias score %.2f", profile.ContentAnalysis.LanguageAnalysis.BiasScore))
 	}
 
 	// Check for large content changes
 	if profile.ContentAnalysis.TextChanges.CharsAdded > 5000 {
-		score += 10
-		flags = append(flags, "LARGE_ADDITION")
+		card.add("LARGE_ADDITION", 10, fmt.Sprintf("%d characters added", profile.ContentAnalysis.TextChanges.CharsAdded))
 	}
 	if profile.ContentAnalysis.TextChanges.CharsRemoved > 2000 {
-		score += 15
-		flags = append(flags, "LARGE_REMOVAL")
+		card.add("LARGE_REMOVAL", 15, fmt.Sprintf("%d characters removed", profile.ContentAnalysis.TextChanges.CharsRemoved))
 	}
 
 	// Check for blocked user
 	if profile.Author.IsBlocked {
-		score += 25
-		flags = append(flags, "BLOCKED_USER")
+		card.add("BLOCKED_USER", 25, "author currently blocked")
 	}
 
-	// Limit score to 100
-	if score > 100 {
-		score = 100
+	// Check for cosmetic-only edit (rendered text unchanged)
+	if profile.ContentAnalysis.TextChanges.IsCosmetic {
+		card.add("COSMETIC_ONLY", 5, "rendered text unchanged")
 	}
 
-	return score, flags
+	// Check for a trivial-sounding summary hiding a substantive change
+	if profile.ContentAnalysis.TextChanges.MisleadingSummary {
+		changes := profile.ContentAnalysis.TextChanges
+		card.add("MISLEADING_SUMMARY", 20, fmt.Sprintf("typo/format summary, %d words added and %d removed",
+			changes.WordsAdded, changes.WordsRemoved))
+	}
+
+	// Check for a tiny diff changing a loaded word, a negation or a figure
+	if profile.ContentAnalysis.TextChanges.StealthPOV {
+		card.add("STEALTH_POV", 20, strings.Join(profile.ContentAnalysis.TextChanges.StealthChanges, "; "))
+	}
+
+	// Check for maintenance tags removed without fixing the issue they point out
+	if changes := profile.ContentAnalysis.TextChanges; len(changes.RemovedMaintenanceTags) > 0 && !changes.MaintenanceAddressed {
+		card.add("MAINTENANCE_TAG_REMOVAL", 15, fmt.Sprintf("removed {{%s}} without adding references or rewriting the text",
+			strings.Join(changes.RemovedMaintenanceTags, "}}, {{")))
+	}
+
+	return card.result()
 }
 
 // Helper functions
 
+// findParentRevision returns the parent of a revision, taken from the fetched
+// revisions or else fetched by ID: the fetched window does not reach back to the
+// parent of older revisions. Page creations have no parent: nil is returned.
+func (ca *ContributionAnalyzer) findParentRevision(revision models.WikiRevision, allRevisions []models.WikiRevision) *models.WikiRevision {
+	if revision.ParentID == 0 {
+		return nil
+	}
+
+	for _, rev := range allRevisions {
+		if rev.RevID == revision.ParentID {
+			return &rev
+		}
+	}
+
+	parentRevision, err := ca.client.GetRevisionInfo(revision.ParentID, "")
+	if err != nil {
+		logf("⚠️ [CONTRIBUTION ANALYZER] Unable to retrieve parent revision %d: %v\n", revision.ParentID, err)
+		return nil
+	}
+	return parentRevision
+}
+
 // getParentSize returns the size of parent revision or 0 if not found
 func getParentSize(parentRevision *models.WikiRevision) int {
 	if parentRevision == nil {
@@ -658,6 +857,14 @@ func getParentSize(parentRevision *models.WikiRevision) int {
 	return parentRevision.Size
 }
 
+// isRevertRevision checks if a revision is a revert, preferring change tags over comment keywords
+func (ca *ContributionAnalyzer) isRevertRevision(revision models.WikiRevision) bool {
+	if revertTypeFromTags(revision.Tags) != "" {
+		return true
+	}
+	return ca.detectRevert(revision.Comment)
+}
+
 // detectRevert checks if a comment indicates a revert
 func (ca *ContributionAnalyzer) detectRevert(comment string) bool {
 	comment = strings.ToLower(comment)
@@ -710,12 +917,6 @@ func (ca *ContributionAnalyzer) isTrivialEdit(comment string) bool {
 func (ca *ContributionAnalyzer) findPOVWords(text string) []string {
 	var povWords []string
 
-	// Common POV indicators
-	povIndicators := []string{
-		"obviously", "clearly", "undoubtedly", "best", "worst",
-		"always", "never", "perfect", "terrible", "amazing",
-	}
-
 	textLower := strings.ToLower(text)
 	for _, word := range povIndicators {
 		if strings.Contains(textLower, word) {
@@ -730,12 +931,21 @@ func (ca *ContributionAnalyzer) findPOVWords(text string) []string {
 func (ca *ContributionAnalyzer) determineContentType(comment string, changes models.TextChangeAnalysis) string {
 	comment = strings.ToLower(comment)
 
+	if changes.IsCosmetic {
+		return "cosmetic_only"
+	}
+	if changes.MisleadingSummary {
+		return "content_edit"
+	}
 	if strings.Contains(comment, "typo") || strings.Contains(comment, "spelling") {
 		return "typo_fix"
 	}
 	if strings.Contains(comment, "source") || strings.Contains(comment, "reference") {
 		return "source_addition"
 	}
+	if onlyReferenceSections(changes.SectionsAffected) {
+		return "source_addition"
+	}
 	if changes.IsStructural {
 		return "structural_change"
 	}
@@ -748,7 +958,7 @@ func (ca *ContributionAnalyzer) determineContentType(comment string, changes mod
 
 // determineRelation determines the relationship between two revisions
 func (ca *ContributionAnalyzer) determineRelation(rev1, rev2 models.WikiRevision) string {
-	if ca.detectRevert(rev2.Comment) && strings.Contains(rev2.Comment, fmt.Sprintf("%d", rev1.RevID)) {
+	if ca.isRevertRevision(rev2) && strings.Contains(rev2.Comment, fmt.Sprintf("%d", rev1.RevID)) {
 		return "revert"
 	}
 	if rev1.User == rev2.User {
@@ -0,0 +1,124 @@
+// internal/analyzer/cosmetic.go
+package analyzer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// maxCosmeticSample bounds the number of recent edits whose content is compared
+// against their parent: revisions and parents come in a single request of 20
+const maxCosmeticSample = 10
+
+// minCosmeticSample is the number of compared edits the inflation flag needs
+const minCosmeticSample = 8
+
+var (
+	// htmlCommentPattern matches hidden comments, which never render
+	htmlCommentPattern = regexp.MustCompile(`(?s)<!--.*?-->`)
+	// categoryLinkPattern matches category links, whose order does not affect rendering
+	categoryLinkPattern = regexp.MustCompile(`(?i)\[\[\s*(category|catégorie|kategorie|categoría|categoria)\s*:[^\]]*\]\]`)
+	// markupOpeningPattern matches the spaces after the opening of a template or link
+	markupOpeningPattern = regexp.MustCompile(`(\{\{|\[\[)[ \t]+`)
+	// markupClosingPattern matches the spaces before the closing of a template or link
+	markupClosingPattern = regexp.MustCompile(`[ \t]+(\}\}|\]\])`)
+	// templateParameterPattern matches a named template parameter with its spacing
+	templateParameterPattern = regexp.MustCompile(`\|\s*([^|={}\[\]\n]+?)\s*=[ \t]*`)
+	// lineSpacingPattern matches runs of spaces and tabs
+	lineSpacingPattern = regexp.MustCompile(`[ \t]+`)
+	// trailingSpacePattern matches the spaces ending a line
+	trailingSpacePattern = regexp.MustCompile(`[ \t]+\n`)
+	// blankLinesPattern matches more than one blank line, which render as one
+	blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+	// whitespacePattern matches runs of whitespace
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// normalizeRenderedText reduces wikitext to a form where edits that do not change
+// the rendered page compare equal: hidden comments are dropped, runs of spaces
+// and blank lines are collapsed, spacing inside template and link delimiters and
+// around named template parameters is removed, and categories are sorted. Line
+// breaks and the spacing of the prose itself are kept: they render.
+func normalizeRenderedText(wikitext string) string {
+	text := htmlCommentPattern.ReplaceAllString(wikitext, "")
+
+	categories := categoryLinkPattern.FindAllString(text, -1)
+	text = categoryLinkPattern.ReplaceAllString(text, "")
+	for i, category := range categories {
+		categories[i] = strings.ToLower(whitespacePattern.ReplaceAllString(category, ""))
+	}
+	sort.Strings(categories)
+
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = lineSpacingPattern.ReplaceAllString(text, " ")
+	text = trailingSpacePattern.ReplaceAllString(text, "\n")
+	text = blankLinesPattern.ReplaceAllString(text, "\n\n")
+	text = markupOpeningPattern.ReplaceAllString(text, "$1")
+	text = markupClosingPattern.ReplaceAllString(text, "$1")
+	text = templateParameterPattern.ReplaceAllString(text, "|$1=")
+	text = strings.TrimSpace(text)
+
+	return text + strings.Join(categories, "")
+}
+
+// isCosmeticChange reports whether two revisions differ only by whitespace or
+// markup layout, i.e. the edit leaves the rendered text unchanged
+func isCosmeticChange(parentText, childText string) bool {
+	if parentText == childText {
+		return false
+	}
+	return normalizeRenderedText(parentText) == normalizeRenderedText(childText)
+}
+
+// analyzeCosmeticEdits compares a sample of recent main-namespace edits with their
+// parent revisions and measures how many of them are cosmetic only
+func (ua *UserAnalyzer) analyzeCosmeticEdits(contributions []models.WikiContribution) *models.CosmeticEditStats {
+	var sample []models.WikiContribution
+	for _, contrib := range contributions {
+		if contrib.NS != 0 || contrib.ParentID == 0 {
+			continue
+		}
+		sample = append(sample, contrib)
+		if len(sample) >= maxCosmeticSample {
+			break
+		}
+	}
+
+	if len(sample) == 0 {
+		return nil
+	}
+
+	revisionIDs := make([]int, 0, len(sample)*2)
+	for _, contrib := range sample {
+		revisionIDs = append(revisionIDs, contrib.RevID, contrib.ParentID)
+	}
+
+	contents, err := ua.client.GetRevisionsContent(revisionIDs)
+	if err != nil {
+		return nil
+	}
+
+	stats := &models.CosmeticEditStats{}
+	for _, contrib := range sample {
+		childText, childExists := contents[contrib.RevID]
+		parentText, parentExists := contents[contrib.ParentID]
+		if !childExists || !parentExists {
+			continue
+		}
+
+		stats.SampledEdits++
+		if isCosmeticChange(parentText, childText) {
+			stats.CosmeticEdits++
+		}
+	}
+
+	if stats.SampledEdits == 0 {
+		return nil
+	}
+	stats.CosmeticRatio = float64(stats.CosmeticEdits) / float64(stats.SampledEdits)
+
+	return stats
+}
@@ -0,0 +1,39 @@
+// internal/analyzer/coverage.go
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// coverageReport records which optional analyses ran on an entity, and why the
+// others did not, as scoreCard records the rules behind its score
+type coverageReport struct {
+	checks []models.CoverageCheck
+}
+
+// ran records an analysis that completed
+func (cr *coverageReport) ran(check string) {
+	cr.checks = append(cr.checks, models.CoverageCheck{Check: check, Status: models.CheckRan})
+}
+
+// skipped records an analysis that was not requested or does not apply
+func (cr *coverageReport) skipped(check, reason string) {
+	cr.checks = append(cr.checks, models.CoverageCheck{Check: check, Status: models.CheckSkipped, Reason: reason})
+}
+
+// unavailable records an analysis that was requested but could not run
+func (cr *coverageReport) unavailable(check, reason string) {
+	cr.checks = append(cr.checks, models.CoverageCheck{Check: check, Status: models.CheckUnavailable, Reason: reason})
+}
+
+// result returns the recorded checks, with a last one when the API call budget
+// ran out: requests past it were not sent, whatever check they belonged to
+func (cr *coverageReport) result(wikiClient *client.WikipediaClient) []models.CoverageCheck {
+	if wikiClient.BudgetExhausted() {
+		cr.unavailable("API requests", fmt.Sprintf("call budget exhausted after %d calls, later requests not sent", wikiClient.APICalls()))
+	}
+	return cr.checks
+}
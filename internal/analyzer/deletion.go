@@ -0,0 +1,212 @@
+// internal/analyzer/deletion.go
+package analyzer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Coordinated !voting: coordinatedVoteMinAccounts accounts or more casting the
+// same !vote in a deletion discussion within coordinatedVoteWindow, the trace of
+// off-wiki canvassing
+const (
+	coordinatedVoteWindow      = time.Hour
+	coordinatedVoteMinAccounts = 3
+)
+
+// deletionDiscussionPrefixes are the project-namespace subpage prefixes of
+// deletion discussions, per wiki language
+var deletionDiscussionPrefixes = []string{
+	"Articles for deletion/", "Miscellany for deletion/", "Categories for discussion/",
+	"Templates for discussion/", "Redirects for discussion/",
+	"Pages à supprimer/", "Löschkandidaten/", "Consultas de borrado/",
+}
+
+// votePositions maps the words of a bolded !vote to the position it casts. Words
+// like "strong", "weak" or "speedy" only qualify it.
+var votePositions = map[string]string{
+	"keep": "keep", "delete": "delete", "merge": "merge", "redirect": "redirect",
+	"draftify": "draftify", "userfy": "userfy", "incubate": "draftify",
+	"conserver": "keep", "supprimer": "delete", "fusionner": "merge", "rediriger": "redirect",
+	"behalten": "keep", "löschen": "delete", "mantener": "keep", "borrar": "delete",
+}
+
+var (
+	// voteBoldPattern matches the bolded text of a !vote, '''Keep'''
+	voteBoldPattern = regexp.MustCompile(`'''([^']+)'''`)
+
+	// voteSignaturePattern matches the user link of a signature, under the local
+	// namespace names
+	voteSignaturePattern = regexp.MustCompile(`(?i)\[\[(?:User|User talk|Utilisateur|Discussion utilisateur|Utilisatrice|Benutzer|Benutzerin|Benutzer Diskussion|Usuario|Usuaria|Usuario discusión|Special:Contributions|Spécial:Contributions|Spezial:Beiträge|Especial:Contribuciones)[:/]([^|\]/#]+)`)
+
+	// voteTimestampPattern matches the timestamp of an English signature,
+	// "12:34, 5 March 2024 (UTC)"
+	voteTimestampPattern = regexp.MustCompile(`(\d{1,2}:\d{2}), (\d{1,2} [A-Z][a-z]+ \d{4}) \(UTC\)`)
+)
+
+// isDeletionDiscussion reports whether a page is a deletion discussion: a
+// subpage of a deletion process in the project namespace
+func isDeletionDiscussion(title string, namespace int) bool {
+	if namespace != 4 {
+		return false
+	}
+	if colon := strings.Index(title, ":"); colon >= 0 {
+		title = title[colon+1:]
+	}
+	for _, prefix := range deletionDiscussionPrefixes {
+		if strings.HasPrefix(title, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDeletionVotes reads the !votes of a deletion discussion's wikitext: list
+// items with a bolded position and a signature. Only each user's first !vote is
+// kept, later ones being clarifications. Timestamps are read from English
+// signatures and left zero otherwise.
+func parseDeletionVotes(wikitext string) []models.DeletionVote {
+	var votes []models.DeletionVote
+	voted := make(map[string]bool)
+
+	for _, line := range strings.Split(wikitext, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "*") && !strings.HasPrefix(line, "#") {
+			continue
+		}
+		bold := voteBoldPattern.FindStringSubmatch(line)
+		if bold == nil {
+			continue
+		}
+		position := votePosition(bold[1])
+		if position == "" {
+			continue
+		}
+
+		// The signature ends the line: its user link is the last one
+		signatures := voteSignaturePattern.FindAllStringSubmatch(line, -1)
+		if len(signatures) == 0 {
+			continue
+		}
+		user := utils.NormalizeUsername(signatures[len(signatures)-1][1])
+		if user == "" || voted[user] {
+			continue
+		}
+		voted[user] = true
+
+		vote := models.DeletionVote{
+			User:     user,
+			Position: position,
+			Text:     strings.TrimSpace(bold[1]),
+		}
+		if stamp := voteTimestampPattern.FindAllStringSubmatch(line, -1); len(stamp) > 0 {
+			last := stamp[len(stamp)-1]
+			vote.Timestamp, _ = time.Parse("15:04, 2 January 2006", last[1]+", "+last[2])
+		}
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
+
+// votePosition returns the position cast by a bolded !vote ("Strong keep" is
+// keep), or "" for a comment or an unknown word
+func votePosition(text string) string {
+	for _, word := range wordPattern.FindAllString(strings.ToLower(text), -1) {
+		if position, exists := votePositions[word]; exists {
+			return position
+		}
+	}
+	return ""
+}
+
+// analyzeDeletionDiscussion tallies the !votes of a deletion discussion and
+// finds the clusters of identical !votes cast together. Votes without a
+// readable signature timestamp are dated by their author's first edit in the
+// page history (oldest first); those still undated cannot be clustered.
+func (pa *PageAnalyzer) analyzeDeletionDiscussion(wikitext string, history []models.WikiRevision) *models.DeletionDiscussion {
+	votes := parseDeletionVotes(wikitext)
+
+	firstEdits := make(map[string]time.Time)
+	for _, rev := range history {
+		if rev.UserHidden {
+			continue
+		}
+		user := utils.NormalizeUsername(rev.User)
+		if _, seen := firstEdits[user]; !seen {
+			firstEdits[user], _ = time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+		}
+	}
+
+	discussion := &models.DeletionDiscussion{
+		Votes: votes,
+		Tally: make(map[string]int),
+	}
+	for i := range discussion.Votes {
+		vote := &discussion.Votes[i]
+		if vote.Timestamp.IsZero() {
+			vote.Timestamp = firstEdits[vote.User]
+		}
+		discussion.Tally[vote.Position]++
+	}
+	sort.SliceStable(discussion.Votes, func(i, j int) bool {
+		return discussion.Votes[i].Timestamp.Before(discussion.Votes[j].Timestamp)
+	})
+	discussion.Clusters = detectVoteClusters(discussion.Votes)
+
+	return discussion
+}
+
+// detectVoteClusters finds, per position, the runs of at least
+// coordinatedVoteMinAccounts !votes cast within coordinatedVoteWindow of the
+// run's first one. votes are sorted oldest first; runs do not overlap.
+func detectVoteClusters(votes []models.DeletionVote) []models.VoteCluster {
+	byPosition := make(map[string][]models.DeletionVote)
+	var positions []string
+	for _, vote := range votes {
+		if vote.Timestamp.IsZero() {
+			continue
+		}
+		if _, exists := byPosition[vote.Position]; !exists {
+			positions = append(positions, vote.Position)
+		}
+		byPosition[vote.Position] = append(byPosition[vote.Position], vote)
+	}
+
+	var clusters []models.VoteCluster
+	for _, position := range positions {
+		sameVotes := byPosition[position]
+		for start := 0; start < len(sameVotes); {
+			end := start
+			for end < len(sameVotes) && sameVotes[end].Timestamp.Sub(sameVotes[start].Timestamp) <= coordinatedVoteWindow {
+				end++
+			}
+			if end-start < coordinatedVoteMinAccounts {
+				start++
+				continue
+			}
+
+			cluster := models.VoteCluster{
+				Position:  position,
+				FirstVote: sameVotes[start].Timestamp,
+				LastVote:  sameVotes[end-1].Timestamp,
+			}
+			for _, vote := range sameVotes[start:end] {
+				cluster.Users = append(cluster.Users, vote.User)
+			}
+			cluster.SpanMinutes = int(cluster.LastVote.Sub(cluster.FirstVote).Minutes())
+			clusters = append(clusters, cluster)
+			start = end
+		}
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return len(clusters[i].Users) > len(clusters[j].Users)
+	})
+	return clusters
+}
@@ -0,0 +1,111 @@
+// internal/analyzer/edges.go
+package analyzer
+
+import (
+	"sort"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Interaction edge types
+const (
+	edgeReverted = "reverted" // Source reverted an edit by target
+	edgeDefended = "defended" // Source restored target's edit after an attack
+)
+
+// edgeBuilder aggregates interactions into one weighted edge per (source, target, type)
+type edgeBuilder struct {
+	edges map[[3]string]*models.InteractionEdge
+	order [][3]string
+}
+
+func newEdgeBuilder() *edgeBuilder {
+	return &edgeBuilder{edges: make(map[[3]string]*models.InteractionEdge)}
+}
+
+func (eb *edgeBuilder) add(source, target, edgeType, page string, timestamp time.Time) {
+	if source == "" || target == "" || source == target {
+		return
+	}
+
+	key := [3]string{source, target, edgeType}
+	edge, exists := eb.edges[key]
+	if !exists {
+		edge = &models.InteractionEdge{Source: source, Target: target, Type: edgeType, FirstSeen: timestamp, LastSeen: timestamp}
+		eb.edges[key] = edge
+		eb.order = append(eb.order, key)
+	}
+
+	edge.Weight++
+	if timestamp.Before(edge.FirstSeen) {
+		edge.FirstSeen = timestamp
+	}
+	if timestamp.After(edge.LastSeen) {
+		edge.LastSeen = timestamp
+	}
+	if page != "" && !utils.Contains(edge.Pages, page) {
+		edge.Pages = append(edge.Pages, page)
+	}
+}
+
+// addReverts adds a reverted edge from each reverting author to the author of
+// the revision it undid
+func (eb *edgeBuilder) addReverts(profile *models.PageProfile) {
+	revisionsByID := make(map[int]models.Revision, len(profile.RecentRevisions))
+	for _, revision := range profile.RecentRevisions {
+		revisionsByID[revision.RevID] = revision
+	}
+
+	for _, revision := range profile.RecentRevisions {
+		if !revision.IsRevert || revision.IsHidden {
+			continue
+		}
+		parent, exists := revisionsByID[revision.ParentID]
+		if !exists || parent.IsHidden {
+			continue
+		}
+		eb.add(utils.NormalizeUsername(revision.Username), utils.NormalizeUsername(parent.Username),
+			edgeReverted, profile.PageTitle, revision.Timestamp)
+	}
+}
+
+// result returns the edges, heaviest first
+func (eb *edgeBuilder) result() []models.InteractionEdge {
+	edges := make([]models.InteractionEdge, 0, len(eb.order))
+	for _, key := range eb.order {
+		edges = append(edges, *eb.edges[key])
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight > edges[j].Weight
+	})
+	return edges
+}
+
+// BuildPageInteractionEdges lists who reverted whom on a page, as a weighted edge
+// list for network analysis tools
+func BuildPageInteractionEdges(profile *models.PageProfile) []models.InteractionEdge {
+	builder := newEdgeBuilder()
+	builder.addReverts(profile)
+	return builder.result()
+}
+
+// BuildCrossPageInteractionEdges lists the reverts on every analyzed page and the
+// mutual support events between contributors, as a weighted edge list
+func BuildCrossPageInteractionEdges(analysis *models.CrossPageAnalysis) []models.InteractionEdge {
+	builder := newEdgeBuilder()
+	for _, pageName := range analysis.Pages {
+		if profile, exists := analysis.PageProfiles[pageName]; exists {
+			builder.addReverts(profile)
+		}
+	}
+
+	for _, pair := range analysis.CoordinatedPatterns.MutualSupportPairs {
+		for _, event := range pair.SupportEvents {
+			builder.add(event.DefenderUser, event.SupportedUser, edgeDefended, event.PageTitle, event.Timestamp)
+		}
+	}
+
+	return builder.result()
+}
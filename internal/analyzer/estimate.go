@@ -0,0 +1,171 @@
+// internal/analyzer/estimate.go
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// callEstimate accumulates the steps of an API call estimate
+type callEstimate struct {
+	steps []models.CallEstimateStep
+}
+
+// add records a step sending calls requests, at most calls when upperBound
+func (e *callEstimate) add(step string, calls int, upperBound bool, note string) {
+	e.steps = append(e.steps, models.CallEstimateStep{Step: step, Calls: calls, UpperBound: upperBound, Note: note})
+}
+
+// addScaled records the steps of a sub-analysis run times times
+func (e *callEstimate) addScaled(steps []models.CallEstimateStep, times int, unit string) {
+	for _, step := range steps {
+		note := fmt.Sprintf("%d per %s", step.Calls, unit)
+		if step.Note != "" {
+			note += ", " + step.Note
+		}
+		e.add(step.Step, step.Calls*times, step.UpperBound, note)
+	}
+}
+
+// result totals the steps
+func (e *callEstimate) result(target string) models.CallEstimate {
+	estimate := models.CallEstimate{Target: target, Steps: e.steps}
+	for _, step := range e.steps {
+		estimate.Total += step.Calls
+		estimate.UpperBound = estimate.UpperBound || step.UpperBound
+	}
+	return estimate
+}
+
+// siteInfoSteps are the requests made once per client: the capability probe and
+// the localized namespace names
+func siteInfoSteps(e *callEstimate) {
+	e.add("Wiki capabilities (siteinfo)", 1, false, "once per run")
+	e.add("Namespace names (siteinfo)", 1, true, "once per run, when needed")
+}
+
+// batches returns the number of list requests needed for limit items
+func batches(limit, queryLimit int) int {
+	if limit <= 0 {
+		return 0
+	}
+	return (limit + queryLimit - 1) / queryLimit
+}
+
+// EstimateProfileCalls estimates the requests GetUserProfileWithConfig sends for
+// one user under config (nil skips the revoked analysis), site info included
+func (ua *UserAnalyzer) EstimateProfileCalls(config *RevokedAnalysisConfig) models.CallEstimate {
+	var e callEstimate
+	siteInfoSteps(&e)
+	e.steps = append(e.steps, ua.profileSteps(config)...)
+	return e.result("1 user")
+}
+
+// profileSteps lists the requests of one user profile, site info aside
+func (ua *UserAnalyzer) profileSteps(config *RevokedAnalysisConfig) []models.CallEstimateStep {
+	var e callEstimate
+	queryLimit := ua.client.QueryLimit()
+
+	e.add("User info", 1, false, "")
+	e.add("Recent contributions", batches(200, queryLimit), false, "")
+	e.add("First contribution", 1, true, "accounts without a registration date")
+	e.add("Protection logs of edited pages", autoconfirmedEdits+autoconfirmedMaxJump+1, true, "young accounts only, one per page of their first edits")
+	e.add("Page categories (topic clusters)", 1, true, "")
+	e.add("Revision contents (cosmetic, COI, creations)", 3, true, "")
+
+	if config != nil && !ua.skipRevoked {
+		e.add("Revoked contributions: quick checks", config.MaxPagesToAnalyze, true, "one per recently edited page")
+		if config.EnableDeepAnalysis {
+			e.add("Revoked contributions: deep analysis", config.MaxPagesToAnalyze*batches(config.MaxRevisionsPerPage, queryLimit), true, "pages with reverts only")
+		}
+		if config.VerifyContent {
+			e.add("Revert content verification", 0, false, "plus one per revert found")
+		}
+	}
+	if ua.includeDeleted {
+		e.add("Deleted contributions", batches(500, queryLimit), false, "")
+	}
+	if ua.scanTopPages {
+		e.add("Top pages conflict scan", topPagesScanned*batches(topPagesScanRevisions, queryLimit), true, "")
+	}
+
+	return e.steps
+}
+
+// EstimateProfileCalls estimates the requests GetPageProfile sends for one page,
+// site info included
+func (pa *PageAnalyzer) EstimateProfileCalls() models.CallEstimate {
+	var e callEstimate
+	siteInfoSteps(&e)
+	e.steps = append(e.steps, pa.profileSteps()...)
+	return e.result("1 page")
+}
+
+// profileSteps lists the requests of one page profile, site info aside
+func (pa *PageAnalyzer) profileSteps() []models.CallEstimateStep {
+	var e callEstimate
+	queryLimit := pa.client.QueryLimit()
+
+	e.add("Page info", 1, false, "")
+	e.add("Revisions", batches(pa.numberOfPageRevisions, queryLimit), false, "")
+	e.add("Detailed history", 1, false, "")
+	if pa.followMoves {
+		e.add("Page moves", 1, false, "plus one history request per former title")
+	}
+	e.add("Contributors", batches(pa.numberOfContributors, queryLimit), false, "")
+
+	// Registered contributors among the first of the history's top contributors
+	// get a full user profile, then a look-up of their first edit to the page
+	profiled := min(topContributorsKept, contributorsProfiled)
+	userCalls := 1
+	defaultConfig := GetDefaultRevokedAnalysisConfig()
+	for _, step := range NewUserAnalyzer(pa.client).profileSteps(&defaultConfig) {
+		userCalls += step.Calls
+	}
+	e.add("Contributor profiles", profiled*userCalls, true, fmt.Sprintf("up to %d registered contributors, %d each", profiled, userCalls))
+
+	if pa.checkProtection {
+		e.add("Protection log", 1, false, "")
+	}
+	if pa.analyzeSources {
+		e.add("Source analysis (wikitext, reference churn)", 2, false, "")
+	}
+	if pa.analyzePageViews {
+		e.add("Pageviews", 1, true, "wikis publishing pageview statistics")
+	}
+	if pa.analyzeTalkPage {
+		e.add("Talk page history", 1, true, "pages with a talk page")
+	}
+	e.add("Deletion discussion wikitext", 1, true, "deletion discussions only")
+
+	return e.steps
+}
+
+// EstimateCalls estimates the requests AnalyzePages sends for pageNames. Pages in
+// the profile cache cost nothing.
+func (cpa *CrossPageAnalyzer) EstimateCalls(pageNames []string) models.CallEstimate {
+	var e callEstimate
+	siteInfoSteps(&e)
+
+	fetched := 0
+	for _, pageName := range pageNames {
+		if key, cacheable := cpa.pageAnalyzer.cacheKey(pageName); cpa.cache != nil && cacheable {
+			if _, cached := cpa.cache.Load(key); cached {
+				continue
+			}
+		}
+		fetched++
+	}
+	if cached := len(pageNames) - fetched; cached > 0 {
+		e.add("Cached page profiles", 0, false, fmt.Sprintf("%d of %d pages", cached, len(pageNames)))
+	}
+	if fetched > 0 {
+		e.addScaled(cpa.pageAnalyzer.profileSteps(), fetched, "page")
+	}
+
+	renameLookups := min(len(pageNames)*cpa.options.MaxContributorsPerPage, maxRenameLookups)
+	e.add("Account renames", renameLookups, true, "most active registered contributors, on wikis logging renames")
+
+	return e.result(fmt.Sprintf("%d pages", len(pageNames)))
+}
@@ -0,0 +1,157 @@
+// internal/analyzer/evidence.go
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// BuildEvidenceExport turns a cross-page analysis into a citeable artifact: every
+// detected pattern is listed with the revisions supporting it and their diff URLs
+func BuildEvidenceExport(analysis *models.CrossPageAnalysis) *models.EvidenceExport {
+	export := &models.EvidenceExport{
+		Pages:          analysis.Pages,
+		Language:       analysis.Language,
+		SuspicionScore: analysis.SuspicionScore,
+		SuspicionFlags: analysis.SuspicionFlags,
+		Patterns:       []models.EvidencePattern{},
+		GeneratedAt:    time.Now(),
+	}
+	language := analysis.Language
+	patterns := analysis.CoordinatedPatterns
+
+	// 1. Mutual support pairs - the defending revisions
+	for _, pair := range patterns.MutualSupportPairs {
+		export.Patterns = append(export.Patterns, models.EvidencePattern{
+			Type: "mutual_support",
+			Description: fmt.Sprintf("%s and %s defend each other (%d support events, ratio %.2f)",
+				pair.UserA, pair.UserB, len(pair.SupportEvents), pair.MutualSupportRatio),
+			Users:          []string{pair.UserA, pair.UserB},
+			Pages:          pair.PagesInvolved,
+			SuspicionLevel: pair.SuspicionLevel,
+			Revisions:      supportEventsEvidence(language, pair.SupportEvents),
+		})
+	}
+
+	// 2. Tag-team editing sequences
+	for _, tagTeam := range patterns.TagTeamEditing {
+		export.Patterns = append(export.Patterns, models.EvidencePattern{
+			Type:        "tag_team",
+			Description: fmt.Sprintf("Tag-team editing by %d users (%s rotation)", len(tagTeam.Users), tagTeam.RotationPattern),
+			Users:       tagTeam.Users,
+			Pages:       tagTeam.PagesAffected,
+			Revisions:   editEventsEvidence(language, tagTeam.EditSequences),
+		})
+	}
+
+	// 3. Coordinated reversions
+	for _, revert := range patterns.CoordinatedReversions {
+		export.Patterns = append(export.Patterns, models.EvidencePattern{
+			Type:           "coordinated_revert",
+			Description:    fmt.Sprintf("%d users coordinate reverts against %s", len(revert.RevertingUsers), revert.TargetUser),
+			Users:          append([]string{revert.TargetUser}, revert.RevertingUsers...),
+			Pages:          revert.PagesAffected,
+			SuspicionLevel: revert.SuspicionLevel,
+			Revisions:      editEventsEvidence(language, revert.RevertEvents),
+		})
+	}
+
+	// 4. Support networks - evidence of the pairs inside the network
+	for _, network := range patterns.SupportNetworks {
+		var events []models.MutualSupportEvent
+		for _, pair := range patterns.MutualSupportPairs {
+			if utils.Contains(network.Users, pair.UserA) && utils.Contains(network.Users, pair.UserB) {
+				events = append(events, pair.SupportEvents...)
+			}
+		}
+
+		export.Patterns = append(export.Patterns, models.EvidencePattern{
+			Type:        "support_network",
+			Description: fmt.Sprintf("Support network %s of %d users (density %.2f)", network.NetworkID, len(network.Users), network.NetworkDensity),
+			Users:       network.Users,
+			Pages:       network.PagesControlled,
+			Revisions:   supportEventsEvidence(language, events),
+		})
+	}
+
+	// 5. Synchronized editing - revisions of the users on the pages within the window
+	for _, event := range analysis.TemporalPatterns.SynchronizedEditing {
+		windowEnd := event.Timestamp.Add(time.Duration(event.TimeWindow) * time.Minute)
+
+		revisions := []models.EvidenceRevision{}
+		for _, page := range event.PagesAffected {
+			profile, exists := analysis.PageProfiles[page]
+			if !exists || profile == nil {
+				continue
+			}
+			for _, rev := range profile.RecentRevisions {
+				if !utils.Contains(event.Users, rev.Username) || rev.Timestamp.Before(event.Timestamp) || rev.Timestamp.After(windowEnd) {
+					continue
+				}
+				revisions = append(revisions, newEvidenceRevision(language, rev.RevID, page, rev.Username, rev.Timestamp, rev.Comment))
+			}
+		}
+		sortEvidenceRevisions(revisions)
+
+		export.Patterns = append(export.Patterns, models.EvidencePattern{
+			Type:           "synchronized_editing",
+			Description:    fmt.Sprintf("%s editing by %d users within %d minutes", event.SynchronizationType, len(event.Users), event.TimeWindow),
+			Users:          event.Users,
+			Pages:          event.PagesAffected,
+			SuspicionLevel: event.SuspicionLevel,
+			Revisions:      revisions,
+		})
+	}
+
+	return export
+}
+
+// supportEventsEvidence converts support events into cited revisions
+func supportEventsEvidence(language string, events []models.MutualSupportEvent) []models.EvidenceRevision {
+	revisions := []models.EvidenceRevision{}
+	for _, event := range events {
+		if event.RevisionID == 0 {
+			continue
+		}
+		revisions = append(revisions, newEvidenceRevision(language, event.RevisionID, event.PageTitle, event.DefenderUser, event.Timestamp, event.Comment))
+	}
+	sortEvidenceRevisions(revisions)
+	return revisions
+}
+
+// editEventsEvidence converts edit events into cited revisions
+func editEventsEvidence(language string, events []models.EditEvent) []models.EvidenceRevision {
+	revisions := []models.EvidenceRevision{}
+	for _, event := range events {
+		if event.RevisionID == 0 {
+			continue
+		}
+		revisions = append(revisions, newEvidenceRevision(language, event.RevisionID, event.PageTitle, event.Username, event.Timestamp, event.Comment))
+	}
+	sortEvidenceRevisions(revisions)
+	return revisions
+}
+
+// newEvidenceRevision builds a cited revision with its diff URL
+func newEvidenceRevision(language string, revisionID int, pageTitle, username string, timestamp time.Time, comment string) models.EvidenceRevision {
+	return models.EvidenceRevision{
+		RevisionID: revisionID,
+		PageTitle:  pageTitle,
+		Username:   username,
+		Timestamp:  timestamp,
+		Comment:    comment,
+		DiffURL:    client.DiffURL(language, revisionID),
+	}
+}
+
+// sortEvidenceRevisions orders cited revisions chronologically
+func sortEvidenceRevisions(revisions []models.EvidenceRevision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Timestamp.Before(revisions[j].Timestamp)
+	})
+}
@@ -0,0 +1,163 @@
+// internal/analyzer/footprint.go
+package analyzer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/sources"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// FootprintAnalyzer profiles a user, then analyzes the pages they edit most to
+// show their role on each
+type FootprintAnalyzer struct {
+	client       *client.WikipediaClient
+	userAnalyzer *UserAnalyzer
+	pageAnalyzer *PageAnalyzer
+	maxPages     int
+}
+
+type FootprintOptions struct {
+	MaxPages              int // Number of most edited pages to analyze
+	NumberOfPageRevisions int // Number of revisions to analyze per page
+	NumberOfDaysHistory   int // Number of days of page history
+	NumberOfContributors  int // Number of page contributors to analyze
+
+	// DomainClassifier rates reference domains (default: the embedded perennial sources dataset)
+	DomainClassifier *sources.DomainClassifier
+}
+
+// NewFootprintAnalyzer creates a new footprint analyzer
+func NewFootprintAnalyzer(client *client.WikipediaClient, options FootprintOptions) *FootprintAnalyzer {
+	maxPages := options.MaxPages
+	if maxPages <= 0 {
+		maxPages = 5
+	}
+
+	return &FootprintAnalyzer{
+		client:       client,
+		userAnalyzer: NewUserAnalyzer(client),
+		pageAnalyzer: NewPageAnalyzer(client, PageAnalysisOptions{
+			NumberOfPageRevisions: options.NumberOfPageRevisions,
+			NumberOfDaysHistory:   options.NumberOfDaysHistory,
+			NumberOfContributors:  options.NumberOfContributors,
+			DomainClassifier:      options.DomainClassifier,
+		}),
+		maxPages: maxPages,
+	}
+}
+
+// GetFootprint builds username's profile and analyzes their most edited pages.
+// The revoked contributions analysis is skipped: reverts are counted from each
+// page's own revisions. A page whose analysis fails is reported and skipped.
+func (fa *FootprintAnalyzer) GetFootprint(username string) (*models.UserFootprint, error) {
+	fa.userAnalyzer.SetSkipRevoked(true)
+	profile, err := fa.userAnalyzer.GetUserProfileWithConfig(username, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	footprint := &models.UserFootprint{
+		Username:       profile.Username,
+		EditCount:      profile.EditCount,
+		SampleSize:     profile.SampleSize,
+		SuspicionScore: profile.SuspicionScore,
+		SuspicionFlags: profile.SuspicionFlags,
+		Pages:          []models.PageInvolvement{},
+		Language:       fa.client.Language(),
+		RetrievedAt:    time.Now(),
+	}
+
+	// TopPages is sorted by edit count
+	for i, page := range profile.TopPages {
+		if i >= fa.maxPages {
+			break
+		}
+		pageProfile, err := fa.pageAnalyzer.GetPageProfile(page.PageTitle)
+		if err != nil {
+			footprint.Errors = append(footprint.Errors, fmt.Sprintf("%s: %v", page.PageTitle, err))
+			continue
+		}
+
+		involvement := pageInvolvement(profile.Username, pageProfile)
+		involvement.SampleEdits = page.EditCount
+		footprint.Pages = append(footprint.Pages, involvement)
+	}
+
+	return footprint, nil
+}
+
+// pageInvolvement measures username's role on an analyzed page: their share of
+// its recent revisions, the reverts they made and received there, and their
+// part in its conflicts
+func pageInvolvement(username string, profile *models.PageProfile) models.PageInvolvement {
+	username = utils.NormalizeUsername(username)
+	involvement := models.PageInvolvement{
+		PageTitle:          profile.PageTitle,
+		PageRevisions:      len(profile.RecentRevisions),
+		PageSuspicionScore: profile.SuspicionScore,
+		ControversyScore:   profile.ConflictStats.ControversyScore,
+	}
+
+	for i, contributor := range profile.Contributors {
+		if utils.NormalizeUsername(contributor.Username) == username {
+			involvement.ContributorRank = i + 1
+			break
+		}
+	}
+
+	revisionsByID := make(map[int]models.Revision, len(profile.RecentRevisions))
+	for _, revision := range profile.RecentRevisions {
+		revisionsByID[revision.RevID] = revision
+	}
+
+	for _, revision := range profile.RecentRevisions {
+		if revision.IsHidden {
+			continue
+		}
+		author := utils.NormalizeUsername(revision.Username)
+		if author == username {
+			involvement.UserRevisions++
+		}
+		if !revision.IsRevert {
+			continue
+		}
+		parent, exists := revisionsByID[revision.ParentID]
+		if !exists || parent.IsHidden {
+			continue
+		}
+
+		// Self-reverts count neither way
+		parentAuthor := utils.NormalizeUsername(parent.Username)
+		if author == username && parentAuthor != username {
+			involvement.RevertsMade++
+		} else if parentAuthor == username && author != username {
+			involvement.RevertsReceived++
+		}
+	}
+	if involvement.PageRevisions > 0 {
+		involvement.ContributorShare = float64(involvement.UserRevisions) / float64(involvement.PageRevisions)
+	}
+
+	stats := profile.ConflictStats
+	for _, user := range stats.ConflictingUsers {
+		if utils.NormalizeUsername(user) == username {
+			involvement.ConflictingUser = true
+			break
+		}
+	}
+	for _, period := range stats.EditWarPeriods {
+		for _, participant := range period.Participants {
+			if utils.NormalizeUsername(participant) == username {
+				involvement.EditWarPeriods++
+				break
+			}
+		}
+	}
+	involvement.PageOwner = stats.Ownership != nil && utils.NormalizeUsername(stats.Ownership.Username) == username
+
+	return involvement
+}
@@ -0,0 +1,101 @@
+// internal/analyzer/handoff.go
+package analyzer
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Account handoff: an account goes quiet on the analyzed pages as another one
+// starts, so that the two active periods follow each other with at most
+// handoffMaxOverlap of overlap and handoffMaxGap of silence in between. Each
+// account needs handoffMinEdits edits for its active period to mean anything.
+const (
+	handoffMaxOverlap = 48 * time.Hour
+	handoffMaxGap     = 7 * 24 * time.Hour
+	handoffMinEdits   = 3
+)
+
+// detectAccountHandoffs finds pairs of registered accounts sharing a page whose
+// active periods are complementary, and flags both accounts. Each account is
+// paired at most once, with the successor closest to its changeover.
+func (cpa *CrossPageAnalyzer) detectAccountHandoffs(contributors []models.CommonContributor) []models.AccountHandoff {
+	var candidates []models.AccountHandoff
+	for i := range contributors {
+		predecessor := &contributors[i]
+		if !handoffCandidate(predecessor) {
+			continue
+		}
+		for j := range contributors {
+			successor := &contributors[j]
+			if i == j || !handoffCandidate(successor) {
+				continue
+			}
+
+			// The successor starts around the predecessor's last edit and keeps going after it
+			gap := successor.FirstEdit.Sub(predecessor.LastEdit)
+			if gap < -handoffMaxOverlap || gap > handoffMaxGap {
+				continue
+			}
+			if !successor.FirstEdit.After(predecessor.FirstEdit) || !successor.LastEdit.After(predecessor.LastEdit) {
+				continue
+			}
+
+			var sharedPages []string
+			for _, page := range predecessor.PagesEdited {
+				if utils.Contains(successor.PagesEdited, page) {
+					sharedPages = append(sharedPages, page)
+				}
+			}
+			if len(sharedPages) == 0 {
+				continue
+			}
+
+			candidates = append(candidates, models.AccountHandoff{
+				Predecessor:    predecessor.Username,
+				Successor:      successor.Username,
+				LastEdit:       predecessor.LastEdit,
+				ChangeoverDate: successor.FirstEdit,
+				GapHours:       gap.Hours(),
+				SharedPages:    sharedPages,
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return math.Abs(candidates[i].GapHours) < math.Abs(candidates[j].GapHours)
+	})
+
+	paired := make(map[string]bool)
+	handoffs := []models.AccountHandoff{}
+	for _, candidate := range candidates {
+		if paired[candidate.Predecessor] || paired[candidate.Successor] {
+			continue
+		}
+		paired[candidate.Predecessor] = true
+		paired[candidate.Successor] = true
+		handoffs = append(handoffs, candidate)
+	}
+
+	sort.Slice(handoffs, func(i, j int) bool {
+		return handoffs[i].ChangeoverDate.Before(handoffs[j].ChangeoverDate)
+	})
+
+	for i := range contributors {
+		if paired[contributors[i].Username] && !utils.Contains(contributors[i].SuspicionFlags, "ACCOUNT_HANDOFF") {
+			contributors[i].SuspicionFlags = append(contributors[i].SuspicionFlags, "ACCOUNT_HANDOFF")
+		}
+	}
+
+	return handoffs
+}
+
+// handoffCandidate reports whether a contributor's active period can take part
+// in a handoff. IP addresses change hands on their own and are left out.
+func handoffCandidate(contributor *models.CommonContributor) bool {
+	return !contributor.IsAnonymous && contributor.TotalEdits >= handoffMinEdits
+}
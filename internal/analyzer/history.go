@@ -0,0 +1,164 @@
+// internal/analyzer/history.go
+package analyzer
+
+import (
+	"strings"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// A recent activity burst is more than activityBurstEdits edits within the last
+// activityBurstWindow, whatever the edit frequency windows
+const (
+	activityBurstWindow = 7 * 24 * time.Hour
+	activityBurstEdits  = 10
+)
+
+// historyPass holds what the contributor, conflict and quality analyses need
+// from the detailed history, gathered in a single walk over the revisions:
+// timestamps are parsed and reverts detected once per revision rather than once
+// per analysis, and the per-author and per-period counters are shared.
+type historyPass struct {
+	revisions     []models.WikiRevision
+	timestamps    []time.Time // Parsed timestamps, by index in revisions
+	revisionsByID map[int]models.WikiRevision
+
+	// Reverts, by index in revisions, and the self-reverts among them by revision ID
+	revertIndexes []int
+	isRevert      map[int]bool
+	isSelfRevert  map[int]bool
+
+	// Authors: the attributable revisions build contributor statistics, while
+	// editsByUser counts every revision under its author name
+	contributors map[string]*models.TopContributor
+	editsByUser  map[string]int
+	attributable int
+
+	// Attributable revisions whose edit summary is not revision-deleted, by author
+	visibleSummaries map[string]int
+
+	// Quality counters
+	totalSizeChanges int
+	anonymousEdits   int
+	hourlyEdits      map[int]int
+	frequency        models.EditFrequency
+	burstEdits       int // Edits within activityBurstWindow
+}
+
+// newHistoryPass walks the revisions once. Self-reverts are checked afterwards,
+// against the complete index, since a parent can come later in merged histories.
+func (pa *PageAnalyzer) newHistoryPass(revisions []models.WikiRevision) *historyPass {
+	pass := &historyPass{
+		revisions:     revisions,
+		timestamps:    make([]time.Time, len(revisions)),
+		revisionsByID: make(map[int]models.WikiRevision, len(revisions)),
+		isRevert:      make(map[int]bool),
+		isSelfRevert:  make(map[int]bool),
+		contributors:  make(map[string]*models.TopContributor),
+		editsByUser:   make(map[string]int),
+		hourlyEdits:   make(map[int]int),
+
+		visibleSummaries: make(map[string]int),
+		frequency: models.EditFrequency{
+			EditsByDay: make(map[string]int),
+		},
+	}
+
+	now := time.Now()
+	windowStarts := make([]time.Time, len(pa.frequencyWindows))
+	for i, days := range pa.frequencyWindows {
+		windowStarts[i] = now.AddDate(0, 0, -days)
+		pass.frequency.Windows = append(pass.frequency.Windows, models.EditWindow{Days: days})
+	}
+	burstStart := now.Add(-activityBurstWindow)
+	sevenDaysAgo := now.AddDate(0, 0, -7)
+	thirtyDaysAgo := now.AddDate(0, 0, -30)
+	ninetyDaysAgo := now.AddDate(0, 0, -90)
+
+	for i, rev := range revisions {
+		timestamp, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+		pass.timestamps[i] = timestamp
+		pass.revisionsByID[rev.RevID] = rev
+
+		if pa.isRevertRevision(rev) {
+			pass.revertIndexes = append(pass.revertIndexes, i)
+			pass.isRevert[rev.RevID] = true
+		}
+
+		// Quality counters
+		pass.totalSizeChanges += rev.Size
+		if rev.Anon == "true" {
+			pass.anonymousEdits++
+		}
+		pass.hourlyEdits[timestamp.Hour()]++
+		pass.frequency.EditsByDay[timestamp.Format("2006-01-02")]++
+		for w, start := range windowStarts {
+			if timestamp.After(start) {
+				pass.frequency.Windows[w].Edits++
+			}
+		}
+		if timestamp.After(burstStart) {
+			pass.burstEdits++
+		}
+		if timestamp.After(sevenDaysAgo) {
+			pass.frequency.EditsLast7Days++
+		}
+		if timestamp.After(thirtyDaysAgo) {
+			pass.frequency.EditsLast30Days++
+		}
+		if timestamp.After(ninetyDaysAgo) {
+			pass.frequency.EditsLast90Days++
+		}
+
+		// Revision-deleted authors cannot be attributed to anyone
+		pass.editsByUser[rev.User]++
+		if rev.UserHidden {
+			continue
+		}
+		pass.attributable++
+		pass.addContribution(rev, timestamp)
+
+		if !rev.CommentHidden {
+			pass.visibleSummaries[rev.User]++
+			if strings.TrimSpace(rev.Comment) == "" {
+				pass.contributors[rev.User].EmptySummaries++
+			}
+		}
+	}
+
+	for _, i := range pass.revertIndexes {
+		if pa.isSelfRevert(revisions[i], pass.revisionsByID) {
+			pass.isSelfRevert[revisions[i].RevID] = true
+		}
+	}
+
+	return pass
+}
+
+// addContribution records an attributable revision in its author's statistics
+func (pass *historyPass) addContribution(rev models.WikiRevision, timestamp time.Time) {
+	if existing, exists := pass.contributors[rev.User]; exists {
+		existing.EditCount++
+		existing.TotalSizeDiff += rev.Size // This is approximate
+
+		if timestamp.After(existing.LastEdit) {
+			existing.LastEdit = timestamp
+		}
+		if timestamp.Before(existing.FirstEdit) {
+			existing.FirstEdit = timestamp
+		}
+		return
+	}
+
+	pass.contributors[rev.User] = &models.TopContributor{
+		Username:      rev.User,
+		UserID:        rev.UserID,
+		EditCount:     1,
+		FirstEdit:     timestamp,
+		LastEdit:      timestamp,
+		TotalSizeDiff: rev.Size,
+		IsAnonymous:   rev.Anon == "true",
+		IsRegistered:  rev.UserID > 0,
+	}
+}
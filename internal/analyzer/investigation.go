@@ -0,0 +1,262 @@
+// internal/analyzer/investigation.go
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/sources"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// InvestigationAnalyzer runs contribution, author and page analysis for one revision
+// and merges the results into a single report
+type InvestigationAnalyzer struct {
+	client               *client.WikipediaClient
+	contributionAnalyzer *ContributionAnalyzer
+	userAnalyzer         *UserAnalyzer
+	pageAnalyzer         *PageAnalyzer
+}
+
+type InvestigationOptions struct {
+	AnalysisDepth         string // Contribution analysis depth: "basic", "standard", "deep"
+	NumberOfPageRevisions int    // Number of page revisions to analyze
+	NumberOfDaysHistory   int    // Number of days of page history
+	NumberOfContributors  int    // Number of page contributors to analyze
+
+	// DomainClassifier rates reference domains (default: the embedded perennial sources dataset)
+	DomainClassifier *sources.DomainClassifier
+}
+
+// signalAliases maps equivalent flags raised by different analyzers to one name
+var signalAliases = map[string]string{
+	"BLOCKED_USER": "USER_BLOCKED",
+}
+
+// NewInvestigationAnalyzer creates a new investigation analyzer
+func NewInvestigationAnalyzer(client *client.WikipediaClient, options InvestigationOptions) *InvestigationAnalyzer {
+	return &InvestigationAnalyzer{
+		client: client,
+		contributionAnalyzer: NewContributionAnalyzer(client, ContributionAnalysisOptions{
+			AnalysisDepth: utils.SetOrDefault(options.AnalysisDepth, "standard"),
+		}),
+		userAnalyzer: NewUserAnalyzer(client),
+		pageAnalyzer: NewPageAnalyzer(client, PageAnalysisOptions{
+			NumberOfPageRevisions: options.NumberOfPageRevisions,
+			NumberOfDaysHistory:   options.NumberOfDaysHistory,
+			NumberOfContributors:  options.NumberOfContributors,
+			DomainClassifier:      options.DomainClassifier,
+		}),
+	}
+}
+
+// Investigate builds a unified risk report for a revision on a page
+func (ia *InvestigationAnalyzer) Investigate(revisionID int, pageTitle string) (*models.InvestigationReport, error) {
+	report := &models.InvestigationReport{
+		RevisionID: revisionID,
+		PageTitle:  pageTitle,
+		Language:   ia.client.Language(),
+		Signals:    []models.InvestigationSignal{},
+		Narrative:  []string{},
+		AnalyzedAt: time.Now(),
+	}
+
+	// 1. The edit itself - required, everything else hangs off it
+	contribution, err := ia.contributionAnalyzer.GetContributionProfile(revisionID, pageTitle)
+	if err != nil {
+		return nil, fmt.Errorf("unable to analyze contribution: %w", err)
+	}
+	report.Contribution = contribution
+	report.PageTitle = contribution.PageTitle
+
+	// 2. The author's full profile (not available for anonymous or hidden editors)
+	if !contribution.Author.IsAnonymous && !contribution.Author.IsHidden {
+		authorProfile, err := ia.userAnalyzer.GetUserProfile(contribution.Author.Username)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("author analysis failed: %v", err))
+		} else {
+			report.AuthorProfile = authorProfile
+		}
+	}
+
+	// 3. The target page's conflict state
+	pageProfile, err := ia.pageAnalyzer.GetPageProfile(contribution.PageTitle)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("page analysis failed: %v", err))
+	} else {
+		report.PageProfile = pageProfile
+	}
+
+	// 4. Merge signals and compute the combined verdict
+	report.Signals = ia.mergeSignals(report)
+	report.RiskScore = ia.calculateRiskScore(report)
+	report.RiskLevel = ia.determineRiskLevel(report.RiskScore)
+	report.Narrative = ia.buildNarrative(report)
+
+	return report, nil
+}
+
+// mergeSignals deduplicates suspicion flags raised by the sub-analyses
+func (ia *InvestigationAnalyzer) mergeSignals(report *models.InvestigationReport) []models.InvestigationSignal {
+	signalSources := make(map[string][]string)
+	var order []string
+
+	addFlags := func(source string, flags []string) {
+		for _, flag := range flags {
+			if alias, exists := signalAliases[flag]; exists {
+				flag = alias
+			}
+			if _, exists := signalSources[flag]; !exists {
+				order = append(order, flag)
+			}
+			if !utils.Contains(signalSources[flag], source) {
+				signalSources[flag] = append(signalSources[flag], source)
+			}
+		}
+	}
+
+	addFlags("contribution", report.Contribution.SuspicionFlags)
+	if report.AuthorProfile != nil {
+		addFlags("author", report.AuthorProfile.SuspicionFlags)
+	}
+	if report.PageProfile != nil {
+		addFlags("page", report.PageProfile.SuspicionFlags)
+	}
+
+	signals := make([]models.InvestigationSignal, 0, len(order))
+	for _, flag := range order {
+		signals = append(signals, models.InvestigationSignal{
+			Flag:    flag,
+			Sources: signalSources[flag],
+		})
+	}
+
+	// Signals confirmed by several analyses first
+	sort.SliceStable(signals, func(i, j int) bool {
+		return len(signals[i].Sources) > len(signals[j].Sources)
+	})
+
+	return signals
+}
+
+// calculateRiskScore combines sub-analysis scores into a single risk score
+func (ia *InvestigationAnalyzer) calculateRiskScore(report *models.InvestigationReport) int {
+	// 1. Weighted base from each analysis (contribution already includes half the author score)
+	weightedScore := float64(report.Contribution.SuspicionScore) * 0.5
+	totalWeight := 0.5
+
+	if report.AuthorProfile != nil {
+		weightedScore += float64(report.AuthorProfile.SuspicionScore) * 0.3
+		totalWeight += 0.3
+	}
+	if report.PageProfile != nil {
+		weightedScore += float64(report.PageProfile.SuspicionScore) * 0.2
+		totalWeight += 0.2
+	}
+
+	score := int(weightedScore / totalWeight)
+
+	// 2. Author is one of the page's conflicting users
+	if report.PageProfile != nil && utils.Contains(report.PageProfile.ConflictStats.ConflictingUsers, report.Contribution.Author.Username) {
+		score += 10
+	}
+
+	// 3. Signals corroborated by more than one analysis
+	for _, signal := range report.Signals {
+		if len(signal.Sources) > 1 {
+			score += 5
+		}
+	}
+
+	// Limit score to 100
+	if score > 100 {
+		score = 100
+	}
+
+	return score
+}
+
+// determineRiskLevel maps the combined risk score to a level
+func (ia *InvestigationAnalyzer) determineRiskLevel(score int) string {
+	switch {
+	case score >= 80:
+		return "VERY_HIGH"
+	case score >= 60:
+		return "HIGH"
+	case score >= 40:
+		return "MODERATE"
+	case score >= 20:
+		return "LOW"
+	default:
+		return "NONE"
+	}
+}
+
+// buildNarrative writes a short human-readable account of the findings
+func (ia *InvestigationAnalyzer) buildNarrative(report *models.InvestigationReport) []string {
+	var narrative []string
+	contribution := report.Contribution
+
+	// The edit
+	editDescription := fmt.Sprintf("Revision %d on \"%s\" was made by %s on %s (suspicion %d/100).",
+		contribution.RevisionID,
+		contribution.PageTitle,
+		contribution.Author.Username,
+		contribution.Timestamp.Format("2006-01-02 15:04"),
+		contribution.SuspicionScore,
+	)
+	if contribution.IsRevert {
+		editDescription += " The edit is a revert."
+	}
+	narrative = append(narrative, editDescription)
+
+	// The author
+	if contribution.Author.IsHidden {
+		narrative = append(narrative, "The author's username is revision-deleted, so no account history is available.")
+	} else if contribution.Author.IsAnonymous {
+		narrative = append(narrative, "The author is an anonymous (IP) editor, so no account history is available.")
+	} else if report.AuthorProfile != nil {
+		author := report.AuthorProfile
+		authorDescription := fmt.Sprintf("The author has %d edits and a suspicion score of %d/100",
+			author.EditCount, author.SuspicionScore)
+		if author.RegistrationDate != nil {
+			authorDescription += fmt.Sprintf(", with an account %d days old", int(time.Since(*author.RegistrationDate).Hours()/24))
+		}
+		authorDescription += "."
+		if author.RevokedCount > 0 {
+			authorDescription += fmt.Sprintf(" %d of their %d most recent contributions were reverted (%.1f%%).",
+				author.RevokedCount, author.SampleSize, author.RevokedRatio*100)
+		}
+		narrative = append(narrative, authorDescription)
+	}
+
+	// The page
+	if report.PageProfile != nil {
+		page := report.PageProfile
+		pageDescription := fmt.Sprintf("The page has a suspicion score of %d/100, %d reversions and a controversy score of %.2f.",
+			page.SuspicionScore, page.ConflictStats.ReversionsCount, page.ConflictStats.ControversyScore)
+		if len(page.ConflictStats.EditWarPeriods) > 0 {
+			pageDescription += fmt.Sprintf(" %d edit war period(s) were detected.", len(page.ConflictStats.EditWarPeriods))
+		}
+		if utils.Contains(page.ConflictStats.ConflictingUsers, contribution.Author.Username) {
+			pageDescription += " The author is among the page's conflicting users."
+		}
+		narrative = append(narrative, pageDescription)
+	}
+
+	// Corroborated signals
+	corroborated := 0
+	for _, signal := range report.Signals {
+		if len(signal.Sources) > 1 {
+			corroborated++
+		}
+	}
+	if corroborated > 0 {
+		narrative = append(narrative, fmt.Sprintf("%d signal(s) are corroborated by more than one analysis.", corroborated))
+	}
+
+	return narrative
+}
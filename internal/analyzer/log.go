@@ -0,0 +1,33 @@
+// internal/analyzer/log.go
+package analyzer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var (
+	logMu     sync.Mutex
+	logOutput io.Writer = os.Stdout
+)
+
+// SetLogOutput redirects the analyzers' progress messages and warnings (failed
+// secondary requests, skipped steps) to w, nil to silence them. They go to the
+// standard output by default.
+func SetLogOutput(w io.Writer) {
+	logMu.Lock()
+	defer logMu.Unlock()
+	if w == nil {
+		w = io.Discard
+	}
+	logOutput = w
+}
+
+// logf writes a progress message or warning to the log output
+func logf(format string, args ...interface{}) {
+	logMu.Lock()
+	defer logMu.Unlock()
+	fmt.Fprintf(logOutput, format, args...)
+}
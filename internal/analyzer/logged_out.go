@@ -0,0 +1,264 @@
+// internal/analyzer/logged_out.go
+package analyzer
+
+import (
+	"sort"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Logged-out editing: a registered editor logging out to make contentious edits
+// leaves an IP address editing like the account. Both need loggedOutMinEdits
+// edits; the address then matches the account when their hour-of-day
+// distributions share loggedOutMinHourOverlap, when loggedOutMinProximity of
+// its edits come within loggedOutProximityWindow of an account edit, and when
+// they focus on the same sections (page) or pages (cross-page). Every signal
+// must be there: the hours alone match many editors of an active page.
+const (
+	loggedOutMinEdits         = 5
+	loggedOutMinHourOverlap   = 0.6
+	loggedOutMinProximity     = 0.5
+	loggedOutMinFocusOverlap  = 0.5
+	loggedOutProximityWindow  = 3 * time.Hour
+	loggedOutContributorScore = 30 // Suspicion of an address found editing for an account
+)
+
+// loggedOutActivity is the editing of one author: when, and on what
+type loggedOutActivity struct {
+	name  string
+	times []time.Time // Sorted
+	focus map[string]bool
+}
+
+// add records an edit
+func (activity *loggedOutActivity) add(timestamp time.Time, focus string) {
+	activity.times = append(activity.times, timestamp)
+	if focus != "" {
+		activity.focus[focus] = true
+	}
+}
+
+// compareLoggedOut measures how closely the editing of an address mirrors an
+// account's. An address and an account that revert each other are two people
+// in a dispute, not one editor: the caller leaves such pairs out.
+func compareLoggedOut(address, account *loggedOutActivity) (models.LoggedOutEditing, bool) {
+	match := models.LoggedOutEditing{
+		Address:      address.name,
+		Account:      account.name,
+		AddressEdits: len(address.times),
+		AccountEdits: len(account.times),
+	}
+	if match.AddressEdits < loggedOutMinEdits || match.AccountEdits < loggedOutMinEdits {
+		return match, false
+	}
+
+	// Hour-of-day distributions: the share both place in the same hours
+	var addressHours, accountHours [24]float64
+	for _, timestamp := range address.times {
+		addressHours[timestamp.UTC().Hour()] += 1 / float64(len(address.times))
+	}
+	for _, timestamp := range account.times {
+		accountHours[timestamp.UTC().Hour()] += 1 / float64(len(account.times))
+	}
+	for hour := range addressHours {
+		match.HourOverlap += min(addressHours[hour], accountHours[hour])
+	}
+
+	// Complementary timing: the address edits around the account's edits
+	near := 0
+	for _, timestamp := range address.times {
+		i := sort.Search(len(account.times), func(i int) bool { return !account.times[i].Before(timestamp) })
+		if i < len(account.times) && account.times[i].Sub(timestamp) <= loggedOutProximityWindow ||
+			i > 0 && timestamp.Sub(account.times[i-1]) <= loggedOutProximityWindow {
+			near++
+		}
+	}
+	match.Proximity = float64(near) / float64(len(address.times))
+
+	// Narrow focus: the same sections or pages, which both must name
+	if len(address.focus) == 0 || len(account.focus) == 0 {
+		return match, false
+	}
+	match.FocusOverlap = jaccardSimilarity(address.focus, account.focus)
+
+	return match, match.HourOverlap >= loggedOutMinHourOverlap &&
+		match.Proximity >= loggedOutMinProximity &&
+		match.FocusOverlap >= loggedOutMinFocusOverlap
+}
+
+// pairLoggedOut compares each address with each account, skipping the pairs in
+// conflict, and keeps the closest account of each address
+func pairLoggedOut(addresses, accounts []*loggedOutActivity, conflicts map[[2]string]bool) []models.LoggedOutEditing {
+	matches := []models.LoggedOutEditing{}
+	for _, address := range addresses {
+		var best *models.LoggedOutEditing
+		for _, account := range accounts {
+			if conflicts[[2]string{address.name, account.name}] || conflicts[[2]string{account.name, address.name}] {
+				continue
+			}
+			match, ok := compareLoggedOut(address, account)
+			if ok && (best == nil || match.HourOverlap+match.Proximity > best.HourOverlap+best.Proximity) {
+				best = &match
+			}
+		}
+		if best != nil {
+			matches = append(matches, *best)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].HourOverlap+matches[i].Proximity > matches[j].HourOverlap+matches[j].Proximity
+	})
+	return matches
+}
+
+// sortedActivities returns the activities by name, their times sorted, split
+// between addresses and accounts
+func sortedActivities(activities map[string]*loggedOutActivity, anonymous map[string]bool) (addresses, accounts []*loggedOutActivity) {
+	names := make([]string, 0, len(activities))
+	for name := range activities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		activity := activities[name]
+		sort.Slice(activity.times, func(i, j int) bool { return activity.times[i].Before(activity.times[j]) })
+		if anonymous[name] {
+			addresses = append(addresses, activity)
+		} else {
+			accounts = append(accounts, activity)
+		}
+	}
+	return addresses, accounts
+}
+
+// detectLoggedOutEditing finds the IP addresses of the page's history editing
+// like one of its registered accounts: the same hours, the same sections (named
+// by the summaries' "/* Section */" markers), edits made within hours of the
+// account's, and never a revert between the two
+func (pa *PageAnalyzer) detectLoggedOutEditing(history *historyPass) []models.LoggedOutEditing {
+	activities := make(map[string]*loggedOutActivity)
+	anonymous := make(map[string]bool)
+	conflicts := make(map[[2]string]bool)
+
+	for i, rev := range history.revisions {
+		if rev.UserHidden || rev.User == "" {
+			continue
+		}
+
+		activity, exists := activities[rev.User]
+		if !exists {
+			activity = &loggedOutActivity{name: rev.User, focus: make(map[string]bool)}
+			activities[rev.User] = activity
+		}
+		section, _ := utils.SplitEditSummary(rev.Comment)
+		activity.add(history.timestamps[i], section)
+		if rev.Anon == "true" {
+			anonymous[rev.User] = true
+		}
+
+		if history.isRevert[rev.RevID] {
+			if parent, exists := history.revisionsByID[rev.ParentID]; exists && !parent.UserHidden && parent.User != rev.User {
+				conflicts[[2]string{rev.User, parent.User}] = true
+			}
+		}
+	}
+
+	addresses, accounts := sortedActivities(activities, anonymous)
+	return pairLoggedOut(addresses, accounts, conflicts)
+}
+
+// markLoggedOutEditing flags the addresses and accounts found editing alike, and
+// raises the suspicion of the addresses
+func markLoggedOutEditing(profile *models.PageProfile) {
+	if len(profile.LoggedOutEditing) == 0 {
+		return
+	}
+
+	names := make(map[string]bool)
+	for _, match := range profile.LoggedOutEditing {
+		names[match.Address] = true
+		names[match.Account] = true
+	}
+
+	for i := range profile.Contributors {
+		contributor := &profile.Contributors[i]
+		if !names[contributor.Username] || utils.Contains(contributor.SuspicionFlags, "POSSIBLE_LOGGED_OUT_EDITING") {
+			continue
+		}
+		contributor.SuspicionFlags = append(contributor.SuspicionFlags, "POSSIBLE_LOGGED_OUT_EDITING")
+		if contributor.IsAnonymous {
+			contributor.SuspicionScore = max(contributor.SuspicionScore, loggedOutContributorScore)
+		}
+	}
+}
+
+// detectLoggedOutEditing finds the IP addresses editing the analyzed pages like
+// one of the accounts: the same hours, the same narrow set of pages, edits made
+// within hours of the account's, and never a revert of one right after the other.
+// Flags and suspicion are raised as on a page.
+func (cpa *CrossPageAnalyzer) detectLoggedOutEditing(contributors []models.CommonContributor, revisions []models.EditEvent) []models.LoggedOutEditing {
+	anonymous := make(map[string]bool)
+	for _, contributor := range contributors {
+		anonymous[contributor.Username] = contributor.IsAnonymous
+	}
+
+	activities := make(map[string]*loggedOutActivity)
+	for _, revision := range revisions {
+		if _, known := anonymous[revision.Username]; !known {
+			continue
+		}
+		activity, exists := activities[revision.Username]
+		if !exists {
+			activity = &loggedOutActivity{name: revision.Username, focus: make(map[string]bool)}
+			activities[revision.Username] = activity
+		}
+		activity.add(revision.Timestamp, revision.PageTitle)
+	}
+
+	// A revert right after another author's edit on the same page undoes it
+	byPage := make(map[string][]models.EditEvent)
+	for _, revision := range revisions {
+		byPage[revision.PageTitle] = append(byPage[revision.PageTitle], revision)
+	}
+	conflicts := make(map[[2]string]bool)
+	for _, events := range byPage {
+		sort.Slice(events, func(i, j int) bool { return events[i].Timestamp.Before(events[j].Timestamp) })
+		for i := 1; i < len(events); i++ {
+			if events[i].IsRevert && events[i].Username != events[i-1].Username {
+				conflicts[[2]string{events[i].Username, events[i-1].Username}] = true
+			}
+		}
+	}
+
+	addresses, accounts := sortedActivities(activities, anonymous)
+	matches := pairLoggedOut(addresses, accounts, conflicts)
+
+	names := make(map[string]bool)
+	for i := range matches {
+		for page := range activities[matches[i].Address].focus {
+			if activities[matches[i].Account].focus[page] {
+				matches[i].Pages = append(matches[i].Pages, page)
+			}
+		}
+		sort.Strings(matches[i].Pages)
+		names[matches[i].Address] = true
+		names[matches[i].Account] = true
+	}
+
+	for i := range contributors {
+		contributor := &contributors[i]
+		if !names[contributor.Username] || utils.Contains(contributor.SuspicionFlags, "POSSIBLE_LOGGED_OUT_EDITING") {
+			continue
+		}
+		contributor.SuspicionFlags = append(contributor.SuspicionFlags, "POSSIBLE_LOGGED_OUT_EDITING")
+		if contributor.IsAnonymous {
+			contributor.SuspicionScore = max(contributor.SuspicionScore, loggedOutContributorScore)
+		}
+	}
+
+	return matches
+}
@@ -0,0 +1,83 @@
+// internal/analyzer/low_profile.go
+package analyzer
+
+import (
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Low-profile sustained campaign: many small, spread-out edits to one article,
+// each too minor and too isolated to draw a patroller's attention, adding up to a
+// slow rewrite. The pattern needs lowProfileMinEdits edits to the user's most
+// edited article, lowProfileMinShare of the analyzed sample, averaging at most
+// lowProfileMaxAverageBytes, over lowProfileMinSpanDays or more with never more
+// than lowProfileMaxDailyEdits on one day.
+const (
+	lowProfileMinEdits        = 15
+	lowProfileMinShare        = 0.4
+	lowProfileMaxAverageBytes = 150
+	lowProfileMinSpanDays     = 90
+	lowProfileMaxDailyEdits   = 2
+)
+
+// analyzeLowProfileCampaign looks for a user chipping at their most edited
+// article in small, spread-out edits. The article counts as contentious when the
+// top pages conflict scan found it disputed or when some of the user's edits to
+// it were reverted, by change tags or by the revoked contributions analysis.
+// Returns nil when the edits do not follow the pattern.
+func (ua *UserAnalyzer) analyzeLowProfileCampaign(contributions []models.WikiContribution, topPages []models.PageEditSummary, revoked []models.RevokedContribution) *models.LowProfileCampaign {
+	if len(topPages) == 0 || topPages[0].Namespace != 0 || len(contributions) == 0 {
+		return nil
+	}
+	page := topPages[0]
+	if page.EditCount < lowProfileMinEdits || float64(page.EditCount) < lowProfileMinShare*float64(len(contributions)) {
+		return nil
+	}
+
+	revokedRevIDs := make(map[int]bool, len(revoked))
+	for _, revokedContrib := range revoked {
+		revokedRevIDs[revokedContrib.OriginalContrib.RevID] = true
+	}
+
+	campaign := &models.LowProfileCampaign{
+		PageTitle: page.PageTitle,
+		Edits:     page.EditCount,
+		Share:     float64(page.EditCount) / float64(len(contributions)),
+		FirstEdit: page.FirstEdit,
+		LastEdit:  page.LastEdit,
+		SpanDays:  int(page.LastEdit.Sub(page.FirstEdit).Hours() / 24),
+		NetBytes:  page.TotalSizeDiff,
+		Disputed:  page.Controversy != nil && page.Controversy.Disputed,
+	}
+
+	editsPerDay := make(map[string]int)
+	for _, contrib := range contributions {
+		if contrib.PageID != page.PageID || contrib.Title != page.PageTitle {
+			continue
+		}
+		if contrib.SizeDiff < 0 {
+			campaign.ChangedBytes -= contrib.SizeDiff
+		} else {
+			campaign.ChangedBytes += contrib.SizeDiff
+		}
+		if timestamp, err := time.Parse("2006-01-02T15:04:05Z", contrib.Timestamp); err == nil {
+			day := timestamp.Format("2006-01-02")
+			editsPerDay[day]++
+			campaign.MaxDailyEdits = utils.Max(campaign.MaxDailyEdits, editsPerDay[day])
+		}
+		if revokedRevIDs[contrib.RevID] || ua.isRevokedByTags(contrib.Tags) {
+			campaign.RevertedEdits++
+		}
+	}
+	campaign.AverageBytes = campaign.ChangedBytes / campaign.Edits
+
+	if campaign.AverageBytes > lowProfileMaxAverageBytes || campaign.SpanDays < lowProfileMinSpanDays ||
+		campaign.MaxDailyEdits > lowProfileMaxDailyEdits {
+		return nil
+	}
+	campaign.Contentious = campaign.Disputed || campaign.RevertedEdits > 0
+
+	return campaign
+}
@@ -0,0 +1,97 @@
+// internal/analyzer/maintenance.go
+package analyzer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// maintenanceFixMinWords is the number of words an edit must change besides the
+// templates to count as addressing the issue of a maintenance tag it removes
+const maintenanceFixMinWords = 20
+
+// maintenanceTemplates maps the normalized names of the maintenance and cleanup
+// templates, redirects and aliases included, to their canonical name. Their
+// removal without improving the text hides an acknowledged problem from readers
+// and patrollers. Replacing a template by one of its aliases ({{fact}} by
+// {{citation needed}}) removes nothing. Names also used for ordinary templates
+// ("update", "or", "referencias") are left out.
+var maintenanceTemplates = map[string]string{
+	// Sourcing
+	"citation needed": "citation needed", "cn": "citation needed", "fact": "citation needed",
+	"unreferenced": "unreferenced", "unsourced": "unreferenced",
+	"more citations needed": "more citations needed", "refimprove": "more citations needed",
+	"blp sources": "blp sources", "blp unsourced": "blp unsourced",
+	"original research": "original research", "dubious": "dubious", "verification needed": "verification needed",
+	"référence nécessaire": "référence nécessaire", "refnec": "référence nécessaire",
+	"à sourcer": "à sourcer", "sans source": "sans source", "travail inédit": "travail inédit",
+	"belege fehlen": "belege fehlen", "cita requerida": "cita requerida", "sin referencias": "sin referencias",
+	// Neutrality
+	"pov": "pov", "npov": "pov", "neutrality": "pov", "pov section": "pov section", "pov check": "pov check",
+	"disputed": "disputed", "peacock": "peacock", "weasel": "weasel", "advert": "advert",
+	"autobiography": "autobiography", "coi": "coi", "undue weight": "undue weight",
+	"non neutre": "non neutre", "neutralité": "non neutre", "promotionnel": "promotionnel",
+	"neutralität": "neutralität", "no neutral": "no neutral", "promocional": "promocional",
+	// Cleanup
+	"cleanup": "cleanup", "multiple issues": "multiple issues", "outdated": "outdated",
+	"à wikifier": "à wikifier", "à recycler": "à recycler", "überarbeiten": "überarbeiten", "mantenimiento": "mantenimiento",
+}
+
+var (
+	// templateCallPattern matches a template call without nested templates and
+	// captures its name
+	templateCallPattern = regexp.MustCompile(`\{\{\s*([^{}|]+?)\s*(?:\|[^{}]*)?\}\}`)
+
+	// referenceTagPattern matches the opening of a reference
+	referenceTagPattern = regexp.MustCompile(`(?i)<ref[\s>/]`)
+)
+
+// maintenanceTemplateName returns the canonical name of a template call when it
+// is a maintenance template, or ""
+func maintenanceTemplateName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(strings.ReplaceAll(name, "_", " ")))
+	name = strings.TrimPrefix(name, "template:")
+	name = whitespacePattern.ReplaceAllString(name, " ")
+	return maintenanceTemplates[name]
+}
+
+// maintenanceCalls counts the maintenance templates of a wikitext by name, and
+// returns the text without them
+func maintenanceCalls(wikitext string) (map[string]int, string) {
+	counts := make(map[string]int)
+	stripped := templateCallPattern.ReplaceAllStringFunc(wikitext, func(call string) string {
+		name := maintenanceTemplateName(templateCallPattern.FindStringSubmatch(call)[1])
+		if name == "" {
+			return call
+		}
+		counts[name]++
+		return ""
+	})
+	return counts, stripped
+}
+
+// removedMaintenanceTags lists the maintenance templates an edit removed, and
+// whether it addressed their issue: adding references, or rewriting at least
+// maintenanceFixMinWords words of the text around them
+func removedMaintenanceTags(parentText, childText string) ([]string, bool) {
+	parentCalls, parentStripped := maintenanceCalls(parentText)
+	childCalls, childStripped := maintenanceCalls(childText)
+
+	var removed []string
+	for name, count := range parentCalls {
+		if count > childCalls[name] {
+			removed = append(removed, name)
+		}
+	}
+	if len(removed) == 0 {
+		return nil, false
+	}
+	sort.Strings(removed)
+
+	if len(referenceTagPattern.FindAllString(childText, -1)) > len(referenceTagPattern.FindAllString(parentText, -1)) {
+		return removed, true
+	}
+	added, deleted := changedWords(parentStripped, childStripped)
+	return removed, len(added)+len(deleted) >= maintenanceFixMinWords
+}
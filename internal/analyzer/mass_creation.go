@@ -0,0 +1,150 @@
+// internal/analyzer/mass_creation.go
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// Mass stub creation: many short articles created from one boilerplate skeleton,
+// a paid-editing and spam farming pattern
+const (
+	massCreationMinPages       = 5    // Pages sharing a skeleton before the user is flagged
+	massCreationMaxStubBytes   = 5000 // Creations larger than this are articles, not stubs
+	massCreationPairSimilarity = 0.5  // Skeleton similarity from which two stubs share a template
+	maxCreationSample          = 50   // Creations whose content is compared (one content batch)
+	skeletonShingleWords       = 3
+)
+
+// stubSkeleton reduces a stub's wikitext to its boilerplate: its subject and
+// figures are blanked so that "X is a village in Y" pages compare equal, and the
+// text is cut into overlapping word shingles
+func stubSkeleton(wikitext, title string) map[string]bool {
+	titleWords := make(map[string]bool)
+	for _, word := range wordPattern.FindAllString(strings.ToLower(title), -1) {
+		titleWords[word] = true
+	}
+
+	words := wordPattern.FindAllString(strings.ToLower(normalizeRenderedText(wikitext)), -1)
+	for i, word := range words {
+		if titleWords[word] {
+			words[i] = "title"
+		} else {
+			words[i] = digitsPattern.ReplaceAllString(word, "#")
+		}
+	}
+
+	shingles := make(map[string]bool)
+	for i := 0; i+skeletonShingleWords <= len(words); i++ {
+		shingles[strings.Join(words[i:i+skeletonShingleWords], " ")] = true
+	}
+	return shingles
+}
+
+// skeletonSimilarity is the Jaccard index of two skeletons
+func skeletonSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for shingle := range a {
+		if b[shingle] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// analyzeMassCreation compares the content of the stubs a user created in the
+// main namespace and finds the largest group built on a common skeleton: the
+// stub with the most similar siblings, and those siblings. Returns nil when the
+// sample holds fewer than two stub creations.
+func (ua *UserAnalyzer) analyzeMassCreation(contributions []models.WikiContribution) *models.MassCreation {
+	creation := &models.MassCreation{}
+	var stubs []models.WikiContribution
+	for _, contrib := range contributions {
+		if contrib.NS != 0 || contrib.ParentID != 0 {
+			continue
+		}
+		creation.CreatedPages++
+		if contrib.Size <= massCreationMaxStubBytes && len(stubs) < maxCreationSample {
+			stubs = append(stubs, contrib)
+		}
+	}
+	if len(stubs) < 2 {
+		return nil
+	}
+
+	revisionIDs := make([]int, 0, len(stubs))
+	for _, stub := range stubs {
+		revisionIDs = append(revisionIDs, stub.RevID)
+	}
+	contents, err := ua.client.GetRevisionsContent(revisionIDs)
+	if err != nil {
+		return nil
+	}
+
+	var sampled []models.WikiContribution
+	var skeletons []map[string]bool
+	for _, stub := range stubs {
+		if text, exists := contents[stub.RevID]; exists {
+			sampled = append(sampled, stub)
+			skeletons = append(skeletons, stubSkeleton(text, stub.Title))
+		}
+	}
+	creation.SampledPages = len(sampled)
+	if len(sampled) < 2 {
+		return nil
+	}
+
+	// Pairwise similarities, and the stub with the most similar siblings
+	similarity := make([][]float64, len(sampled))
+	for i := range similarity {
+		similarity[i] = make([]float64, len(sampled))
+	}
+	center, centerSiblings := -1, 0
+	for i := range sampled {
+		siblings := 0
+		for j := range sampled {
+			if i == j {
+				continue
+			}
+			if j < i {
+				similarity[i][j] = similarity[j][i]
+			} else {
+				similarity[i][j] = skeletonSimilarity(skeletons[i], skeletons[j])
+			}
+			if similarity[i][j] >= massCreationPairSimilarity {
+				siblings++
+			}
+		}
+		if siblings > centerSiblings {
+			center, centerSiblings = i, siblings
+		}
+	}
+	if center < 0 {
+		return creation
+	}
+
+	group := []int{center}
+	for j := range sampled {
+		if j != center && similarity[center][j] >= massCreationPairSimilarity {
+			group = append(group, j)
+		}
+	}
+
+	totalSimilarity, pairs, totalSize := 0.0, 0, 0
+	for a, i := range group {
+		creation.TemplatePages = append(creation.TemplatePages, sampled[i].Title)
+		totalSize += sampled[i].Size
+		for _, j := range group[a+1:] {
+			totalSimilarity += similarity[i][j]
+			pairs++
+		}
+	}
+	creation.TemplateSimilarity = totalSimilarity / float64(pairs)
+	creation.AverageStubBytes = totalSize / len(group)
+
+	return creation
+}
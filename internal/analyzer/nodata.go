@@ -0,0 +1,9 @@
+// internal/analyzer/nodata.go
+package analyzer
+
+import "errors"
+
+// ErrNoData is returned when an entity exists but has nothing to analyze (a user
+// without visible contributions, a page without revisions), rather than a report
+// of zeros
+var ErrNoData = errors.New("no data to analyze")
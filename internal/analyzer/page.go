@@ -3,6 +3,7 @@ package analyzer
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -10,25 +11,89 @@ import (
 	"github.com/intMeric/wikipedia-analyser/internal/client"
 
 	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/proxies"
+	"github.com/intMeric/wikipedia-analyser/internal/sources"
 	"github.com/intMeric/wikipedia-analyser/internal/utils"
 )
 
 // PageAnalyzer analyzes Wikipedia page data
 type PageAnalyzer struct {
 	client                *client.WikipediaClient
-	numberOfPageRevisions int  // Number of revisions to analyze
-	numberOfDaysHistory   int  // Number of days for detailed history
-	numberOfContributors  int  // Number of contributors to analyze
-	analyzeSources        bool // Whether to analyze page sources
+	numberOfPageRevisions int   // Number of revisions to analyze
+	numberOfDaysHistory   int   // Number of days for detailed history
+	numberOfContributors  int   // Number of contributors to analyze
+	analyzeSources        bool  // Whether to analyze page sources
+	analyzePageViews      bool  // Whether to correlate activity with pageviews
+	countSelfReverts      bool  // Whether self-reverts count as conflicts
+	relativeScoring       bool  // Whether activity thresholds scale with the page's median contributor
+	followMoves           bool  // Whether history left under former titles is included
+	analyzeTalkPage       bool  // Whether talk-page activity weighs into the controversy assessment
+	checkProtection       bool  // Whether the protection log is replayed for editing rushes once protection ended
+	minRevisions          int   // History revisions needed before ratio metrics are scored
+	frequencyWindows      []int // Edit frequency windows in days, shortest first
+	conflictWindow        int   // Days within which a revert counts as a recent conflict
+	domainClassifier      *sources.DomainClassifier
+	proxyResolver         proxies.Resolver
 }
 
 type PageAnalysisOptions struct {
-	NumberOfPageRevisions int  // Number of revisions to analyze
-	NumberOfDaysHistory   int  // Number of days for detailed history
-	NumberOfContributors  int  // Number of contributors to analyze
-	AnalyzeSources        bool // Whether to analyze page sources
+	NumberOfPageRevisions int   // Number of revisions to analyze
+	NumberOfDaysHistory   int   // Number of days for detailed history
+	NumberOfContributors  int   // Number of contributors to analyze
+	AnalyzeSources        bool  // Whether to analyze page sources
+	AnalyzePageViews      bool  // Whether to correlate activity with pageviews
+	CountSelfReverts      bool  // Whether self-reverts count as conflicts
+	RelativeScoring       bool  // Whether activity thresholds scale with the page's median contributor
+	FollowMoves           bool  // Whether history left under former titles is included
+	AnalyzeTalkPage       bool  // Whether talk-page activity weighs into the controversy assessment
+	CheckProtection       bool  // Whether the protection log is replayed for editing rushes once protection ended
+	MinRevisions          int   // History revisions needed before ratio metrics are scored
+	FrequencyWindows      []int // Edit frequency windows in days (default 7, 30, 90)
+	ConflictWindow        int   // Days within which a revert counts as a recent conflict (default 7, the CLI rejects 0)
+
+	// DomainClassifier rates reference domains (default: the embedded perennial sources dataset)
+	DomainClassifier *sources.DomainClassifier
+
+	// ProxyResolver checks anonymous contributors against hosting, VPN and proxy
+	// ranges (default: no check)
+	ProxyResolver proxies.Resolver
 }
 
+// Validate rejects option values the analyzer cannot honor. Zero values select
+// the defaults.
+func (o PageAnalysisOptions) Validate() error {
+	if o.NumberOfPageRevisions < 0 {
+		return fmt.Errorf("number of revisions to analyze cannot be negative (got %d)", o.NumberOfPageRevisions)
+	}
+	if o.NumberOfDaysHistory < 0 {
+		return fmt.Errorf("number of history days cannot be negative (got %d)", o.NumberOfDaysHistory)
+	}
+	if o.NumberOfContributors < 0 {
+		return fmt.Errorf("number of contributors to analyze cannot be negative (got %d)", o.NumberOfContributors)
+	}
+	if o.MinRevisions < 0 {
+		return fmt.Errorf("minimum revisions for ratio metrics cannot be negative (got %d)", o.MinRevisions)
+	}
+	seen := make(map[int]bool)
+	for _, days := range o.FrequencyWindows {
+		if days <= 0 {
+			return fmt.Errorf("edit frequency windows must be positive (got %d)", days)
+		}
+		if seen[days] {
+			return fmt.Errorf("edit frequency window %d given twice", days)
+		}
+		seen[days] = true
+	}
+	if o.ConflictWindow < 0 {
+		return fmt.Errorf("recent conflict window cannot be negative (got %d)", o.ConflictWindow)
+	}
+	return nil
+}
+
+// defaultFrequencyWindows are the edit frequency windows, in days, used when none
+// are configured
+var defaultFrequencyWindows = []int{7, 30, 90}
+
 // NewPageAnalyzer creates a new page analyzer
 func NewPageAnalyzer(client *client.WikipediaClient, pageAnalysisOptions PageAnalysisOptions) *PageAnalyzer {
 	return &PageAnalyzer{
@@ -37,9 +102,32 @@ func NewPageAnalyzer(client *client.WikipediaClient, pageAnalysisOptions PageAna
 		numberOfDaysHistory:   utils.SetOrDefault(pageAnalysisOptions.NumberOfDaysHistory, 30),
 		numberOfContributors:  utils.SetOrDefault(pageAnalysisOptions.NumberOfContributors, 20),
 		analyzeSources:        pageAnalysisOptions.AnalyzeSources,
+		analyzePageViews:      pageAnalysisOptions.AnalyzePageViews,
+		countSelfReverts:      pageAnalysisOptions.CountSelfReverts,
+		relativeScoring:       pageAnalysisOptions.RelativeScoring,
+		followMoves:           pageAnalysisOptions.FollowMoves,
+		analyzeTalkPage:       pageAnalysisOptions.AnalyzeTalkPage,
+		checkProtection:       pageAnalysisOptions.CheckProtection,
+		minRevisions:          utils.SetOrDefault(pageAnalysisOptions.MinRevisions, 5),
+		frequencyWindows:      sortedWindows(pageAnalysisOptions.FrequencyWindows),
+		conflictWindow:        utils.SetOrDefault(pageAnalysisOptions.ConflictWindow, 7),
+		domainClassifier:      pageAnalysisOptions.DomainClassifier,
+		proxyResolver:         pageAnalysisOptions.ProxyResolver,
 	}
 }
 
+// sortedWindows returns the configured frequency windows shortest first, or the
+// default windows
+func sortedWindows(windows []int) []int {
+	if len(windows) == 0 {
+		return defaultFrequencyWindows
+	}
+	sorted := make([]int, len(windows))
+	copy(sorted, windows)
+	sort.Ints(sorted)
+	return sorted
+}
+
 // GetPageProfile retrieves and analyzes a complete page profile
 func (pa *PageAnalyzer) GetPageProfile(title string) (*models.PageProfile, error) {
 	// 1. Get basic page information
@@ -47,46 +135,96 @@ func (pa *PageAnalyzer) GetPageProfile(title string) (*models.PageProfile, error
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve page info: %w", err)
 	}
+	// The canonical title from here on ("donald trump" becomes "Donald Trump")
+	title = pageInfo.Title
 
 	// 2. Get recent revisions (last 100)
 	revisions, err := pa.client.GetPageRevisions(title, pa.numberOfPageRevisions)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve page revisions: %w", err)
 	}
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("%w: %s has no visible revisions", ErrNoData, title)
+	}
+	revisions = ensureChronologicalOrder(revisions, true, title)
 
 	// 3. Get detailed history for the last 30 days
 	detailedHistory, err := pa.client.GetPageHistory(title, pa.numberOfDaysHistory)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve page history: %w", err)
 	}
+	detailedHistory = ensureChronologicalOrder(detailedHistory, false, title)
+
+	var coverage coverageReport
+	var moves []models.PageMove
+	if pa.followMoves {
+		moves, detailedHistory = pa.followPageMoves(pageInfo.Title, detailedHistory)
+		coverage.ran("Former titles (page moves)")
+	} else {
+		coverage.skipped("Former titles (page moves)", "not requested")
+	}
 
 	// 4. Get contributors
-	contributors, err := pa.client.GetPageContributors(title, pa.numberOfContributors)
+	contributors, err := pa.client.GetPageContributors(pageInfo.Title, pageInfo.PageID, pa.numberOfContributors)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve contributors: %w", err)
 	}
 
 	// 5. Create basic profile
 	profile := &models.PageProfile{
-		PageTitle:    pageInfo.Title,
-		PageID:       pageInfo.PageID,
-		Namespace:    pageInfo.NS,
-		Language:     pa.client.Language(),
-		LastModified: time.Now(), // Will be updated from revisions
-		PageSize:     pageInfo.Length,
-		RetrievedAt:  time.Now(),
+		PageTitle:      pageInfo.Title,
+		RequestedTitle: pageInfo.NormalizedFrom,
+		PageID:         pageInfo.PageID,
+		Namespace:      pageInfo.NS,
+		Language:       pa.client.Language(),
+		LastModified:   time.Now(), // Will be updated from revisions
+		PageSize:       pageInfo.Length,
+		Moves:          moves,
+		RetrievedAt:    time.Now(),
 	}
 
 	// 6. Process revisions and calculate metrics
 	profile.RecentRevisions = pa.convertRevisions(revisions)
 	profile.TotalRevisions = len(revisions) // This would need a separate API call for exact count
 
-	// 7. Analyze contributors
-	profile.Contributors = pa.analyzeContributors(detailedHistory, contributors)
+	// The detailed history is walked once for the contributor, conflict and quality analyses
+	history := pa.newHistoryPass(detailedHistory)
+
+	// 7. Analyze contributors, against the page's own activity level if requested
+	if pa.relativeScoring {
+		profile.ActivityBaseline = computeActivityBaseline(history)
+	}
+	profile.Contributors = pa.analyzeContributors(pageInfo.Title, history, contributors, profile.ActivityBaseline)
+	pa.checkAnonymousProxies(profile.Contributors, &coverage)
+	profile.CoordinatedArrival = detectCoordinatedArrival(profile.Contributors)
 
 	// 8. Analyze conflicts and quality
-	profile.ConflictStats = pa.analyzeConflicts(detailedHistory)
-	profile.QualityMetrics = pa.analyzeQuality(detailedHistory, profile.Contributors)
+	profile.ConflictStats = pa.analyzeConflicts(history)
+	markReintroducedVandalism(profile)
+	profile.LoggedOutEditing = pa.detectLoggedOutEditing(history)
+	markLoggedOutEditing(profile)
+	profile.QualityMetrics = pa.analyzeQuality(history, profile.Contributors)
+	profile.HistoryRevisions = len(detailedHistory)
+	profile.InsufficientHistory = len(detailedHistory) < pa.minRevisions
+	// Editing rush once an edit protection ended: the dispute it held back resumes
+	if pa.checkProtection {
+		protectionLog, err := pa.client.GetProtectionLog(pageInfo.Title)
+		if err != nil {
+			logf("⚠️ [PAGE ANALYZER] Unable to retrieve protection log of %s: %v\n", pageInfo.Title, err)
+			coverage.unavailable("Protection expiry surge", fmt.Sprintf("protection log request failed: %v", err))
+		} else {
+			profile.ConflictStats.ProtectionSurge = pa.detectProtectionExpirySurge(protectionLog, detailedHistory, time.Now())
+			coverage.ran("Protection expiry surge")
+		}
+	} else {
+		coverage.skipped("Protection expiry surge", "not requested")
+	}
+
+	if profile.InsufficientHistory {
+		coverage.skipped("Stability and controversy scoring", fmt.Sprintf("%d history revisions, %d needed", len(detailedHistory), pa.minRevisions))
+	} else {
+		coverage.ran("Stability and controversy scoring")
+	}
 
 	// 9. Calculate creation date from oldest revision
 	if len(revisions) > 0 {
@@ -104,21 +242,182 @@ func (pa *PageAnalyzer) GetPageProfile(title string) (*models.PageProfile, error
 		if err != nil {
 			// Don't fail the entire analysis if source analysis fails
 			profile.SuspicionFlags = append(profile.SuspicionFlags, "Source analysis failed")
+			coverage.unavailable("Source analysis", fmt.Sprintf("wikitext request failed: %v", err))
 		} else {
-			sourceAnalyzer := NewSourceAnalyzer()
+			sourceAnalyzer := NewSourceAnalyzer(pa.domainClassifier)
 			profile.SourceAnalysis = sourceAnalyzer.AnalyzePageSources(wikitext)
+			profile.SourceAnalysis.ReferenceChurn = pa.analyzeReferenceChurn(sourceAnalyzer, detailedHistory)
+			pa.flagCitationRemovers(profile)
+			pa.flagUnsourcedAdders(profile)
+			coverage.ran("Source analysis")
+		}
+	} else {
+		coverage.skipped("Source analysis", "not requested")
+	}
+
+	// 11. Correlate editing activity with reader traffic if requested
+	if pa.analyzePageViews && !pa.client.Capabilities().PageViews() {
+		coverage.unavailable("Pageview correlation", "the wiki publishes no pageview statistics")
+	} else if pa.analyzePageViews {
+		pageViews, err := pa.client.GetPageViews(pageInfo.Title, utils.Max(pa.numberOfDaysHistory, 30))
+		switch {
+		case err != nil:
+			coverage.unavailable("Pageview correlation", fmt.Sprintf("pageviews request failed: %v", err))
+		case len(pageViews) == 0:
+			coverage.unavailable("Pageview correlation", "no pageview data")
+		default:
+			profile.PageViews = pageViews
+			profile.QualityMetrics.TrafficContext = pa.analyzeTrafficContext(pageViews, profile.QualityMetrics.RecentActivityBurst)
+			coverage.ran("Pageview correlation")
+		}
+	} else {
+		coverage.skipped("Pageview correlation", "not requested")
+	}
+
+	// 12. Weigh the reverts with the talk page discussion if requested
+	if pa.analyzeTalkPage {
+		profile.ConflictStats.TalkActivity = pa.analyzeTalkActivity(pageInfo, profile.ConflictStats)
+		if talk := profile.ConflictStats.TalkActivity; talk != nil {
+			profile.ConflictStats.CombinedControversy = combinedControversy(profile.ConflictStats.ControversyScore, talk.HeatScore)
+			coverage.ran("Talk page discussion")
+		} else {
+			coverage.unavailable("Talk page discussion", "no talk page, or its history could not be retrieved")
+		}
+	} else {
+		coverage.skipped("Talk page discussion", "not requested")
+	}
+
+	// 13. Tally the !votes of a deletion discussion
+	if isDeletionDiscussion(pageInfo.Title, pageInfo.NS) {
+		wikitext, err := pa.client.GetPageWikitext(pageInfo.Title)
+		if err != nil {
+			logf("⚠️ [PAGE ANALYZER] Unable to retrieve wikitext of %s: %v\n", pageInfo.Title, err)
+			coverage.unavailable("Deletion discussion votes", fmt.Sprintf("wikitext request failed: %v", err))
+		} else {
+			profile.DeletionDiscussion = pa.analyzeDeletionDiscussion(wikitext, detailedHistory)
+			coverage.ran("Deletion discussion votes")
 		}
 	}
+	profile.Coverage = coverage.result(pa.client)
 
-	// 11. Calculate suspicion score
-	profile.SuspicionScore, profile.SuspicionFlags = pa.calculateSuspicionScore(profile)
+	// 14. Calculate suspicion score
+	profile.SuspicionScore, profile.SuspicionFlags, profile.ScoreBreakdown = pa.calculateSuspicionScore(profile)
 
 	return profile, nil
 }
 
+// followPageMoves finds the renames of a page and merges in the history still
+// recorded under its former titles (e.g. after a cut-and-paste move or a history
+// split), so the analysis does not stop at the latest rename
+func (pa *PageAnalyzer) followPageMoves(title string, history []models.WikiRevision) ([]models.PageMove, []models.WikiRevision) {
+	events, err := pa.client.GetPageMoves(title)
+	if err != nil {
+		logf("⚠️ [PAGE ANALYZER] Unable to retrieve moves of %s: %v\n", title, err)
+		return nil, history
+	}
+
+	known := make(map[int]bool, len(history))
+	for _, rev := range history {
+		known[rev.RevID] = true
+	}
+
+	var moves []models.PageMove
+	for _, event := range events {
+		movedAt, _ := time.Parse("2006-01-02T15:04:05Z", event.Timestamp)
+		move := models.PageMove{
+			FromTitle: event.Title,
+			ToTitle:   event.TargetTitle,
+			MovedBy:   event.User,
+			MovedAt:   movedAt,
+			Comment:   event.Comment,
+		}
+
+		formerHistory, err := pa.client.GetPageHistory(event.Title, pa.numberOfDaysHistory)
+		if err != nil {
+			logf("⚠️ [PAGE ANALYZER] Unable to retrieve history of former title %s: %v\n", event.Title, err)
+		}
+		for _, rev := range formerHistory {
+			// From the move on, the former title holds the redirect left behind,
+			// not the article
+			revTime, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+			if !revTime.Before(movedAt) {
+				continue
+			}
+			if !known[rev.RevID] {
+				known[rev.RevID] = true
+				history = append(history, rev)
+				move.RevisionsAdded++
+			}
+		}
+
+		moves = append(moves, move)
+	}
+
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].MovedAt.Before(moves[j].MovedAt)
+	})
+
+	// Merged revisions interleave with the current ones
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].Timestamp < history[j].Timestamp
+	})
+
+	return moves, history
+}
+
+// ensureChronologicalOrder checks that revisions are ordered by timestamp in the
+// expected direction (newest first, or oldest first for history queries).
+// Imports and some tools produce out-of-order timestamps, which would break parent
+// diffing and edit-war windows, so such sets are re-sorted with a warning.
+func ensureChronologicalOrder(revisions []models.WikiRevision, newestFirst bool, pageTitle string) []models.WikiRevision {
+	timestamps := make(map[int]time.Time, len(revisions))
+	for _, rev := range revisions {
+		timestamps[rev.RevID], _ = time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+	}
+
+	inOrder := func(earlier, later models.WikiRevision) bool {
+		if newestFirst {
+			return !timestamps[earlier.RevID].Before(timestamps[later.RevID])
+		}
+		return !timestamps[earlier.RevID].After(timestamps[later.RevID])
+	}
+
+	outOfOrder := 0
+	for i := 1; i < len(revisions); i++ {
+		if !inOrder(revisions[i-1], revisions[i]) {
+			outOfOrder++
+		}
+	}
+
+	if outOfOrder == 0 {
+		return revisions
+	}
+
+	logf("⚠️ [PAGE ANALYZER] %d out-of-order revision timestamps on %s (imported or back-dated edits?), re-sorting by timestamp\n",
+		outOfOrder, pageTitle)
+
+	sorted := make([]models.WikiRevision, len(revisions))
+	copy(sorted, revisions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := timestamps[sorted[i].RevID], timestamps[sorted[j].RevID]
+		if newestFirst {
+			return ti.After(tj)
+		}
+		return ti.Before(tj)
+	})
+
+	return sorted
+}
+
 // convertRevisions converts API revisions to internal model
 func (pa *PageAnalyzer) convertRevisions(wikiRevisions []models.WikiRevision) []models.Revision {
 	revisions := make([]models.Revision, 0, len(wikiRevisions))
+	revertKinds := pa.classifyReverts(wikiRevisions, true)
+
+	revisionsByID := make(map[int]models.WikiRevision, len(wikiRevisions))
+	for _, wr := range wikiRevisions {
+		revisionsByID[wr.RevID] = wr
+	}
 
 	var lastSize int
 	for i, wr := range wikiRevisions {
@@ -142,7 +441,17 @@ func (pa *PageAnalyzer) convertRevisions(wikiRevisions []models.WikiRevision) []
 			NewSize:     wr.Size,
 			IsMinor:     wr.Minor == "true",
 			IsAnonymous: wr.Anon == "true",
-			IsRevert:    pa.detectRevert(wr.Comment),
+			IsRevert:    pa.isRevertRevision(wr),
+			RevertKind:  revertKinds[wr.RevID],
+			IsHidden:    wr.UserHidden,
+			Tags:        wr.Tags,
+		}
+		revision.Section, _ = utils.SplitEditSummary(wr.Comment)
+		if revision.IsRevert {
+			if delay, known := revertDelay(wr, revisionsByID); known {
+				revision.RevertDelaySeconds = int(delay.Seconds())
+				revision.ToolAssisted = delay < toolAssistedRevertDelay
+			}
 		}
 
 		revisions = append(revisions, revision)
@@ -151,41 +460,22 @@ func (pa *PageAnalyzer) convertRevisions(wikiRevisions []models.WikiRevision) []
 	return revisions
 }
 
-// analyzeContributors analyzes page contributors and their patterns
-func (pa *PageAnalyzer) analyzeContributors(revisions []models.WikiRevision, contributors []models.WikiContributor) []models.TopContributor {
-	contributorStats := make(map[string]*models.TopContributor)
-
-	// Process revisions to build contributor statistics
-	for _, rev := range revisions {
-		timestamp, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
-
-		if existing, exists := contributorStats[rev.User]; exists {
-			existing.EditCount++
-			existing.TotalSizeDiff += rev.Size // This is approximate
-
-			if timestamp.After(existing.LastEdit) {
-				existing.LastEdit = timestamp
-			}
-			if timestamp.Before(existing.FirstEdit) {
-				existing.FirstEdit = timestamp
-			}
-		} else {
-			contributorStats[rev.User] = &models.TopContributor{
-				Username:      rev.User,
-				UserID:        rev.UserID,
-				EditCount:     1,
-				FirstEdit:     timestamp,
-				LastEdit:      timestamp,
-				TotalSizeDiff: rev.Size,
-				IsAnonymous:   rev.Anon == "true",
-				IsRegistered:  rev.UserID > 0,
-			}
-		}
-	}
+// The history's contributors are ranked by edit count and the first
+// topContributorsKept kept; the first contributorsProfiled of them get a full
+// user profile, which costs several API calls each
+const (
+	topContributorsKept  = 20
+	contributorsProfiled = 10
+)
 
-	// Convert to slice and sort by edit count
+// analyzeContributors analyzes page contributors and their patterns
+func (pa *PageAnalyzer) analyzeContributors(title string, history *historyPass, contributors []models.WikiContributor, baseline *models.ActivityBaseline) []models.TopContributor {
+	// Convert the contributor statistics of the history pass to a slice and sort by edit count
 	var topContributors []models.TopContributor
-	for _, contributor := range contributorStats {
+	for username, contributor := range history.contributors {
+		if visible := history.visibleSummaries[username]; visible > 0 {
+			contributor.EmptySummaryRatio = float64(contributor.EmptySummaries) / float64(visible)
+		}
 		topContributors = append(topContributors, *contributor)
 	}
 
@@ -193,27 +483,65 @@ func (pa *PageAnalyzer) analyzeContributors(revisions []models.WikiRevision, con
 		return topContributors[i].EditCount > topContributors[j].EditCount
 	})
 
-	// Limit to top 20
-	if len(topContributors) > 20 {
-		topContributors = topContributors[:20]
+	// Limit to the top contributors
+	if len(topContributors) > topContributorsKept {
+		topContributors = topContributors[:topContributorsKept]
 	}
 
 	// Analyze each top contributor individually for suspicion scores
-	pa.analyzeContributorSuspicion(topContributors)
+	pa.analyzeContributorSuspicion(title, topContributors, baseline)
 
 	return topContributors
 }
 
+// Relative scoring: absolute activity thresholds were set for a page whose median
+// contributor makes baselineReferenceMedian edits over the history window
+const (
+	baselineReferenceMedian = 2.0
+	baselineMinFactor       = 0.5 // Quiet pages lower thresholds by at most half
+)
+
+// computeActivityBaseline measures the median number of edits per contributor in
+// the history window and derives the factor applied to activity thresholds
+func computeActivityBaseline(history *historyPass) *models.ActivityBaseline {
+	if len(history.contributors) == 0 {
+		return nil
+	}
+
+	counts := make([]int, 0, len(history.contributors))
+	for _, contributor := range history.contributors {
+		counts = append(counts, contributor.EditCount)
+	}
+	sort.Ints(counts)
+
+	median := float64(counts[len(counts)/2])
+	if len(counts)%2 == 0 {
+		median = float64(counts[len(counts)/2-1]+counts[len(counts)/2]) / 2
+	}
+
+	return &models.ActivityBaseline{
+		MedianEdits:  median,
+		Contributors: len(counts),
+		Factor:       math.Max(median/baselineReferenceMedian, baselineMinFactor),
+	}
+}
+
+// activityThreshold scales an absolute edit-count threshold to the page baseline,
+// or returns it unchanged when relative scoring is off
+func activityThreshold(absolute int, baseline *models.ActivityBaseline) float64 {
+	if baseline == nil {
+		return float64(absolute)
+	}
+	return float64(absolute) * baseline.Factor
+}
+
 // analyzeContributorSuspicion analyzes each contributor individually for suspicion
-func (pa *PageAnalyzer) analyzeContributorSuspicion(contributors []models.TopContributor) {
+func (pa *PageAnalyzer) analyzeContributorSuspicion(title string, contributors []models.TopContributor, baseline *models.ActivityBaseline) {
 	// Create a user analyzer to analyze each contributor
 	userAnalyzer := NewUserAnalyzer(pa.client)
 
-	// Limit detailed analysis to top 10 contributors to avoid too many API calls
-	limit := len(contributors)
-	if limit > 10 {
-		limit = 10
-	}
+	// Limit detailed analysis to the first contributors to avoid too many API calls
+	limit := min(len(contributors), contributorsProfiled)
 
 	for i := 0; i < limit; i++ {
 		contributor := &contributors[i]
@@ -230,7 +558,7 @@ func (pa *PageAnalyzer) analyzeContributorSuspicion(contributors []models.TopCon
 		if err != nil {
 			contributor.SuspicionScore = -1
 			contributor.AnalysisError = fmt.Sprintf("Analysis failed: %v", err)
-			fmt.Printf("⚠️ [PAGES ANALYZER] Failed to analyze %s: %v\n", contributor.Username, err)
+			logf("⚠️ [PAGES ANALYZER] Failed to analyze %s: %v\n", contributor.Username, err)
 			continue
 		}
 
@@ -238,12 +566,23 @@ func (pa *PageAnalyzer) analyzeContributorSuspicion(contributors []models.TopCon
 		contributor.SuspicionScore = userProfile.SuspicionScore
 		contributor.SuspicionFlags = userProfile.SuspicionFlags
 
+		// Compare the first appearance on this page with the account age. The
+		// history window only shows recent edits, so ask for the first one explicitly.
+		if userProfile.RegistrationDate != nil {
+			contributor.AccountCreated = userProfile.RegistrationDate
+			if firstEdit, err := pa.client.GetUserFirstPageEdit(title, contributor.Username); err == nil && firstEdit != nil {
+				if firstSeen, err := time.Parse("2006-01-02T15:04:05Z", firstEdit.Timestamp); err == nil {
+					contributor.FirstSeenOnPage = &firstSeen
+				}
+			}
+		}
+
 		// Add page-specific flags based on contribution patterns
-		pageSpecificFlags := pa.analyzeContributorPageBehavior(*contributor)
+		pageSpecificFlags := pa.analyzeContributorPageBehavior(*contributor, baseline)
 		contributor.SuspicionFlags = append(contributor.SuspicionFlags, pageSpecificFlags...)
 	}
 
-	// For contributors beyond the top 10, set basic suspicion indicators
+	// For contributors beyond the profiled ones, set basic suspicion indicators
 	for i := limit; i < len(contributors); i++ {
 		contributor := &contributors[i]
 
@@ -252,24 +591,91 @@ func (pa *PageAnalyzer) analyzeContributorSuspicion(contributors []models.TopCon
 			contributor.SuspicionFlags = []string{"ANONYMOUS_USER"}
 		} else {
 			// Basic analysis without full API call
-			contributor.SuspicionScore = pa.calculateBasicContributorSuspicion(*contributor)
-			contributor.SuspicionFlags = pa.analyzeContributorPageBehavior(*contributor)
+			contributor.SuspicionScore = pa.calculateBasicContributorSuspicion(*contributor, baseline)
+			contributor.SuspicionFlags = pa.analyzeContributorPageBehavior(*contributor, baseline)
 		}
 	}
 }
 
+// Coordinated arrival: this many accounts making their first edit to the page
+// within the window
+const (
+	coordinatedArrivalWindow      = time.Hour
+	coordinatedArrivalMinAccounts = 5
+)
+
+// detectCoordinatedArrival finds the largest group of registered contributors
+// whose first edit to the page lands within coordinatedArrivalWindow. The first
+// edit ever is used when known (top contributors), the first one in the history
+// window otherwise.
+func detectCoordinatedArrival(contributors []models.TopContributor) *models.CoordinatedArrival {
+	type arrival struct {
+		username string
+		at       time.Time
+	}
+
+	var arrivals []arrival
+	for _, contributor := range contributors {
+		if contributor.IsAnonymous {
+			continue
+		}
+		firstEdit := contributor.FirstEdit
+		if contributor.FirstSeenOnPage != nil {
+			firstEdit = *contributor.FirstSeenOnPage
+		}
+		arrivals = append(arrivals, arrival{username: contributor.Username, at: firstEdit})
+	}
+
+	sort.Slice(arrivals, func(i, j int) bool {
+		return arrivals[i].at.Before(arrivals[j].at)
+	})
+
+	bestStart, bestEnd := 0, -1
+	start := 0
+	for end := range arrivals {
+		for arrivals[end].at.Sub(arrivals[start].at) > coordinatedArrivalWindow {
+			start++
+		}
+		if end-start > bestEnd-bestStart {
+			bestStart, bestEnd = start, end
+		}
+	}
+
+	if bestEnd-bestStart+1 < coordinatedArrivalMinAccounts {
+		return nil
+	}
+
+	group := &models.CoordinatedArrival{
+		WindowStart:   arrivals[bestStart].at,
+		WindowEnd:     arrivals[bestEnd].at,
+		WindowMinutes: int(coordinatedArrivalWindow.Minutes()),
+	}
+	for _, a := range arrivals[bestStart : bestEnd+1] {
+		group.Accounts = append(group.Accounts, a.username)
+	}
+
+	return group
+}
+
+// First-appearance thresholds for page contributors
+const (
+	recentArrivalDays      = 30  // First edit on the page within this many days
+	establishedAccountDays = 365 // Account age at that first edit considered established
+)
+
 // analyzeContributorPageBehavior analyzes contributor behavior specific to this page
-func (pa *PageAnalyzer) analyzeContributorPageBehavior(contributor models.TopContributor) []string {
+func (pa *PageAnalyzer) analyzeContributorPageBehavior(contributor models.TopContributor, baseline *models.ActivityBaseline) []string {
 	var flags []string
+	editCount := float64(contributor.EditCount)
 
 	// High edit concentration on this page
-	if contributor.EditCount > 50 {
+	if editCount > activityThreshold(50, baseline) {
 		flags = append(flags, "HIGH_PAGE_ACTIVITY")
 	}
 
 	// Recent account with high activity on this page
 	daysSinceFirstEdit := int(time.Since(contributor.FirstEdit).Hours() / 24)
-	if daysSinceFirstEdit < 7 && contributor.EditCount > 10 {
+	if daysSinceFirstEdit < 7 && editCount > activityThreshold(10, baseline) {
 		flags = append(flags, "NEW_ACCOUNT_HIGH_PAGE_ACTIVITY")
 	}
 
@@ -284,21 +690,34 @@ func (pa *PageAnalyzer) analyzeContributorPageBehavior(contributor models.TopCon
 		flags = append(flags, "LARGE_CONTENT_CHANGES")
 	}
 
+	// Recent arrival on the page: an established account suddenly showing up here
+	// means something different from a brand new account created for it
+	if contributor.FirstSeenOnPage != nil && contributor.AccountCreated != nil &&
+		time.Since(*contributor.FirstSeenOnPage) < recentArrivalDays*24*time.Hour {
+		accountAgeDays := contributor.FirstSeenOnPage.Sub(*contributor.AccountCreated).Hours() / 24
+		if accountAgeDays >= establishedAccountDays {
+			flags = append(flags, "NEW_TO_PAGE_OLD_ACCOUNT")
+		} else if accountAgeDays < recentArrivalDays {
+			flags = append(flags, "NEW_ACCOUNT_NEW_TO_PAGE")
+		}
+	}
+
 	return flags
 }
 
 // calculateBasicContributorSuspicion calculates a basic suspicion score without full API analysis
-func (pa *PageAnalyzer) calculateBasicContributorSuspicion(contributor models.TopContributor) int {
+func (pa *PageAnalyzer) calculateBasicContributorSuspicion(contributor models.TopContributor, baseline *models.ActivityBaseline) int {
 	score := 0
+	editCount := float64(contributor.EditCount)
 
 	// Recent account with high activity
 	daysSinceFirstEdit := int(time.Since(contributor.FirstEdit).Hours() / 24)
-	if daysSinceFirstEdit < 30 && contributor.EditCount > 20 {
+	if daysSinceFirstEdit < 30 && editCount > activityThreshold(20, baseline) {
 		score += 15
 	}
 
 	// Very high activity on single page
-	if contributor.EditCount > 100 {
+	if editCount > activityThreshold(100, baseline) {
 		score += 10
 	}
 
@@ -308,7 +727,7 @@ func (pa *PageAnalyzer) calculateBasicContributorSuspicion(contributor models.To
 	}
 
 	// Very recent registration and activity
-	if daysSinceFirstEdit < 7 && contributor.EditCount > 5 {
+	if daysSinceFirstEdit < 7 && editCount > activityThreshold(5, baseline) {
 		score += 20
 	}
 
@@ -316,137 +735,366 @@ func (pa *PageAnalyzer) calculateBasicContributorSuspicion(contributor models.To
 }
 
 // analyzeConflicts detects edit wars and conflicts
-func (pa *PageAnalyzer) analyzeConflicts(revisions []models.WikiRevision) models.ConflictStats {
+func (pa *PageAnalyzer) analyzeConflicts(history *historyPass) models.ConflictStats {
 	stats := models.ConflictStats{
 		ConflictingUsers: make([]string, 0),
 		EditWarPeriods:   make([]models.EditWarPeriod, 0),
 	}
 
+	revisions := history.revisions
 	if len(revisions) == 0 {
 		return stats
 	}
 
+	// The history pass indexes revisions to find the author of the reverted revision
+	revisionsByID := history.revisionsByID
+
+	revertKinds := pa.classifyReverts(revisions, false)
+
 	// Count reversions by looking for revert keywords in comments
 	reversions := 0
+	weightedReversions := 0.0
 	conflictUsers := make(map[string]bool)
 	recentConflicts := 0
+	recentSince := time.Now().AddDate(0, 0, -pa.conflictWindow)
 	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
 
-	for _, rev := range revisions {
-		timestamp, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+	for _, i := range history.revertIndexes {
+		rev := revisions[i]
 
-		if pa.detectRevert(rev.Comment) {
-			reversions++
+		// A user undoing their own edit is not a conflict
+		if history.isSelfRevert[rev.RevID] {
+			stats.SelfReverts++
+			if !pa.countSelfReverts {
+				continue
+			}
+		}
+
+		reversions++
+		weightedReversions += pa.revertWeight(rev, revisionsByID)
+		if isToolAssistedRevert(rev, revisionsByID) {
+			stats.ToolAssistedReverts++
+		}
+		switch revertKinds[rev.RevID] {
+		case revertKindFull:
+			stats.FullReverts++
+		case revertKindPartial:
+			stats.PartialReverts++
+		}
+		if !rev.UserHidden {
 			conflictUsers[rev.User] = true
+		}
 
-			if timestamp.After(sevenDaysAgo) {
-				recentConflicts++
-			}
+		if history.timestamps[i].After(recentSince) {
+			recentConflicts++
+		}
+		if history.timestamps[i].After(sevenDaysAgo) {
+			stats.RecentConflicts7Days++
 		}
 	}
 
 	stats.ReversionsCount = reversions
+	stats.WeightedReverts = weightedReversions
 	stats.RecentConflicts = recentConflicts
+	stats.ConflictWindowDays = pa.conflictWindow
+	stats.SelfRevertsCounted = pa.countSelfReverts
 
 	// Extract conflicting users
 	for user := range conflictUsers {
 		stats.ConflictingUsers = append(stats.ConflictingUsers, user)
 	}
 
-	// Calculate stability and controversy scores
+	stats.LongestRevertChain = pa.detectLongestRevertChain(history)
+	stats.ReintroducedVandalism = pa.detectReintroducedVandalism(history)
+
+	// Calculate stability and controversy scores. Every revert of a revert in the
+	// longest chain adds to controversy: back-and-forth reverting is an edit war.
 	totalRevisions := len(revisions)
 	if totalRevisions > 0 {
 		stats.StabilityScore = 1.0 - (float64(reversions) / float64(totalRevisions))
-		stats.ControversyScore = float64(reversions) / float64(totalRevisions)
+		controversy := weightedReversions / float64(totalRevisions)
+		if chain := stats.LongestRevertChain; chain != nil {
+			controversy += float64(chain.Length-1) * revertChainWeight
+		}
+		stats.ControversyScore = math.Min(controversy, 1.0)
 	}
 
 	// Detect edit war periods (simplified detection)
-	stats.EditWarPeriods = pa.detectEditWarPeriods(revisions)
+	stats.EditWarPeriods = pa.detectEditWarPeriods(history)
+
+	stats.Ownership = pa.detectOwnership(history)
 
 	return stats
 }
 
-// analyzeQuality calculates quality metrics for the page
-func (pa *PageAnalyzer) analyzeQuality(revisions []models.WikiRevision, contributors []models.TopContributor) models.QualityMetrics {
-	metrics := models.QualityMetrics{
-		EditFrequency: models.EditFrequency{
-			EditsByDay: make(map[string]int),
-		},
+// Ownership: an editor reverting this share of everyone else's edits
+const (
+	ownershipMinRatio   = 0.5
+	ownershipMinReverts = 5
+)
+
+// detectOwnership finds the editor who reverts the largest share of other
+// editors' changes. The reverted edit is the parent of the revert.
+func (pa *PageAnalyzer) detectOwnership(history *historyPass) *models.PageOwnership {
+	revertedByUser := make(map[string]int)
+
+	for _, i := range history.revertIndexes {
+		rev := history.revisions[i]
+		if rev.UserHidden || history.isSelfRevert[rev.RevID] {
+			continue
+		}
+		if parent, exists := history.revisionsByID[rev.ParentID]; exists && !parent.UserHidden && parent.User != rev.User {
+			revertedByUser[rev.User]++
+		}
 	}
 
-	if len(revisions) == 0 {
-		return metrics
+	var owner *models.PageOwnership
+	for user, reverted := range revertedByUser {
+		othersEdits := history.attributable - history.contributors[user].EditCount
+		if reverted < ownershipMinReverts || othersEdits == 0 {
+			continue
+		}
+
+		ratio := float64(reverted) / float64(othersEdits)
+		if ratio >= ownershipMinRatio && (owner == nil || ratio > owner.Ratio) {
+			owner = &models.PageOwnership{
+				Username:      user,
+				RevertedEdits: reverted,
+				OthersEdits:   othersEdits,
+				Ratio:         ratio,
+			}
+		}
 	}
 
-	// Calculate various metrics
-	totalSizeChanges := 0
-	anonymousEdits := 0
-	newEditorEdits := 0
-	hourlyEdits := make(map[int]int)
-	recentActivity := 0
-	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
-	thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
-	ninetyDaysAgo := time.Now().AddDate(0, 0, -90)
+	return owner
+}
 
-	// Track new editors (registered within last 30 days of their first edit on this page)
-	contributorFirstEdits := make(map[string]time.Time)
+// Revert chains: reverts of reverts
+const (
+	minRevertChainLength     = 2    // A revert and the revert undoing it
+	revertChainWeight        = 0.05 // Controversy added per revert of a revert
+	minSuspiciousRevertChain = 3    // Chain length flagged in the suspicion score
+)
 
-	for _, rev := range revisions {
-		timestamp, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+// detectLongestRevertChain finds the longest run of reverts each undoing the
+// previous one. A revert undoes its parent; when content hashes are known, the
+// revert must also restore the content its parent had reverted. Self-reverts end a
+// chain unless counted as conflicts.
+func (pa *PageAnalyzer) detectLongestRevertChain(history *historyPass) *models.RevertChain {
+	revisionsByID := history.revisionsByID
+	isChainRevert := func(rev models.WikiRevision) bool {
+		return history.isRevert[rev.RevID] && (pa.countSelfReverts || !history.isSelfRevert[rev.RevID])
+	}
+
+	// chainLength[id] is the length of the chain ending with revision id
+	chainLength := make(map[int]int)
+	var lengthOf func(rev models.WikiRevision) int
+	lengthOf = func(rev models.WikiRevision) int {
+		if length, exists := chainLength[rev.RevID]; exists {
+			return length
+		}
+		length := 1
+		if parent, exists := revisionsByID[rev.ParentID]; exists && isChainRevert(parent) {
+			grandparent, known := revisionsByID[parent.ParentID]
+			if !known || rev.SHA1 == "" || grandparent.SHA1 == "" || rev.SHA1 == grandparent.SHA1 {
+				length = lengthOf(parent) + 1
+			}
+		}
+		chainLength[rev.RevID] = length
+		return length
+	}
 
-		totalSizeChanges += rev.Size
+	var last models.WikiRevision
+	longest := 0
+	for _, i := range history.revertIndexes {
+		rev := history.revisions[i]
+		if !isChainRevert(rev) {
+			continue
+		}
+		if length := lengthOf(rev); length > longest {
+			longest = length
+			last = rev
+		}
+	}
+	if longest < minRevertChainLength {
+		return nil
+	}
 
-		if rev.Anon == "true" {
-			anonymousEdits++
+	// Walk back from the last revert of the chain
+	chain := &models.RevertChain{Length: longest}
+	rev := last
+	for i := 0; i < longest; i++ {
+		chain.RevisionIDs = append([]int{rev.RevID}, chain.RevisionIDs...)
+		if !rev.UserHidden && !utils.Contains(chain.Users, rev.User) {
+			chain.Users = append(chain.Users, rev.User)
 		}
+		timestamp, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+		chain.StartTime = timestamp
+		if i == 0 {
+			chain.EndTime = timestamp
+		}
+		rev = revisionsByID[rev.ParentID]
+	}
 
-		// Track hourly activity
-		hourlyEdits[timestamp.Hour()]++
+	return chain
+}
+
+// revertTypeWeights scales how much a revert says about controversy: cleaning up
+// vandalism is routine patrolling, undoing someone's content is a dispute
+var revertTypeWeights = map[string]float64{
+	"vandalism_revert": 0.25,
+	"rollback":         0.5,
+	"undo":             1.0,
+	"manual_revert":    1.0,
+	"restore":          1.0,
+	"generic_revert":   0.75,
+}
 
-		// Track daily activity
-		dateKey := timestamp.Format("2006-01-02")
-		metrics.EditFrequency.EditsByDay[dateKey]++
+// establishedRevertWeight multiplies content reverts between two registered editors
+const establishedRevertWeight = 1.5
 
-		// Count recent activity
-		if timestamp.After(sevenDaysAgo) {
-			recentActivity++
-			metrics.EditFrequency.EditsLast7Days++
+// revertWeight returns the controversy weight of a revert. Vandalism wording in
+// the summary wins over change tags, which do not tell why an edit was reverted.
+func (pa *PageAnalyzer) revertWeight(revision models.WikiRevision, revisionsByID map[int]models.WikiRevision) float64 {
+	revertType := classifyRevertComment(revision.Comment, pa.client.Language())
+	if tagType := revertTypeFromTags(revision.Tags); tagType != "" && revertType != "vandalism_revert" {
+		revertType = tagType
+	}
+
+	weight, exists := revertTypeWeights[revertType]
+	if !exists {
+		weight = revertTypeWeights["generic_revert"]
+	}
+
+	// Content disputes between established editors weigh the most
+	if revertType == "undo" || revertType == "manual_revert" || revertType == "restore" {
+		if parent, exists := revisionsByID[revision.ParentID]; exists &&
+			revision.Anon != "true" && parent.Anon != "true" && !revision.UserHidden && !parent.UserHidden {
+			weight *= establishedRevertWeight
 		}
-		if timestamp.After(thirtyDaysAgo) {
-			metrics.EditFrequency.EditsLast30Days++
+	}
+
+	// A revert made within seconds comes from a patrolling tool, whatever its summary
+	if isToolAssistedRevert(revision, revisionsByID) {
+		weight = math.Min(weight, revertTypeWeights["rollback"])
+	}
+
+	return weight
+}
+
+// toolAssistedRevertDelay is the reaction time under which a revert was made with
+// a tool (rollback, Twinkle, Huggle...) rather than after reviewing the edit
+const toolAssistedRevertDelay = 15 * time.Second
+
+// revertDelay returns the time between a revert and the edit it reverted, its
+// parent, when both timestamps are known
+func revertDelay(revert models.WikiRevision, revisionsByID map[int]models.WikiRevision) (time.Duration, bool) {
+	parent, exists := revisionsByID[revert.ParentID]
+	if !exists {
+		return 0, false
+	}
+
+	revertedAt, err := time.Parse("2006-01-02T15:04:05Z", revert.Timestamp)
+	if err != nil {
+		return 0, false
+	}
+	editedAt, err := time.Parse("2006-01-02T15:04:05Z", parent.Timestamp)
+	if err != nil || revertedAt.Before(editedAt) {
+		return 0, false
+	}
+
+	return revertedAt.Sub(editedAt), true
+}
+
+// isToolAssistedRevert reports whether a revert followed the reverted edit too
+// closely to be a manual one
+func isToolAssistedRevert(revert models.WikiRevision, revisionsByID map[int]models.WikiRevision) bool {
+	delay, known := revertDelay(revert, revisionsByID)
+	return known && delay < toolAssistedRevertDelay
+}
+
+// Revert kinds
+const (
+	revertKindFull    = "full"    // Content identical to a version before the parent
+	revertKindPartial = "partial" // Content matches no earlier version
+)
+
+// classifyReverts tells full reverts, which restore an earlier version byte for
+// byte (same sha1), from partial ones. Only reverts are classified, and only when
+// their content hash is known; an ancestor outside the window cannot be matched,
+// so such reverts count as partial.
+func (pa *PageAnalyzer) classifyReverts(revisions []models.WikiRevision, newestFirst bool) map[int]string {
+	kinds := make(map[int]string)
+
+	ordered := revisions
+	if newestFirst {
+		ordered = make([]models.WikiRevision, len(revisions))
+		for i, rev := range revisions {
+			ordered[len(revisions)-1-i] = rev
 		}
-		if timestamp.After(ninetyDaysAgo) {
-			metrics.EditFrequency.EditsLast90Days++
+	}
+
+	// sha1 of every earlier revision, with the revision that first had it
+	seen := make(map[string]int)
+	for _, rev := range ordered {
+		if rev.SHA1 != "" && pa.isRevertRevision(rev) {
+			if firstRevID, exists := seen[rev.SHA1]; exists && firstRevID != rev.ParentID {
+				kinds[rev.RevID] = revertKindFull
+			} else {
+				kinds[rev.RevID] = revertKindPartial
+			}
 		}
 
-		// Track first edits to detect new editors
-		if firstEdit, exists := contributorFirstEdits[rev.User]; !exists || timestamp.Before(firstEdit) {
-			contributorFirstEdits[rev.User] = timestamp
+		if rev.SHA1 != "" {
+			if _, exists := seen[rev.SHA1]; !exists {
+				seen[rev.SHA1] = rev.RevID
+			}
 		}
 	}
 
+	return kinds
+}
+
+// isSelfRevert checks if a revert undoes an edit made by the reverter themselves
+func (pa *PageAnalyzer) isSelfRevert(revision models.WikiRevision, revisionsByID map[int]models.WikiRevision) bool {
+	// The reverted revision is the parent one: same author means self-revert
+	if parent, exists := revisionsByID[revision.ParentID]; exists && !revision.UserHidden && parent.User == revision.User {
+		return true
+	}
+
+	comment := strings.ToLower(revision.Comment)
+	return strings.Contains(comment, "self-revert") || strings.Contains(comment, "self revert") || strings.Contains(comment, "self-rv")
+}
+
+// analyzeQuality calculates quality metrics for the page
+func (pa *PageAnalyzer) analyzeQuality(history *historyPass, contributors []models.TopContributor) models.QualityMetrics {
+	// Edit counters come from the history pass
+	metrics := models.QualityMetrics{EditFrequency: history.frequency}
+	if len(history.revisions) == 0 {
+		return metrics
+	}
+	hourlyEdits := history.hourlyEdits
+
 	// Detect new editors (users whose first edit on this page was within last 30 days)
-	for user, firstEdit := range contributorFirstEdits {
-		if time.Since(firstEdit) <= 30*24*time.Hour {
-			// Count edits by this new editor
-			for _, rev := range revisions {
-				if rev.User == user {
-					newEditorEdits++
-				}
-			}
+	newEditorEdits := 0
+	for user, contributor := range history.contributors {
+		if time.Since(contributor.FirstEdit) <= 30*24*time.Hour {
+			newEditorEdits += history.editsByUser[user]
 		}
 	}
 
 	// Calculate metrics
-	totalRevisions := len(revisions)
+	totalRevisions := len(history.revisions)
 	if totalRevisions > 0 {
-		metrics.AverageEditSize = float64(totalSizeChanges) / float64(totalRevisions)
-		metrics.AnonymousEditRatio = float64(anonymousEdits) / float64(totalRevisions)
+		metrics.AverageEditSize = float64(history.totalSizeChanges) / float64(totalRevisions)
+		metrics.AnonymousEditRatio = float64(history.anonymousEdits) / float64(totalRevisions)
 		metrics.NewEditorRatio = float64(newEditorEdits) / float64(totalRevisions)
 	}
 
-	// Detect recent activity burst (more than 10 edits in last 7 days)
-	metrics.RecentActivityBurst = recentActivity > 10
+	// Detect recent activity burst (more than activityBurstEdits edits in the burst window)
+	metrics.RecentActivityBurst = history.burstEdits > activityBurstEdits
+
+	metrics.ContributorTurnover = analyzeContributorTurnover(history.contributors, 30)
 
 	// Calculate contributor diversity (simplified Gini coefficient)
 	if len(contributors) > 0 {
@@ -467,33 +1115,113 @@ func (pa *PageAnalyzer) analyzeQuality(revisions []models.WikiRevision, contribu
 	return metrics
 }
 
-// calculateSuspicionScore calculates a suspicion score for the page
-func (pa *PageAnalyzer) calculateSuspicionScore(profile *models.PageProfile) (int, []string) {
-	score := 0
-	flags := []string{}
+// analyzeContributorTurnover measures how much of the editor base active in the
+// last windowDays is new to the page. First edits are those of the analyzed history,
+// so the metric needs history reaching back before the window: nil otherwise, or
+// when nobody edited within the window.
+func analyzeContributorTurnover(contributors map[string]*models.TopContributor, windowDays int) *models.ContributorTurnover {
+	windowStart := time.Now().AddDate(0, 0, -windowDays)
+	turnover := &models.ContributorTurnover{WindowDays: windowDays}
+
+	for _, contributor := range contributors {
+		if contributor.FirstEdit.Before(windowStart) {
+			turnover.LongTermContributors++
+		}
+		if contributor.LastEdit.Before(windowStart) {
+			continue
+		}
+
+		turnover.RecentContributors++
+		if contributor.FirstEdit.Before(windowStart) {
+			turnover.ReturningContributors++
+		} else {
+			turnover.NewContributors++
+		}
+	}
+
+	if turnover.LongTermContributors == 0 || turnover.RecentContributors == 0 {
+		return nil
+	}
+	turnover.TurnoverScore = float64(turnover.NewContributors) / float64(turnover.RecentContributors)
+
+	return turnover
+}
+
+// analyzeTrafficContext compares last-week views with the preceding baseline to tell
+// organic, news-driven editing bursts from unexplained (possibly coordinated) ones
+func (pa *PageAnalyzer) analyzeTrafficContext(pageViews []models.DailyPageViews, activityBurst bool) *models.TrafficContext {
+	context := &models.TrafficContext{BurstContext: "none"}
+
+	sevenDaysAgo := time.Now().AddDate(0, 0, -8) // Last day of data is yesterday
+	baselineViews, baselineDays := 0, 0
+	recentDays := 0
+
+	for _, day := range pageViews {
+		if day.Date.After(sevenDaysAgo) {
+			context.ViewsLast7Days += day.Views
+			recentDays++
+		} else {
+			baselineViews += day.Views
+			baselineDays++
+		}
+	}
+
+	if baselineDays > 0 {
+		context.AverageDailyViews = float64(baselineViews) / float64(baselineDays)
+	}
+	if recentDays > 0 {
+		context.RecentDailyViews = float64(context.ViewsLast7Days) / float64(recentDays)
+	}
+	if context.AverageDailyViews > 0 {
+		context.TrafficRatio = context.RecentDailyViews / context.AverageDailyViews
+	}
+
+	if activityBurst {
+		// Readership at least doubled: the topic is likely in the news
+		if context.TrafficRatio >= 2.0 {
+			context.BurstContext = "traffic_driven"
+		} else {
+			context.BurstContext = "unexplained"
+		}
+	}
 
-	// 1. High conflict ratio
-	if profile.ConflictStats.ControversyScore > 0.3 {
-		score += 25
-		flags = append(flags, "PAGE_HIGH_CONFLICT")
+	return context
+}
+
+// calculateSuspicionScore calculates a suspicion score for the page
+func (pa *PageAnalyzer) calculateSuspicionScore(profile *models.PageProfile) (int, []string, *models.ScoreBreakdown) {
+	card := &scoreCard{}
+
+	// 1. High conflict ratio (ratios over a handful of revisions are noise)
+	// With the talk page analyzed, the combined assessment replaces the revert-only score
+	if !profile.InsufficientHistory {
+		if talk := profile.ConflictStats.TalkActivity; talk != nil {
+			if profile.ConflictStats.CombinedControversy > 0.3 {
+				card.add("PAGE_HIGH_CONFLICT", 25, fmt.Sprintf("combined controversy %.2f (controversy %.2f, talk heat %.2f)",
+					profile.ConflictStats.CombinedControversy, profile.ConflictStats.ControversyScore, talk.HeatScore))
+			}
+		} else if profile.ConflictStats.ControversyScore > 0.3 {
+			card.add("PAGE_HIGH_CONFLICT", 25, fmt.Sprintf("controversy score %.2f", profile.ConflictStats.ControversyScore))
+		}
 	}
 
 	// 2. Few contributors for many edits
 	if len(profile.Contributors) < 5 && profile.TotalRevisions > 100 {
-		score += 20
-		flags = append(flags, "PAGE_FEW_CONTRIBUTORS")
+		card.add("PAGE_FEW_CONTRIBUTORS", 20, fmt.Sprintf("%d contributors for %d revisions", len(profile.Contributors), profile.TotalRevisions))
 	}
 
-	// 3. Recent intensive activity
+	// 3. Recent intensive activity (less suspicious when explained by a traffic spike)
 	if profile.QualityMetrics.RecentActivityBurst {
-		score += 15
-		flags = append(flags, "PAGE_RECENT_INTENSIVE_ACTIVITY")
+		if profile.QualityMetrics.TrafficContext != nil && profile.QualityMetrics.TrafficContext.BurstContext == "traffic_driven" {
+			card.add("PAGE_TRAFFIC_DRIVEN_ACTIVITY", 5, "editing burst matching a pageview spike")
+		} else {
+			card.add("PAGE_RECENT_INTENSIVE_ACTIVITY", 15, "recent editing burst")
+		}
 	}
 
 	// 4. High anonymous editing ratio
 	if profile.QualityMetrics.AnonymousEditRatio > 0.5 {
-		score += 15
-		flags = append(flags, "PAGE_ANONYMOUS_HEAVY_EDITING")
+		card.add("PAGE_ANONYMOUS_HEAVY_EDITING", 15, fmt.Sprintf("%.0f%% of edits anonymous", profile.QualityMetrics.AnonymousEditRatio*100))
 	}
 
 	// 5. New editor dominance
@@ -501,33 +1229,208 @@ func (pa *PageAnalyzer) calculateSuspicionScore(profile *models.PageProfile) (in
 		topContributor := profile.Contributors[0]
 		daysSinceFirstEdit := int(time.Since(topContributor.FirstEdit).Hours() / 24)
 		if daysSinceFirstEdit < 30 && float64(topContributor.EditCount)/float64(profile.TotalRevisions) > 0.5 {
-			score += 20
-			flags = append(flags, "PAGE_NEW_EDITOR_DOMINANCE")
+			card.add("PAGE_NEW_EDITOR_DOMINANCE", 20, fmt.Sprintf("%s made %d of %d revisions, first edit %d days ago", topContributor.Username, topContributor.EditCount, profile.TotalRevisions, daysSinceFirstEdit))
 		}
 	}
 
 	// 6. Low contributor diversity
-	if profile.QualityMetrics.ContributorDiversity < 0.3 {
-		score += 10
-		flags = append(flags, "PAGE_LOW_DIVERSITY")
+	if !profile.InsufficientHistory && profile.QualityMetrics.ContributorDiversity < 0.3 {
+		card.add("PAGE_LOW_DIVERSITY", 10, fmt.Sprintf("contributor diversity %.2f", profile.QualityMetrics.ContributorDiversity))
 	}
 
 	// 7. Recent conflicts
 	if profile.ConflictStats.RecentConflicts > 5 {
-		score += 15
-		flags = append(flags, "PAGE_RECENT_CONFLICTS")
+		card.add("PAGE_RECENT_CONFLICTS", 15, fmt.Sprintf("%d recent conflicts (last %d days)", profile.ConflictStats.RecentConflicts, profile.ConflictStats.ConflictWindowDays))
+	}
+
+	// 8. Contributors repeatedly stripping citations
+	if profile.SourceAnalysis != nil && profile.SourceAnalysis.ReferenceChurn != nil &&
+		len(profile.SourceAnalysis.ReferenceChurn.CitationRemovers) > 0 {
+		card.add("PAGE_CITATION_REMOVAL_PATTERN", 15, fmt.Sprintf("%d contributors repeatedly removing citations", len(profile.SourceAnalysis.ReferenceChurn.CitationRemovers)))
+	}
+
+	// 9. One editor reverting most other editors' changes
+	if owner := profile.ConflictStats.Ownership; owner != nil {
+		card.add("PAGE_OWNERSHIP_BEHAVIOR", 20, fmt.Sprintf("%s reverted %d of %d edits by others (%.0f%%)", owner.Username, owner.RevertedEdits, owner.OthersEdits, owner.Ratio*100))
+	}
+
+	// 10. Accounts arriving on the page together
+	if arrival := profile.CoordinatedArrival; arrival != nil {
+		card.add("COORDINATED_ARRIVAL", 20, fmt.Sprintf("%d accounts first edited the page between %s and %s: %s",
+			len(arrival.Accounts), arrival.WindowStart.Format("2006-01-02 15:04"), arrival.WindowEnd.Format("15:04"), strings.Join(arrival.Accounts, ", ")))
+	}
+
+	// 11. Long back-and-forth of reverts undoing reverts
+	if chain := profile.ConflictStats.LongestRevertChain; chain != nil && chain.Length >= minSuspiciousRevertChain {
+		card.add("PAGE_REVERT_CHAIN", 15, fmt.Sprintf("%d reverts undoing each other between %s and %s by %s",
+			chain.Length, chain.StartTime.Format("2006-01-02 15:04"), chain.EndTime.Format("2006-01-02 15:04"), strings.Join(chain.Users, ", ")))
+	}
+
+	// 12. Rush of edits, and reverts, once the page's protection ended
+	if surge := profile.ConflictStats.ProtectionSurge; surge != nil {
+		points := 15
+		if surge.Reverts >= protectionSurgeMinReverts {
+			points = 25
+		}
+		card.add("PROTECTION_EXPIRY_SURGE", points, fmt.Sprintf("%d edits (%d reverts) by %d editors within %dh of the %s protection ending on %s, %.1f expected",
+			surge.Edits, surge.Reverts, len(surge.Editors), surge.WindowHours, surge.Level, surge.ProtectionEnded.Format("2006-01-02 15:04"), surge.ExpectedEdits))
+	}
+
+	// 13. Accounts casting the same !vote together in a deletion discussion
+	if discussion := profile.DeletionDiscussion; discussion != nil && len(discussion.Clusters) > 0 {
+		cluster := discussion.Clusters[0]
+		card.add("COORDINATED_DELETION_VOTES", 25, fmt.Sprintf("%d accounts voted %s within %d min of each other on %s: %s",
+			len(cluster.Users), cluster.Position, cluster.SpanMinutes, cluster.FirstVote.Format("2006-01-02 15:04"), strings.Join(cluster.Users, ", ")))
+	}
+
+	// 14. Anonymous edits made from hosting, VPN or open proxy ranges
+	if matched := proxyEdits(profile.Contributors); len(matched) > 0 {
+		points := 15
+		if len(matched) >= proxyPageManyAddresses {
+			points = 25
+		}
+		card.add("PAGE_ANON_PROXY_EDITS", points, proxyEvidence(matched))
 	}
 
-	// Limit score to 100
-	if score > 100 {
-		score = 100
+	// 15. Content reverted as vandalism put back, by another account above all
+	if reintroduced := profile.ConflictStats.ReintroducedVandalism; len(reintroduced) > 0 {
+		points := 20
+		var users []string
+		for _, restore := range reintroduced {
+			if !restore.SameAccount {
+				points = 30
+			}
+			if !utils.Contains(users, restore.User) {
+				users = append(users, restore.User)
+			}
+		}
+		first := reintroduced[0]
+		card.add("REINTRODUCED_VANDALISM", points, fmt.Sprintf("%d revisions restoring content reverted as vandalism, by %s (first: r%d restoring r%d by %s, reverted by %s)",
+			len(reintroduced), strings.Join(users, ", "), first.RevisionID, first.VandalRevisionID, first.VandalUser, first.RevertedBy))
+	}
+
+	// 16. IP addresses editing like a registered account (logged-out editing)
+	if matches := profile.LoggedOutEditing; len(matches) > 0 {
+		first := matches[0]
+		card.add("POSSIBLE_LOGGED_OUT_EDITING", 20, fmt.Sprintf("%d IP addresses editing like a registered account (first: %s like %s, %.0f%% same hours, %.0f%% of its edits within 3 hours of the account's)",
+			len(matches), first.Address, first.Account, first.HourOverlap*100, first.Proximity*100))
+	}
+
+	return card.result()
+}
+
+// maxChurnRevisionIDs bounds the revision contents fetched for reference churn
+// (one batch request, the API limit for content queries)
+const maxChurnRevisionIDs = 50
+
+// analyzeReferenceChurn fetches the content of the most recent revisions and their
+// parents and measures how references were added and removed
+func (pa *PageAnalyzer) analyzeReferenceChurn(sourceAnalyzer *SourceAnalyzer, history []models.WikiRevision) *models.ReferenceChurn {
+	// History is oldest first: walk back from the newest revision
+	var sample []models.WikiRevision
+	revisionIDs := []int{}
+	seen := make(map[int]bool)
+	for i := len(history) - 1; i >= 0; i-- {
+		rev := history[i]
+		if rev.ParentID == 0 {
+			continue
+		}
+
+		var newIDs []int
+		for _, id := range []int{rev.RevID, rev.ParentID} {
+			if !seen[id] {
+				newIDs = append(newIDs, id)
+			}
+		}
+		if len(revisionIDs)+len(newIDs) > maxChurnRevisionIDs {
+			break
+		}
+
+		for _, id := range newIDs {
+			seen[id] = true
+			revisionIDs = append(revisionIDs, id)
+		}
+		sample = append(sample, rev)
+	}
+
+	if len(sample) == 0 {
+		return nil
+	}
+
+	contents, err := pa.client.GetRevisionsContent(revisionIDs)
+	if err != nil {
+		return nil
 	}
 
-	return score, flags
+	return sourceAnalyzer.AnalyzeReferenceChurn(sample, contents)
+}
+
+// flagCitationRemovers marks the contributors who repeatedly strip references
+func (pa *PageAnalyzer) flagCitationRemovers(profile *models.PageProfile) {
+	churn := profile.SourceAnalysis.ReferenceChurn
+	if churn == nil {
+		return
+	}
+
+	for _, remover := range churn.CitationRemovers {
+		for i := range profile.Contributors {
+			contributor := &profile.Contributors[i]
+			if contributor.Username != remover.Username {
+				continue
+			}
+			contributor.SuspicionFlags = append(contributor.SuspicionFlags, "CITATION_REMOVAL_PATTERN")
+			contributor.SuspicionScore = utils.Min(100, contributor.SuspicionScore+15)
+		}
+	}
+}
+
+// flagUnsourcedAdders marks the contributors whose additions consistently lack citations
+func (pa *PageAnalyzer) flagUnsourcedAdders(profile *models.PageProfile) {
+	churn := profile.SourceAnalysis.ReferenceChurn
+	if churn == nil {
+		return
+	}
+
+	for _, adder := range churn.UnsourcedAdders {
+		for i := range profile.Contributors {
+			contributor := &profile.Contributors[i]
+			if contributor.Username != adder.Username {
+				continue
+			}
+			contributor.SuspicionFlags = append(contributor.SuspicionFlags, "UNSOURCED_CONTENT_ADDER")
+			contributor.SuspicionScore = utils.Min(100, contributor.SuspicionScore+10)
+		}
+	}
 }
 
 // Helper functions
 
+// revertChangeTags maps the MediaWiki change tags set on reverting edits to a revert type.
+// Tags are language-independent and more reliable than edit summary keywords.
+var revertChangeTags = map[string]string{
+	"mw-rollback":      "rollback",
+	"mw-undo":          "undo",
+	"mw-manual-revert": "manual_revert",
+}
+
+// revertTypeFromTags returns the revert type given by change tags, or "" if the revision has none
+func revertTypeFromTags(tags []string) string {
+	for _, tag := range tags {
+		if revertType, exists := revertChangeTags[tag]; exists {
+			return revertType
+		}
+	}
+	return ""
+}
+
+// isRevertRevision checks if a revision is a revert, preferring change tags over comment keywords
+func (pa *PageAnalyzer) isRevertRevision(revision models.WikiRevision) bool {
+	if revertTypeFromTags(revision.Tags) != "" {
+		return true
+	}
+	return pa.detectRevert(revision.Comment)
+}
+
 // detectRevert checks if a comment indicates a revert
 func (pa *PageAnalyzer) detectRevert(comment string) bool {
 	comment = strings.ToLower(comment)
@@ -545,8 +1448,9 @@ func (pa *PageAnalyzer) detectRevert(comment string) bool {
 }
 
 // detectEditWarPeriods identifies periods of intensive editing conflicts
-func (pa *PageAnalyzer) detectEditWarPeriods(revisions []models.WikiRevision) []models.EditWarPeriod {
+func (pa *PageAnalyzer) detectEditWarPeriods(history *historyPass) []models.EditWarPeriod {
 	var periods []models.EditWarPeriod
+	revisions := history.revisions
 
 	// Simplified detection: look for periods with >5 revisions within 24 hours
 	if len(revisions) < 5 {
@@ -555,14 +1459,16 @@ func (pa *PageAnalyzer) detectEditWarPeriods(revisions []models.WikiRevision) []
 
 	windowSize := 5
 	for i := 0; i <= len(revisions)-windowSize; i++ {
-		startTime, _ := time.Parse("2006-01-02T15:04:05Z", revisions[i].Timestamp)
-		endTime, _ := time.Parse("2006-01-02T15:04:05Z", revisions[i+windowSize-1].Timestamp)
+		startTime := history.timestamps[i]
+		endTime := history.timestamps[i+windowSize-1]
 
 		// If 5+ revisions within 24 hours
 		if endTime.Sub(startTime) <= 24*time.Hour {
 			participants := make(map[string]bool)
 			for j := i; j < i+windowSize; j++ {
-				participants[revisions[j].User] = true
+				if !revisions[j].UserHidden {
+					participants[revisions[j].User] = true
+				}
 			}
 
 			var participantList []string
@@ -571,10 +1477,11 @@ func (pa *PageAnalyzer) detectEditWarPeriods(revisions []models.WikiRevision) []
 			}
 
 			period := models.EditWarPeriod{
-				StartTime:     startTime,
-				EndTime:       endTime,
-				Participants:  participantList,
-				RevisionCount: windowSize,
+				StartTime:        startTime,
+				EndTime:          endTime,
+				Participants:     participantList,
+				RevisionCount:    windowSize,
+				ParticipantStats: editWarParticipantStats(history, i, i+windowSize),
 			}
 
 			periods = append(periods, period)
@@ -584,6 +1491,49 @@ func (pa *PageAnalyzer) detectEditWarPeriods(revisions []models.WikiRevision) []
 	return periods
 }
 
+// editWarParticipantStats counts the edits and reverts of each participant among
+// the revisions [start, end) of the history, and whom each revert targeted: the
+// author of the revision it replaced
+func editWarParticipantStats(history *historyPass, start, end int) []models.EditWarParticipant {
+	byUser := make(map[string]*models.EditWarParticipant)
+	var stats []*models.EditWarParticipant
+	for _, rev := range history.revisions[start:end] {
+		if rev.UserHidden {
+			continue
+		}
+		participant, exists := byUser[rev.User]
+		if !exists {
+			participant = &models.EditWarParticipant{Username: rev.User}
+			byUser[rev.User] = participant
+			stats = append(stats, participant)
+		}
+		participant.Edits++
+
+		if !history.isRevert[rev.RevID] || history.isSelfRevert[rev.RevID] {
+			continue
+		}
+		participant.Reverts++
+		if parent, known := history.revisionsByID[rev.ParentID]; known && !parent.UserHidden {
+			if participant.RevertedUsers == nil {
+				participant.RevertedUsers = make(map[string]int)
+			}
+			participant.RevertedUsers[parent.User]++
+		}
+	}
+
+	participants := make([]models.EditWarParticipant, 0, len(stats))
+	for _, participant := range stats {
+		participants = append(participants, *participant)
+	}
+	sort.SliceStable(participants, func(i, j int) bool {
+		if participants[i].Reverts != participants[j].Reverts {
+			return participants[i].Reverts > participants[j].Reverts
+		}
+		return participants[i].Edits > participants[j].Edits
+	})
+	return participants
+}
+
 // calculateContributorDiversity calculates a diversity score based on edit distribution
 func (pa *PageAnalyzer) calculateContributorDiversity(contributors []models.TopContributor) float64 {
 	if len(contributors) <= 1 {
@@ -599,19 +1549,19 @@ func (pa *PageAnalyzer) calculateContributorDiversity(contributors []models.TopC
 		return 0.0
 	}
 
-	// Calculate Gini coefficient (simplified)
-	var sumDiff float64
-	for i, contrib1 := range contributors {
-		for j, contrib2 := range contributors {
-			if i != j {
-				diff := float64(contrib1.EditCount - contrib2.EditCount)
-				if diff < 0 {
-					diff = -diff
-				}
-				sumDiff += diff
-			}
-		}
+	// Calculate Gini coefficient from sorted edit counts: the sum of all pairwise
+	// differences equals 2 * Σ (2i - n - 1) * x_i with x sorted ascending (i from 1)
+	counts := make([]int, len(contributors))
+	for i, contrib := range contributors {
+		counts[i] = contrib.EditCount
+	}
+	sort.Ints(counts)
+
+	weightedSum := 0
+	for i, count := range counts {
+		weightedSum += (2*(i+1) - len(counts) - 1) * count
 	}
+	sumDiff := float64(2 * weightedSum)
 
 	n := float64(len(contributors))
 	meanEdits := float64(totalEdits) / n
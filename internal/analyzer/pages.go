@@ -2,13 +2,16 @@
 package analyzer
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/intMeric/wikipedia-analyser/internal/client"
 	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/sources"
 	"github.com/intMeric/wikipedia-analyser/internal/utils"
 )
 
@@ -17,6 +20,8 @@ type CrossPageAnalyzer struct {
 	client       *client.WikipediaClient
 	pageAnalyzer *PageAnalyzer
 	options      models.CrossPageAnalysisOptions
+	onPageDone   func(index, total int, profile *models.PageProfile)
+	cache        *ProfileCache
 }
 
 // NewCrossPageAnalyzer creates a new cross-page analyzer
@@ -40,6 +45,9 @@ func NewCrossPageAnalyzer(client *client.WikipediaClient, options models.CrossPa
 	if options.MinMutualSupportRatio == 0 {
 		options.MinMutualSupportRatio = 0.3
 	}
+	if options.MinFootprintSimilarity == 0 {
+		options.MinFootprintSimilarity = 0.8
+	}
 
 	pageAnalysisOptions := PageAnalysisOptions{
 		NumberOfPageRevisions: options.MaxRevisionsPerPage,
@@ -54,32 +62,74 @@ func NewCrossPageAnalyzer(client *client.WikipediaClient, options models.CrossPa
 	}
 }
 
+// OnPageAnalyzed registers a handler called as soon as each page has been analyzed,
+// before the cross-page patterns are computed, e.g. to report progress
+func (cpa *CrossPageAnalyzer) OnPageAnalyzed(handler func(index, total int, profile *models.PageProfile)) {
+	cpa.onPageDone = handler
+}
+
+// SetDomainClassifier sets the classifier rating the reference domains of the
+// analyzed pages (default: the embedded perennial sources dataset)
+func (cpa *CrossPageAnalyzer) SetDomainClassifier(classifier *sources.DomainClassifier) {
+	cpa.pageAnalyzer.domainClassifier = classifier
+}
+
+// SetProfileCache makes the analyzer reuse page profiles cached on disk by
+// previous runs, and cache the ones it fetches
+func (cpa *CrossPageAnalyzer) SetProfileCache(cache *ProfileCache) {
+	cpa.cache = cache
+}
+
 // AnalyzePages performs cross-page analysis on multiple pages
 func (cpa *CrossPageAnalyzer) AnalyzePages(pageNames []string) (*models.CrossPageAnalysis, error) {
-	fmt.Printf("[PAGES ANALYZER]🔍 Starting cross-page analysis of %d pages...\n", len(pageNames))
+	if err := cpa.options.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cross-page analysis options: %w", err)
+	}
+
+	logf("[PAGES ANALYZER]🔍 Starting cross-page analysis of %d pages...\n", len(pageNames))
 
 	// 1. Analyze each page individually
 	pageProfiles := make(map[string]*models.PageProfile)
 	allContributors := make(map[string]*models.CommonContributor)
 	allRevisions := []models.EditEvent{}
+	var fetchErrors []error // Failures other than pages without revisions
 
 	for i, pageName := range pageNames {
-		fmt.Printf("[PAGES ANALYZER]📄 Analyzing page %d/%d: %s\n", i+1, len(pageNames), pageName)
+		logf("[PAGES ANALYZER]📄 Analyzing page %d/%d: %s\n", i+1, len(pageNames), pageName)
 
-		profile, err := cpa.pageAnalyzer.GetPageProfile(pageName)
+		profile, err := cpa.pageProfile(pageName)
 		if err != nil {
-			fmt.Printf("[PAGES ANALYZER]⚠️ Failed to analyze page %s: %v\n", pageName, err)
+			logf("[PAGES ANALYZER]⚠️ Failed to analyze page %s: %v\n", pageName, err)
+			if !errors.Is(err, ErrNoData) {
+				fetchErrors = append(fetchErrors, fmt.Errorf("%s: %w", pageName, err))
+			}
 			continue
 		}
 
 		pageProfiles[pageName] = profile
+		if cpa.onPageDone != nil {
+			cpa.onPageDone(i+1, len(pageNames), profile)
+		}
 
 		// Extract contributors and revisions for cross-page analysis
 		cpa.extractContributors(profile, pageName, allContributors)
 		cpa.extractRevisions(profile, pageName, &allRevisions)
 	}
 
-	fmt.Printf("[PAGES ANALYZER]📊 Found %d unique contributors across all pages\n", len(allContributors))
+	// Pages are compared with each other: one is not enough. Pages that failed
+	// to load are an error, pages without revisions merely leave nothing to compare.
+	if len(pageProfiles) < 2 {
+		if len(fetchErrors) > 0 {
+			return nil, fmt.Errorf("only %d of the %d pages could be analyzed, at least 2 are needed: %w", len(pageProfiles), len(pageNames), errors.Join(fetchErrors...))
+		}
+		return nil, fmt.Errorf("%w: only %d of the %d pages have revisions to analyze, at least 2 are needed", ErrNoData, len(pageProfiles), len(pageNames))
+	}
+
+	// Old revisions keep the name an account had then: merge renamed accounts
+	renames := cpa.findRenames(allContributors)
+	mergeRenamedContributors(allContributors, allRevisions, renames)
+
+	logf("[PAGES ANALYZER]📊 Found %d unique contributors across all pages\n", len(allContributors))
 
 	// 2. Identify common contributors
 	commonContributors := cpa.identifyCommonContributors(allContributors)
@@ -93,9 +143,25 @@ func (cpa *CrossPageAnalyzer) AnalyzePages(pageNames []string) (*models.CrossPag
 	// 5. Detect sockpuppet networks
 	sockpuppetNetworks := cpa.detectSockpuppetNetworks(commonContributors, allRevisions)
 
-	// 6. Calculate overall suspicion score
+	// 6. Cluster contributors sharing the same page footprint
+	footprintClusters := cpa.clusterByPageFootprint(commonContributors)
+
+	// 7. Measure edit cadence and group accounts sharing a regular one
+	cpa.analyzeEditCadence(commonContributors, allRevisions)
+	cadenceGroups := cpa.groupByCadence(commonContributors)
+
+	// 8. Find accounts adopting another's distinctive edit summaries
+	summaryAdoptions := cpa.detectSummaryAdoptions(commonContributors, allRevisions)
+
+	// 9. Find accounts taking over as another goes quiet
+	accountHandoffs := cpa.detectAccountHandoffs(commonContributors)
+
+	// 10. Find IP addresses editing like one of the accounts
+	loggedOutEditing := cpa.detectLoggedOutEditing(commonContributors, allRevisions)
+
+	// 11. Calculate overall suspicion score
 	suspicionScore, suspicionFlags := cpa.calculateCrossPageSuspicion(
-		coordinatedPatterns, temporalPatterns, sockpuppetNetworks, commonContributors)
+		coordinatedPatterns, temporalPatterns, sockpuppetNetworks, cadenceGroups, summaryAdoptions, accountHandoffs, loggedOutEditing, commonContributors)
 
 	analysis := &models.CrossPageAnalysis{
 		Pages:               pageNames,
@@ -106,24 +172,62 @@ func (cpa *CrossPageAnalyzer) AnalyzePages(pageNames []string) (*models.CrossPag
 		CoordinatedPatterns: coordinatedPatterns,
 		TemporalPatterns:    temporalPatterns,
 		SockpuppetNetworks:  sockpuppetNetworks,
+		FootprintClusters:   footprintClusters,
+		CadenceGroups:       cadenceGroups,
+		SummaryAdoptions:    summaryAdoptions,
+		AccountHandoffs:     accountHandoffs,
+		LoggedOutEditing:    loggedOutEditing,
+		Renames:             renames,
 		SuspicionScore:      suspicionScore,
 		SuspicionFlags:      suspicionFlags,
 		AnalysisTimestamp:   time.Now(),
 		PageProfiles:        pageProfiles,
 	}
+	analysis.OverallRisk = reconcileInvestigationRisk(analysis)
 
-	fmt.Printf("[PAGES ANALYZER]✅ Cross-page analysis completed. Suspicion score: %d/100\n", suspicionScore)
+	logf("[PAGES ANALYZER]✅ Cross-page analysis completed. Suspicion score: %d/100\n", suspicionScore)
 	return analysis, nil
 }
 
+// pageProfile analyzes a page, going through the profile cache when one is set
+func (cpa *CrossPageAnalyzer) pageProfile(pageName string) (*models.PageProfile, error) {
+	if cpa.cache == nil {
+		return cpa.pageAnalyzer.GetPageProfile(pageName)
+	}
+
+	key, cacheable := cpa.pageAnalyzer.cacheKey(pageName)
+	if !cacheable {
+		return cpa.pageAnalyzer.GetPageProfile(pageName)
+	}
+	if profile, exists := cpa.cache.Load(key); exists {
+		logf("[PAGES ANALYZER]💾 Using cached profile of %s\n", pageName)
+		return profile, nil
+	}
+
+	profile, err := cpa.pageAnalyzer.GetPageProfile(pageName)
+	if err != nil {
+		return nil, err
+	}
+	if err := cpa.cache.Store(key, profile); err != nil {
+		logf("[PAGES ANALYZER]⚠️ Failed to cache profile of %s: %v\n", pageName, err)
+	}
+	return profile, nil
+}
+
 // extractContributors extracts contributors from a page profile
 func (cpa *CrossPageAnalyzer) extractContributors(profile *models.PageProfile, pageName string, allContributors map[string]*models.CommonContributor) {
 	for _, contributor := range profile.Contributors {
-		if existing, exists := allContributors[contributor.Username]; exists {
-			// Update existing contributor
-			existing.PagesEdited = append(existing.PagesEdited, pageName)
+		// Wikipedia treats "jean_Dupont" and "Jean Dupont" as the same account
+		username := utils.NormalizeUsername(contributor.Username)
+
+		if existing, exists := allContributors[username]; exists {
+			// Update existing contributor (spelling variants may repeat a page)
+			if !utils.Contains(existing.PagesEdited, pageName) {
+				existing.PagesEdited = append(existing.PagesEdited, pageName)
+			}
 			existing.TotalEdits += contributor.EditCount
-			existing.EditsByPage[pageName] = contributor.EditCount
+			existing.EditsByPage[pageName] += contributor.EditCount
+			existing.SuspicionScore = utils.Max(existing.SuspicionScore, contributor.SuspicionScore)
 
 			if contributor.FirstEdit.Before(existing.FirstEdit) {
 				existing.FirstEdit = contributor.FirstEdit
@@ -133,8 +237,8 @@ func (cpa *CrossPageAnalyzer) extractContributors(profile *models.PageProfile, p
 			}
 		} else {
 			// Create new common contributor
-			allContributors[contributor.Username] = &models.CommonContributor{
-				Username:            contributor.Username,
+			allContributors[username] = &models.CommonContributor{
+				Username:            username,
 				UserID:              contributor.UserID,
 				PagesEdited:         []string{pageName},
 				TotalEdits:          contributor.EditCount,
@@ -153,9 +257,14 @@ func (cpa *CrossPageAnalyzer) extractContributors(profile *models.PageProfile, p
 // extractRevisions extracts revisions as edit events
 func (cpa *CrossPageAnalyzer) extractRevisions(profile *models.PageProfile, pageName string, allRevisions *[]models.EditEvent) {
 	for _, revision := range profile.RecentRevisions {
+		// Revision-deleted authors cannot take part in coordination patterns
+		if revision.IsHidden {
+			continue
+		}
+
 		editEvent := models.EditEvent{
 			Timestamp:  revision.Timestamp,
-			Username:   revision.Username,
+			Username:   utils.NormalizeUsername(revision.Username),
 			PageTitle:  pageName,
 			RevisionID: revision.RevID,
 			SizeDiff:   revision.SizeDiff,
@@ -610,10 +719,250 @@ func (cpa *CrossPageAnalyzer) detectSockpuppetNetworks(contributors []models.Com
 	return []models.SockpuppetNetwork{}
 }
 
+// clusterByPageFootprint groups contributors whose edited page sets are highly
+// similar (Jaccard similarity at or above MinFootprintSimilarity). Two users join
+// the same cluster when a chain of similar pairs links them.
+func (cpa *CrossPageAnalyzer) clusterByPageFootprint(contributors []models.CommonContributor) []models.FootprintCluster {
+	// A single shared page says nothing about a footprint
+	var candidates []models.CommonContributor
+	for _, contributor := range contributors {
+		if len(contributor.PagesEdited) > 1 {
+			candidates = append(candidates, contributor)
+		}
+	}
+
+	pageSets := make([]map[string]bool, len(candidates))
+	for i, contributor := range candidates {
+		pageSets[i] = make(map[string]bool, len(contributor.PagesEdited))
+		for _, page := range contributor.PagesEdited {
+			pageSets[i][page] = true
+		}
+	}
+
+	parent := make([]int, len(candidates))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := 0; i < len(candidates); i++ {
+		for j := i + 1; j < len(candidates); j++ {
+			if jaccardSimilarity(pageSets[i], pageSets[j]) >= cpa.options.MinFootprintSimilarity {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	members := make(map[int][]int)
+	var roots []int
+	for i := range candidates {
+		root := find(i)
+		if _, exists := members[root]; !exists {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], i)
+	}
+
+	clusters := []models.FootprintCluster{}
+	for _, root := range roots {
+		group := members[root]
+		if len(group) < 2 {
+			continue
+		}
+
+		cluster := models.FootprintCluster{}
+		for _, index := range group {
+			cluster.Users = append(cluster.Users, candidates[index].Username)
+		}
+
+		// Pages every member edited, in the order of the first member
+		for _, page := range candidates[group[0]].PagesEdited {
+			shared := true
+			for _, index := range group[1:] {
+				if !pageSets[index][page] {
+					shared = false
+					break
+				}
+			}
+			if shared {
+				cluster.SharedPages = append(cluster.SharedPages, page)
+			}
+		}
+
+		totalSimilarity := 0.0
+		pairCount := 0
+		for a := 0; a < len(group); a++ {
+			for b := a + 1; b < len(group); b++ {
+				totalSimilarity += jaccardSimilarity(pageSets[group[a]], pageSets[group[b]])
+				pairCount++
+			}
+		}
+		cluster.AverageSimilarity = totalSimilarity / float64(pairCount)
+
+		clusters = append(clusters, cluster)
+	}
+
+	// Largest and tightest clusters first
+	sort.SliceStable(clusters, func(i, j int) bool {
+		if len(clusters[i].Users) != len(clusters[j].Users) {
+			return len(clusters[i].Users) > len(clusters[j].Users)
+		}
+		return clusters[i].AverageSimilarity > clusters[j].AverageSimilarity
+	})
+	for i := range clusters {
+		clusters[i].ClusterID = fmt.Sprintf("cluster_%d", i+1)
+	}
+
+	return clusters
+}
+
+// jaccardSimilarity returns the size of the intersection of two sets over the size of their union
+func jaccardSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for key := range a {
+		if b[key] {
+			intersection++
+		}
+	}
+	union := len(a) + len(b) - intersection
+
+	return float64(intersection) / float64(union)
+}
+
+// Edit cadence thresholds: gaps are measured once a contributor has
+// minCadenceIntervals of them, and are regular when their coefficient of
+// variation stays under maxRegularCadenceCV. Regular accounts whose mean gaps
+// differ by at most cadenceMatchTolerance (relative) share a signature.
+const (
+	minCadenceIntervals   = 5
+	maxRegularCadenceCV   = 0.15
+	cadenceMatchTolerance = 0.1
+)
+
+// analyzeEditCadence measures the spacing of each contributor's edits, all pages
+// together, and flags near-constant intervals as REGULAR_CADENCE
+func (cpa *CrossPageAnalyzer) analyzeEditCadence(contributors []models.CommonContributor, revisions []models.EditEvent) {
+	timestampsByUser := make(map[string][]time.Time)
+	for _, revision := range revisions {
+		username := utils.NormalizeUsername(revision.Username)
+		timestampsByUser[username] = append(timestampsByUser[username], revision.Timestamp)
+	}
+
+	for i := range contributors {
+		contributor := &contributors[i]
+		cadence := editCadence(timestampsByUser[contributor.Username])
+		if cadence == nil {
+			continue
+		}
+
+		contributor.Cadence = cadence
+		if cadence.Regular {
+			contributor.SuspicionFlags = append(contributor.SuspicionFlags, "REGULAR_CADENCE")
+		}
+	}
+}
+
+// editCadence computes the gap statistics of a set of edit timestamps, or nil
+// when there are too few edits to judge
+func editCadence(timestamps []time.Time) *models.EditCadence {
+	if len(timestamps) < minCadenceIntervals+1 {
+		return nil
+	}
+
+	sorted := make([]time.Time, len(timestamps))
+	copy(sorted, timestamps)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	gaps := make([]float64, 0, len(sorted)-1)
+	total := 0.0
+	for i := 1; i < len(sorted); i++ {
+		gap := sorted[i].Sub(sorted[i-1]).Minutes()
+		gaps = append(gaps, gap)
+		total += gap
+	}
+
+	mean := total / float64(len(gaps))
+	if mean == 0 {
+		return nil
+	}
+
+	variance := 0.0
+	for _, gap := range gaps {
+		variance += (gap - mean) * (gap - mean)
+	}
+	cv := math.Sqrt(variance/float64(len(gaps))) / mean
+
+	return &models.EditCadence{
+		Intervals:              len(gaps),
+		MeanGapMinutes:         mean,
+		CoefficientOfVariation: cv,
+		Regular:                cv <= maxRegularCadenceCV,
+	}
+}
+
+// groupByCadence groups the regular-cadence contributors whose mean gaps match,
+// chaining accounts whose gaps are within cadenceMatchTolerance of the previous one
+func (cpa *CrossPageAnalyzer) groupByCadence(contributors []models.CommonContributor) []models.CadenceGroup {
+	var regular []models.CommonContributor
+	for _, contributor := range contributors {
+		if contributor.Cadence != nil && contributor.Cadence.Regular {
+			regular = append(regular, contributor)
+		}
+	}
+	sort.Slice(regular, func(i, j int) bool {
+		return regular[i].Cadence.MeanGapMinutes < regular[j].Cadence.MeanGapMinutes
+	})
+
+	groups := []models.CadenceGroup{}
+	flush := func(members []models.CommonContributor) {
+		if len(members) < 2 {
+			return
+		}
+		group := models.CadenceGroup{}
+		total := 0.0
+		for _, member := range members {
+			group.Users = append(group.Users, member.Username)
+			total += member.Cadence.MeanGapMinutes
+		}
+		group.MeanGapMinutes = total / float64(len(members))
+		group.GroupID = fmt.Sprintf("cadence_%d", len(groups)+1)
+		groups = append(groups, group)
+	}
+
+	var current []models.CommonContributor
+	for _, contributor := range regular {
+		if len(current) > 0 {
+			previous := current[len(current)-1].Cadence.MeanGapMinutes
+			if contributor.Cadence.MeanGapMinutes-previous > previous*cadenceMatchTolerance {
+				flush(current)
+				current = nil
+			}
+		}
+		current = append(current, contributor)
+	}
+	flush(current)
+
+	return groups
+}
+
 func (cpa *CrossPageAnalyzer) calculateCrossPageSuspicion(
 	coordinated models.CoordinatedPatterns,
 	temporal models.TemporalPatterns,
 	sockpuppets []models.SockpuppetNetwork,
+	cadenceGroups []models.CadenceGroup,
+	summaryAdoptions []models.SummaryAdoption,
+	accountHandoffs []models.AccountHandoff,
+	loggedOutEditing []models.LoggedOutEditing,
 	contributors []models.CommonContributor) (int, []string) {
 
 	score := 0
@@ -636,6 +985,30 @@ func (cpa *CrossPageAnalyzer) calculateCrossPageSuspicion(
 		flags = append(flags, "SOCKPUPPET_NETWORK_DETECTED")
 	}
 
+	// Accounts driven at the same regular interval
+	if len(cadenceGroups) > 0 {
+		score += 15
+		flags = append(flags, "SHARED_AUTOMATION_CADENCE")
+	}
+
+	// Accounts taking up another's summary phrasing right after it appeared
+	if len(summaryAdoptions) > 0 {
+		score += 15
+		flags = append(flags, "SUMMARY_STYLE_ADOPTION")
+	}
+
+	// Accounts succeeding each other on the same pages (sock evasion)
+	if len(accountHandoffs) > 0 {
+		score += 20
+		flags = append(flags, "ACCOUNT_HANDOFF")
+	}
+
+	// IP addresses editing like one of the accounts (logged-out editing)
+	if len(loggedOutEditing) > 0 {
+		score += 20
+		flags = append(flags, "POSSIBLE_LOGGED_OUT_EDITING")
+	}
+
 	// High overlap of contributors
 	multiPageContributors := 0
 	for _, contributor := range contributors {
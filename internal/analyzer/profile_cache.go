@@ -0,0 +1,138 @@
+// internal/analyzer/profile_cache.go
+package analyzer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/proxies"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// ProfileCache keeps page profiles on disk so a repeated or interrupted
+// cross-page analysis reuses recent results instead of fetching them again
+type ProfileCache struct {
+	dir string
+	ttl time.Duration
+}
+
+// cachedProfile is the on-disk form of a cache entry
+type cachedProfile struct {
+	CachedAt time.Time           `json:"cached_at"`
+	Profile  *models.PageProfile `json:"profile"`
+}
+
+// NewProfileCache creates a cache in dir, whose entries expire after ttl
+func NewProfileCache(dir string, ttl time.Duration) (*ProfileCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("unable to create cache directory: %w", err)
+	}
+	return &ProfileCache{dir: dir, ttl: ttl}, nil
+}
+
+// Load returns the cached profile for key, unless missing, unreadable or stale
+func (pc *ProfileCache) Load(key string) (*models.PageProfile, bool) {
+	data, err := os.ReadFile(pc.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry cachedProfile
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Profile == nil {
+		return nil, false
+	}
+	if time.Since(entry.CachedAt) > pc.ttl {
+		return nil, false
+	}
+
+	return entry.Profile, true
+}
+
+// Store writes a profile to the cache under key
+func (pc *ProfileCache) Store(key string, profile *models.PageProfile) error {
+	data, err := json.Marshal(cachedProfile{CachedAt: time.Now(), Profile: profile})
+	if err != nil {
+		return fmt.Errorf("unable to encode cached profile: %w", err)
+	}
+
+	// Write then rename, so an interrupted run never leaves a truncated entry
+	tmpPath := pc.path(key) + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("unable to write cached profile: %w", err)
+	}
+	return os.Rename(tmpPath, pc.path(key))
+}
+
+func (pc *ProfileCache) path(key string) string {
+	return filepath.Join(pc.dir, key+".json")
+}
+
+// cacheIdentity gathers everything a page profile depends on: the wiki, the
+// title and each analysis option. A new option must be added here, or cached
+// profiles would ignore it.
+type cacheIdentity struct {
+	Language         string `json:"language"`
+	Title            string `json:"title"`
+	Revisions        int    `json:"revisions"`
+	HistoryDays      int    `json:"history_days"`
+	Contributors     int    `json:"contributors"`
+	AnalyzeSources   bool   `json:"analyze_sources"`
+	AnalyzePageViews bool   `json:"analyze_page_views"`
+	CountSelfReverts bool   `json:"count_self_reverts"`
+	RelativeScoring  bool   `json:"relative_scoring"`
+	FollowMoves      bool   `json:"follow_moves"`
+	AnalyzeTalkPage  bool   `json:"analyze_talk_page"`
+	CheckProtection  bool   `json:"check_protection"`
+	MinRevisions     int    `json:"min_revisions"`
+	FrequencyWindows []int  `json:"frequency_windows"`
+	ConflictWindow   int    `json:"conflict_window"`
+	DomainClassifier string `json:"domain_classifier,omitempty"` // Dataset fingerprint
+	ProxyResolver    string `json:"proxy_resolver,omitempty"`    // Range list fingerprint
+}
+
+// cacheKey identifies the profile of a page on this analyzer's wiki and options.
+// Titles share the canonical form of usernames ("foo_bar" is "Foo bar"). The
+// bool is false when the profile cannot be keyed, with a proxy resolver whose
+// ranges cannot be identified: such profiles are not cached.
+func (pa *PageAnalyzer) cacheKey(title string) (string, bool) {
+	identity := cacheIdentity{
+		Language:         pa.client.Language(),
+		Title:            utils.NormalizeUsername(title),
+		Revisions:        pa.numberOfPageRevisions,
+		HistoryDays:      pa.numberOfDaysHistory,
+		Contributors:     pa.numberOfContributors,
+		AnalyzeSources:   pa.analyzeSources,
+		AnalyzePageViews: pa.analyzePageViews,
+		CountSelfReverts: pa.countSelfReverts,
+		RelativeScoring:  pa.relativeScoring,
+		FollowMoves:      pa.followMoves,
+		AnalyzeTalkPage:  pa.analyzeTalkPage,
+		CheckProtection:  pa.checkProtection,
+		MinRevisions:     pa.minRevisions,
+		FrequencyWindows: pa.frequencyWindows,
+		ConflictWindow:   pa.conflictWindow,
+	}
+	if pa.domainClassifier != nil {
+		identity.DomainClassifier = pa.domainClassifier.Fingerprint()
+	}
+	if pa.proxyResolver != nil {
+		fingerprinter, ok := pa.proxyResolver.(proxies.Fingerprinter)
+		if !ok {
+			return "", false
+		}
+		identity.ProxyResolver = fingerprinter.Fingerprint()
+	}
+
+	data, err := json.Marshal(identity)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), true
+}
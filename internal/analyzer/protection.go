@@ -0,0 +1,194 @@
+// internal/analyzer/protection.go
+package analyzer
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Protection expiry surge: the edits of the protectionSurgeWindow following the
+// end of an edit protection, compared with the page's usual rate. A surge needs
+// protectionSurgeMinEdits edits and protectionSurgeRateFactor times the usual
+// rate; protectionSurgeMinReverts reverts among them mean the dispute resumed.
+const (
+	protectionSurgeWindow     = 48 * time.Hour
+	protectionSurgeMinEdits   = 5
+	protectionSurgeRateFactor = 3.0
+	protectionSurgeMinReverts = models.ProtectionSurgeMinReverts
+)
+
+// protectionEnd is the moment an edit protection stopped applying
+type protectionEnd struct {
+	at       time.Time
+	level    string
+	liftedBy string // Empty when the protection expired
+}
+
+// protectionEnds replays a protection log and returns when each edit protection
+// ended before now: at its expiry, unless a later entry replaced it first, or
+// when an administrator lifted it
+func protectionEnds(events []models.WikiProtectionEvent, now time.Time) []protectionEnd {
+	type activeProtection struct {
+		level  string
+		expiry time.Time // Zero for an indefinite protection
+	}
+
+	sorted := make([]models.WikiProtectionEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp < sorted[j].Timestamp
+	})
+
+	var ends []protectionEnd
+	var active *activeProtection
+	for _, event := range sorted {
+		timestamp, err := time.Parse("2006-01-02T15:04:05Z", event.Timestamp)
+		if err != nil {
+			continue
+		}
+		if active != nil && !active.expiry.IsZero() && active.expiry.Before(timestamp) {
+			ends = append(ends, protectionEnd{at: active.expiry, level: active.level})
+			active = nil
+		}
+
+		var edit *models.PageProtection
+		if event.Action != "unprotect" {
+			for i := range event.Details {
+				if event.Details[i].Type == "edit" {
+					edit = &event.Details[i]
+					break
+				}
+			}
+		}
+
+		// Unprotected, or reprotected without an edit restriction
+		if edit == nil {
+			if active != nil && event.Action != "move_prot" {
+				ends = append(ends, protectionEnd{at: timestamp, level: active.level, liftedBy: event.User})
+				active = nil
+			}
+			continue
+		}
+
+		active = &activeProtection{level: edit.Level}
+		if expiry, err := time.Parse("2006-01-02T15:04:05Z", edit.Expiry); err == nil {
+			active.expiry = expiry
+		}
+	}
+	if active != nil && !active.expiry.IsZero() && active.expiry.Before(now) {
+		ends = append(ends, protectionEnd{at: active.expiry, level: active.level})
+	}
+
+	return ends
+}
+
+// semiProtectedAt replays a protection log and reports whether the page was
+// edit-protected at the autoconfirmed level at instant t
+func semiProtectedAt(events []models.WikiProtectionEvent, t time.Time) bool {
+	sorted := make([]models.WikiProtectionEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp < sorted[j].Timestamp
+	})
+
+	var level string
+	var expiry time.Time // Zero for an indefinite protection
+	for _, event := range sorted {
+		timestamp, err := time.Parse("2006-01-02T15:04:05Z", event.Timestamp)
+		if err != nil {
+			continue
+		}
+		if timestamp.After(t) {
+			break
+		}
+
+		var edit *models.PageProtection
+		if event.Action != "unprotect" {
+			for i := range event.Details {
+				if event.Details[i].Type == "edit" {
+					edit = &event.Details[i]
+					break
+				}
+			}
+		}
+		if edit == nil {
+			if event.Action != "move_prot" {
+				level = ""
+			}
+			continue
+		}
+
+		level, expiry = edit.Level, time.Time{}
+		if parsed, err := time.Parse("2006-01-02T15:04:05Z", edit.Expiry); err == nil {
+			expiry = parsed
+		}
+	}
+
+	return level == "autoconfirmed" && (expiry.IsZero() || expiry.After(t))
+}
+
+// detectProtectionExpirySurge finds the largest rush of edits right after an
+// edit protection of the page ended, within the detailed history (oldest
+// first). Returns nil when no protection end is followed by a surge.
+func (pa *PageAnalyzer) detectProtectionExpirySurge(events []models.WikiProtectionEvent, history []models.WikiRevision, now time.Time) *models.ProtectionExpirySurge {
+	if len(history) == 0 {
+		return nil
+	}
+
+	timestamps := make([]time.Time, len(history))
+	for i, rev := range history {
+		timestamps[i], _ = time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+	}
+	historyStart := timestamps[0]
+	historyHours := now.Sub(historyStart).Hours()
+
+	var strongest *models.ProtectionExpirySurge
+	for _, end := range protectionEnds(events, now) {
+		// The history must cover the window to tell a surge from usual activity
+		if end.at.Before(historyStart) {
+			continue
+		}
+		windowEnd := end.at.Add(protectionSurgeWindow)
+		if windowEnd.After(now) {
+			windowEnd = now
+		}
+		windowHours := windowEnd.Sub(end.at).Hours()
+		if windowHours <= 0 || historyHours <= windowHours {
+			continue
+		}
+
+		surge := &models.ProtectionExpirySurge{
+			ProtectionEnded: end.at,
+			Level:           end.level,
+			LiftedBy:        end.liftedBy,
+			WindowHours:     int(math.Ceil(windowHours)),
+		}
+		for i, rev := range history {
+			if timestamps[i].Before(end.at) || timestamps[i].After(windowEnd) {
+				continue
+			}
+			surge.Edits++
+			if pa.isRevertRevision(rev) {
+				surge.Reverts++
+			}
+			if !rev.UserHidden && !utils.Contains(surge.Editors, rev.User) {
+				surge.Editors = append(surge.Editors, rev.User)
+			}
+		}
+
+		// Usual rate: the rest of the history, outside the window
+		surge.ExpectedEdits = float64(len(history)-surge.Edits) / (historyHours - windowHours) * windowHours
+		if surge.Edits < protectionSurgeMinEdits || float64(surge.Edits) < protectionSurgeRateFactor*surge.ExpectedEdits {
+			continue
+		}
+		if strongest == nil || surge.Edits > strongest.Edits {
+			strongest = surge
+		}
+	}
+
+	return strongest
+}
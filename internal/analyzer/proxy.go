@@ -0,0 +1,91 @@
+// internal/analyzer/proxy.go
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// Anonymous edits from proxy ranges
+const (
+	proxyContributorScore   = 30 // Suspicion of an anonymous contributor editing from a proxy range
+	proxyPageManyAddresses  = 3  // Addresses in proxy ranges from which the page flag weighs more
+	proxyEvidenceMaxEntries = 5
+)
+
+// checkAnonymousProxies resolves the address of each anonymous contributor
+// against the proxy resolver. An address in a hosting, VPN or open proxy range
+// raises the contributor's suspicion: block evasion and sockpuppetry go through
+// such ranges, residential editors rarely do.
+func (pa *PageAnalyzer) checkAnonymousProxies(contributors []models.TopContributor, coverage *coverageReport) {
+	if pa.proxyResolver == nil {
+		coverage.skipped("Proxy check", "not requested")
+		return
+	}
+
+	var failure error
+	for i := range contributors {
+		contributor := &contributors[i]
+		if !contributor.IsAnonymous {
+			continue
+		}
+
+		match, err := pa.proxyResolver.Resolve(contributor.Username)
+		if err != nil {
+			failure = err
+			continue
+		}
+		if match == nil {
+			continue
+		}
+
+		contributor.Proxy = &models.ProxyMatch{Range: match.Range, Kind: match.Kind, Provider: match.Provider}
+		contributor.SuspicionScore = max(contributor.SuspicionScore, proxyContributorScore)
+		contributor.SuspicionFlags = append(contributor.SuspicionFlags, "ANON_FROM_PROXY")
+	}
+
+	if failure != nil {
+		logf("⚠️ [PAGE ANALYZER] Proxy check failed for some addresses: %v\n", failure)
+		coverage.unavailable("Proxy check", fmt.Sprintf("resolver failed: %v", failure))
+		return
+	}
+	coverage.ran("Proxy check")
+}
+
+// proxyEdits lists the anonymous contributors found editing from proxy ranges,
+// most active first
+func proxyEdits(contributors []models.TopContributor) []models.TopContributor {
+	var matched []models.TopContributor
+	for _, contributor := range contributors {
+		if contributor.Proxy != nil {
+			matched = append(matched, contributor)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].EditCount > matched[j].EditCount
+	})
+	return matched
+}
+
+// proxyEvidence describes the proxied addresses for the score breakdown
+func proxyEvidence(matched []models.TopContributor) string {
+	edits := 0
+	var addresses []string
+	for _, contributor := range matched {
+		edits += contributor.EditCount
+		if len(addresses) < proxyEvidenceMaxEntries {
+			source := contributor.Proxy.Kind
+			if contributor.Proxy.Provider != "" {
+				source = contributor.Proxy.Provider
+			}
+			addresses = append(addresses, fmt.Sprintf("%s (%s)", contributor.Username, source))
+		}
+	}
+	if len(matched) > proxyEvidenceMaxEntries {
+		addresses = append(addresses, fmt.Sprintf("%d more", len(matched)-proxyEvidenceMaxEntries))
+	}
+	return fmt.Sprintf("%d edits from %d addresses in hosting, VPN or proxy ranges: %s", edits, len(matched), strings.Join(addresses, ", "))
+}
@@ -0,0 +1,170 @@
+// internal/analyzer/renames.go
+package analyzer
+
+import (
+	"sort"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// maxRenameLookups bounds the accounts checked in the renameuser log, most
+// active first, rename chains included
+const maxRenameLookups = 50
+
+// findRenames looks up the renames of the registered contributors. The new name
+// of a renamed account is looked up in turn, so A -> B -> C chains are followed.
+// Wikis without a renameuser log are not queried.
+func (cpa *CrossPageAnalyzer) findRenames(allContributors map[string]*models.CommonContributor) []models.UserRename {
+	if !cpa.client.Capabilities().UserRenames() {
+		logf("[PAGES ANALYZER]⚠️ The wiki does not log account renames, renamed accounts are not merged\n")
+		return nil
+	}
+
+	var queue []string
+	for username, contributor := range allContributors {
+		if !contributor.IsAnonymous {
+			queue = append(queue, username)
+		}
+	}
+	sort.Slice(queue, func(i, j int) bool {
+		if allContributors[queue[i]].TotalEdits != allContributors[queue[j]].TotalEdits {
+			return allContributors[queue[i]].TotalEdits > allContributors[queue[j]].TotalEdits
+		}
+		return queue[i] < queue[j]
+	})
+
+	looked := make(map[string]bool)
+	var renames []models.UserRename
+	for len(queue) > 0 && len(looked) < maxRenameLookups {
+		username := queue[0]
+		queue = queue[1:]
+		if looked[username] {
+			continue
+		}
+		looked[username] = true
+
+		events, err := cpa.client.GetUserRenames(username)
+		if err != nil {
+			logf("[PAGES ANALYZER]⚠️ Unable to retrieve renames of %s: %v\n", username, err)
+			continue
+		}
+
+		for _, event := range events {
+			renamedAt, _ := time.Parse("2006-01-02T15:04:05Z", event.Timestamp)
+			rename := models.UserRename{
+				OldName:   utils.NormalizeUsername(event.OldUser),
+				NewName:   utils.NormalizeUsername(event.NewUser),
+				RenamedBy: event.User,
+				RenamedAt: renamedAt,
+			}
+			if rename.OldName == rename.NewName {
+				continue
+			}
+			renames = append(renames, rename)
+
+			// Chains are followed first: they are part of the identity being resolved
+			queue = append([]string{rename.NewName}, queue...)
+		}
+	}
+
+	return renames
+}
+
+// currentNames maps every former name to the latest name of its account
+func currentNames(renames []models.UserRename) map[string]string {
+	// A name can have been reused after a rename: the latest rename away from it wins
+	sorted := append([]models.UserRename(nil), renames...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].RenamedAt.Before(sorted[j].RenamedAt)
+	})
+
+	next := make(map[string]string)
+	for _, rename := range sorted {
+		next[rename.OldName] = rename.NewName
+	}
+
+	current := make(map[string]string, len(next))
+	for oldName := range next {
+		name := oldName
+		// Bounded walk, in case of a rename cycle
+		for steps := 0; steps < len(next); steps++ {
+			newName, renamed := next[name]
+			if !renamed {
+				break
+			}
+			name = newName
+		}
+		if name != oldName {
+			current[oldName] = name
+		}
+	}
+	return current
+}
+
+// mergeRenamedContributors merges the contributions recorded under the former
+// names of renamed accounts into one contributor under the current name, and
+// attributes their edit events to it
+func mergeRenamedContributors(allContributors map[string]*models.CommonContributor, revisions []models.EditEvent, renames []models.UserRename) {
+	current := currentNames(renames)
+	if len(current) == 0 {
+		return
+	}
+
+	formerNames := make([]string, 0, len(current))
+	for oldName := range current {
+		formerNames = append(formerNames, oldName)
+	}
+	sort.Strings(formerNames)
+
+	for _, oldName := range formerNames {
+		former, exists := allContributors[oldName]
+		if !exists {
+			continue
+		}
+		delete(allContributors, oldName)
+
+		newName := current[oldName]
+		merged, exists := allContributors[newName]
+		if !exists {
+			former.Username = newName
+			former.FormerNames = append(former.FormerNames, oldName)
+			allContributors[newName] = former
+			continue
+		}
+		mergeContributor(merged, former)
+		merged.FormerNames = append(merged.FormerNames, oldName)
+	}
+
+	for i := range revisions {
+		if newName, renamed := current[revisions[i].Username]; renamed {
+			revisions[i].Username = newName
+		}
+	}
+}
+
+// mergeContributor adds the activity recorded under a former name to a contributor
+func mergeContributor(into, from *models.CommonContributor) {
+	for _, page := range from.PagesEdited {
+		if !utils.Contains(into.PagesEdited, page) {
+			into.PagesEdited = append(into.PagesEdited, page)
+		}
+		into.EditsByPage[page] += from.EditsByPage[page]
+	}
+	into.TotalEdits += from.TotalEdits
+	into.SuspicionScore = utils.Max(into.SuspicionScore, from.SuspicionScore)
+	for _, flag := range from.SuspicionFlags {
+		if !utils.Contains(into.SuspicionFlags, flag) {
+			into.SuspicionFlags = append(into.SuspicionFlags, flag)
+		}
+	}
+	into.FormerNames = append(into.FormerNames, from.FormerNames...)
+
+	if from.FirstEdit.Before(into.FirstEdit) {
+		into.FirstEdit = from.FirstEdit
+	}
+	if from.LastEdit.After(into.LastEdit) {
+		into.LastEdit = from.LastEdit
+	}
+}
@@ -0,0 +1,100 @@
+// internal/analyzer/revert_verify.go
+package analyzer
+
+import (
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Content verification of reverts: a revert restores the version preceding the
+// reverted edit, byte for byte (same sha1) or nearly: the revert may differ from
+// that version by at most revertRestoreTolerance of the reverted edit's own
+// size, measured as the distance between the edit and its parent. Texts are
+// compared on the span where they differ; spans longer than maxLevenshteinRunes
+// count as entirely different rather than being compared rune by rune.
+const (
+	revertRestoreTolerance = 0.1
+	maxLevenshteinRunes    = 3000
+)
+
+// verifyRestoredContent reports whether a revert flagged by its summary or tags
+// restored the content from before the reverted edit: first through the sha1
+// of any earlier revision of pageHistory, then by comparing the revert's text
+// with the reverted edit's parent, against the size of the reverted edit. A
+// revert that cannot be checked (hidden content, page creation, failed request)
+// is given the benefit of the doubt.
+func (ua *UserAnalyzer) verifyRestoredContent(revert, reverted models.WikiRevision, pageHistory []models.WikiRevision) bool {
+	revertedTime, _ := time.Parse("2006-01-02T15:04:05Z", reverted.Timestamp)
+	if revert.SHA1 != "" {
+		for _, rev := range pageHistory {
+			revTime, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+			if rev.SHA1 == revert.SHA1 && revTime.Before(revertedTime) {
+				return true
+			}
+		}
+	}
+
+	if reverted.ParentID == 0 {
+		return true
+	}
+	contents, err := ua.client.GetRevisionsContent([]int{revert.RevID, reverted.RevID, reverted.ParentID})
+	if err != nil {
+		return true
+	}
+	revertText, revertKnown := contents[revert.RevID]
+	revertedText, revertedKnown := contents[reverted.RevID]
+	restoredText, restoredKnown := contents[reverted.ParentID]
+	if !revertKnown || !revertedKnown || !restoredKnown {
+		return true
+	}
+
+	// The revert must land much closer to the restored version than the reverted edit did
+	editSize := editDistance(restoredText, revertedText)
+	if editSize == 0 {
+		return true
+	}
+	return float64(editDistance(revertText, restoredText)) <= revertRestoreTolerance*float64(editSize)
+}
+
+// editDistance is the Levenshtein distance between two texts. The common prefix
+// and suffix are set aside first, so only the edited span is compared; a span
+// too long to compare counts as entirely different.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	for len(ra) > 0 && len(rb) > 0 && ra[0] == rb[0] {
+		ra, rb = ra[1:], rb[1:]
+	}
+	for len(ra) > 0 && len(rb) > 0 && ra[len(ra)-1] == rb[len(rb)-1] {
+		ra, rb = ra[:len(ra)-1], rb[:len(rb)-1]
+	}
+
+	if len(ra) > maxLevenshteinRunes || len(rb) > maxLevenshteinRunes {
+		return utils.Max(len(ra), len(rb))
+	}
+	return levenshtein(ra, rb)
+}
+
+// levenshtein is the edit distance between two rune sequences
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = utils.Min(utils.Min(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,96 @@
+// internal/analyzer/risk.go
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// riskElevationNotable is the elevation, in points above the highest page
+// score, from which coordination is explained as raising the risk
+const riskElevationNotable = 10
+
+// reconcileInvestigationRisk combines the per-page suspicion scores with the
+// cross-page coordination score into one investigation risk. The two are
+// independent evidence, combined as 100 - (100-page)(100-coordination)/100:
+// either alone gives its own score, both together more than either. The
+// explanation says which evidence drives the result.
+func reconcileInvestigationRisk(analysis *models.CrossPageAnalysis) models.InvestigationRisk {
+	risk := models.InvestigationRisk{
+		CoordinationScore: analysis.SuspicionScore,
+	}
+
+	// Sorted titles make ties pick the same page on every run
+	titles := make([]string, 0, len(analysis.PageProfiles))
+	for title := range analysis.PageProfiles {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles)
+	for _, title := range titles {
+		if score := analysis.PageProfiles[title].SuspicionScore; risk.HighestPage == "" || score > risk.HighestPageScore {
+			risk.HighestPageScore = score
+			risk.HighestPage = title
+		}
+	}
+
+	risk.Score = 100 - (100-risk.HighestPageScore)*(100-risk.CoordinationScore)/100
+	risk.Elevation = risk.Score - risk.HighestPageScore
+
+	risk.Explanation = append(risk.Explanation, fmt.Sprintf("Highest single-page score: %d/100 (%s)", risk.HighestPageScore, risk.HighestPage))
+
+	evidence := coordinationEvidence(analysis)
+	if len(evidence) == 0 {
+		risk.Explanation = append(risk.Explanation, "No coordination across the pages: the risk is that of the riskiest page")
+		return risk
+	}
+	risk.Explanation = append(risk.Explanation, fmt.Sprintf("Cross-page coordination: %d/100 from %s", risk.CoordinationScore, strings.Join(evidence, ", ")))
+
+	if risk.Elevation >= riskElevationNotable {
+		risk.Explanation = append(risk.Explanation, fmt.Sprintf(
+			"Coordination raises the risk %d points above the riskiest page: spread over %d pages, it only shows when they are viewed together",
+			risk.Elevation, analysis.TotalPages))
+	} else {
+		risk.Explanation = append(risk.Explanation, "Coordination adds little to what the riskiest page already shows")
+	}
+
+	return risk
+}
+
+// coordinationEvidence lists the cross-page patterns behind the coordination
+// score, for the risk explanation
+func coordinationEvidence(analysis *models.CrossPageAnalysis) []string {
+	var evidence []string
+	count := func(n int, singular, plural string) {
+		if n == 1 {
+			evidence = append(evidence, "1 "+singular)
+		} else if n > 1 {
+			evidence = append(evidence, fmt.Sprintf("%d %s", n, plural))
+		}
+	}
+
+	pairs := analysis.CoordinatedPatterns.MutualSupportPairs
+	if len(pairs) > 0 {
+		pages := make(map[string]bool)
+		for _, pair := range pairs {
+			for _, page := range pair.PagesInvolved {
+				pages[page] = true
+			}
+		}
+		count(len(pairs), "mutual support pair", "mutual support pairs")
+		evidence[len(evidence)-1] += fmt.Sprintf(" over %d pages", len(pages))
+	}
+	count(len(analysis.SockpuppetNetworks), "sockpuppet network", "sockpuppet networks")
+	count(len(analysis.CadenceGroups), "shared editing cadence", "shared editing cadences")
+	count(len(analysis.SummaryAdoptions), "adopted summary style", "adopted summary styles")
+	count(len(analysis.AccountHandoffs), "account handoff", "account handoffs")
+	count(len(analysis.LoggedOutEditing), "IP address editing like an account", "IP addresses editing like an account")
+
+	// Overlap alone is no evidence of coordination, but it scores
+	if len(evidence) == 0 && analysis.SuspicionScore > 0 {
+		evidence = append(evidence, "contributors overlapping across the pages")
+	}
+	return evidence
+}
@@ -0,0 +1,144 @@
+// internal/analyzer/scan.go
+package analyzer
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// ContributionProfiler scores a single contribution; ContributionAnalyzer is the
+// implementation the suspicious-contributions scan runs on
+type ContributionProfiler interface {
+	GetContributionProfile(revisionID int, pageTitle string) (*models.ContributionProfile, error)
+}
+
+// SuspiciousScanOptions configures ScanSuspiciousContributions
+type SuspiciousScanOptions struct {
+	Threshold   int                      // Minimum suspicion score kept
+	Limit       int                      // Suspicious contributions after which the scan stops
+	Concurrency int                      // Contributions analyzed at once (default 4)
+	MaxDuration time.Duration            // Overall scan time, 0 for unlimited
+	Progress    func(scanned, total int) // Called after each analyzed revision, may be nil
+}
+
+// SuspiciousScanResult holds what a scan found, highest suspicion first
+type SuspiciousScanResult struct {
+	Profiles []*models.ContributionProfile
+	Scanned  int  // Revisions analyzed, failed analyses included
+	TimedOut bool // MaxDuration ran out before the scan completed
+}
+
+// ScanSuspiciousContributions analyzes revisions with a bounded pool of workers
+// and keeps those scoring at least the threshold. The scan stops once Limit
+// suspicious contributions are found or MaxDuration runs out, returning what was
+// found so far: analyses still in flight then finish in the background and are
+// discarded. Failed analyses are skipped.
+func ScanSuspiciousContributions(profiler ContributionProfiler, pageTitle string, revisions []models.WikiRevision, options SuspiciousScanOptions) *SuspiciousScanResult {
+	concurrency := options.Concurrency
+	if concurrency < 1 {
+		concurrency = 4
+	}
+
+	var mu sync.Mutex
+	result := &SuspiciousScanResult{}
+	done := make(chan struct{})
+	var stopOnce sync.Once
+	stop := func() { stopOnce.Do(func() { close(done) }) }
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for _, revision := range revisions {
+			select {
+			case jobs <- revision.RevID:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var workers sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for revisionID := range jobs {
+				profile, err := profiler.GetContributionProfile(revisionID, pageTitle)
+
+				mu.Lock()
+				select {
+				case <-done:
+					// The scan already returned: the result must not change under the caller
+					mu.Unlock()
+					return
+				default:
+				}
+				result.Scanned++
+				if err == nil && profile.SuspicionScore >= options.Threshold {
+					result.Profiles = append(result.Profiles, profile)
+					if options.Limit > 0 && len(result.Profiles) >= options.Limit {
+						stop()
+					}
+				}
+				scanned := result.Scanned
+				mu.Unlock()
+
+				if options.Progress != nil {
+					options.Progress(scanned, len(revisions))
+				}
+			}
+		}()
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(finished)
+	}()
+
+	var deadline <-chan time.Time
+	if options.MaxDuration > 0 {
+		timer := time.NewTimer(options.MaxDuration)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	timedOut := false
+	select {
+	case <-finished:
+	case <-done:
+	case <-deadline:
+		timedOut = true
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	stop()
+	result.TimedOut = timedOut
+
+	// Workers finish in any order
+	SortContributionsBySuspicion(result.Profiles)
+	if options.Limit > 0 && len(result.Profiles) > options.Limit {
+		result.Profiles = result.Profiles[:options.Limit]
+	}
+
+	return result
+}
+
+// SortContributionsBySuspicion orders contributions highest suspicion first, so
+// the riskiest edit heads the report. Ties keep chronological order, oldest
+// first, with the revision ID settling edits made the same second.
+func SortContributionsBySuspicion(profiles []*models.ContributionProfile) {
+	sort.SliceStable(profiles, func(i, j int) bool {
+		if profiles[i].SuspicionScore != profiles[j].SuspicionScore {
+			return profiles[i].SuspicionScore > profiles[j].SuspicionScore
+		}
+		if !profiles[i].Timestamp.Equal(profiles[j].Timestamp) {
+			return profiles[i].Timestamp.Before(profiles[j].Timestamp)
+		}
+		return profiles[i].RevisionID < profiles[j].RevisionID
+	})
+}
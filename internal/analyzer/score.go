@@ -0,0 +1,46 @@
+// internal/analyzer/score.go
+package analyzer
+
+import (
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// maxSuspicionScore caps every suspicion score
+const maxSuspicionScore = 100
+
+// scoreCard accumulates suspicion points rule by rule so the final score can be
+// explained: each fired rule is kept with its points and the evidence behind it
+type scoreCard struct {
+	score int
+	flags []string
+	items []models.ScoreItem
+}
+
+// add records a fired rule that raises a suspicion flag
+func (sc *scoreCard) add(flag string, points int, evidence string) {
+	sc.flags = append(sc.flags, flag)
+	sc.addPoints(flag, points, evidence)
+}
+
+// addPoints records points that do not raise a flag of their own
+func (sc *scoreCard) addPoints(rule string, points int, evidence string) {
+	sc.score += points
+	sc.items = append(sc.items, models.ScoreItem{Rule: rule, Points: points, Evidence: evidence})
+}
+
+// result returns the capped score, the raised flags and the itemized breakdown
+func (sc *scoreCard) result() (int, []string, *models.ScoreBreakdown) {
+	score := utils.Min(sc.score, maxSuspicionScore)
+
+	flags := sc.flags
+	if flags == nil {
+		flags = []string{}
+	}
+
+	return score, flags, &models.ScoreBreakdown{
+		Items:      sc.items,
+		RawScore:   sc.score,
+		FinalScore: score,
+	}
+}
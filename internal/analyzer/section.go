@@ -0,0 +1,97 @@
+// internal/analyzer/section.go
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// leadSection names the text before the first heading
+const leadSection = "(lead)"
+
+// referenceSections are the lowercased headings of the reference and further
+// reading sections, per wiki language
+var referenceSections = []string{
+	"references", "notes", "sources", "bibliography", "citations", "external links", "further reading",
+	"références", "notes et références", "bibliographie", "liens externes",
+	"einzelnachweise", "literatur", "weblinks", "quellen",
+	"referencias", "notas", "bibliografía", "enlaces externos",
+}
+
+// sectionHeadingPattern matches a wikitext heading line, "== History =="
+var sectionHeadingPattern = regexp.MustCompile(`^(={1,6})\s*(.+?)\s*={1,6}\s*$`)
+
+// splitSections maps each section heading of a wikitext to its body, the text
+// before the first heading under leadSection, and returns the headings in page
+// order. A repeated heading keeps its bodies joined.
+func splitSections(wikitext string) (map[string]string, []string) {
+	bodies := map[string]string{leadSection: ""}
+	order := []string{leadSection}
+	current := leadSection
+
+	for _, line := range strings.Split(wikitext, "\n") {
+		if match := sectionHeadingPattern.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			current = match[2]
+			if _, exists := bodies[current]; !exists {
+				bodies[current] = ""
+				order = append(order, current)
+			}
+			continue
+		}
+		bodies[current] += line + "\n"
+	}
+
+	return bodies, order
+}
+
+// changedSections lists, in page order, the sections whose text differs
+// between a revision and its parent, including sections added or removed
+func changedSections(parentText, childText string) []string {
+	parentBodies, parentOrder := splitSections(parentText)
+	childBodies, childOrder := splitSections(childText)
+
+	var sections []string
+	for _, section := range childOrder {
+		parentBody, exists := parentBodies[section]
+		if !exists || strings.TrimSpace(parentBody) != strings.TrimSpace(childBodies[section]) {
+			sections = append(sections, section)
+		}
+	}
+	for _, section := range parentOrder {
+		if _, exists := childBodies[section]; !exists {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+// sectionsAffected merges the section named by an edit summary's "/* Section */"
+// marker, listed first, with the sections found changed in the diff
+func sectionsAffected(comment string, diffSections []string) []string {
+	var sections []string
+	if section, _ := utils.SplitEditSummary(comment); section != "" {
+		sections = append(sections, section)
+	}
+	for _, section := range diffSections {
+		if !utils.Contains(sections, section) {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+// onlyReferenceSections reports whether every affected section lists
+// references or further reading: edits there are about sources, not content
+func onlyReferenceSections(sections []string) bool {
+	if len(sections) == 0 {
+		return false
+	}
+	for _, section := range sections {
+		if !utils.Contains(referenceSections, strings.ToLower(section)) {
+			return false
+		}
+	}
+	return true
+}
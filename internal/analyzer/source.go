@@ -3,9 +3,12 @@ package analyzer
 import (
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/sources"
 )
 
 type SourceAnalyzer struct {
@@ -13,16 +16,24 @@ type SourceAnalyzer struct {
 	namedRefPattern *regexp.Regexp
 	urlPattern      *regexp.Regexp
 	templatePattern *regexp.Regexp
-	reliableDomains map[string]string
+	refStripPattern *regexp.Regexp
+	classifier      *sources.DomainClassifier
 }
 
-func NewSourceAnalyzer() *SourceAnalyzer {
+// NewSourceAnalyzer creates a source analyzer rating domains with the given
+// classifier, or the default reliability dataset when nil
+func NewSourceAnalyzer(classifier *sources.DomainClassifier) *SourceAnalyzer {
+	if classifier == nil {
+		classifier = sources.NewDomainClassifier()
+	}
+
 	return &SourceAnalyzer{
 		refPattern:      regexp.MustCompile(`<ref[^>]*>([^<]+)</ref>`),
 		namedRefPattern: regexp.MustCompile(`<ref\s+name\s*=\s*["']([^"']+)["'][^>]*>([^<]*)</ref>`),
 		urlPattern:      regexp.MustCompile(`https?://[^\s\]]+`),
 		templatePattern: regexp.MustCompile(`\{\{cite\s+(\w+)`),
-		reliableDomains: getReliableDomains(),
+		refStripPattern: regexp.MustCompile(`(?s)<ref[^>]*/>|<ref[^>]*>.*?</ref>`),
+		classifier:      classifier,
 	}
 }
 
@@ -139,6 +150,161 @@ func (sa *SourceAnalyzer) countUniqueReferences(references []models.Reference) i
 	return len(seen)
 }
 
+// minCitationRemovalEdits is the number of reference-stripping revisions after
+// which a user is reported as a citation remover
+const minCitationRemovalEdits = 3
+
+// Unsourced content thresholds: an addition is substantial from
+// minSubstantialAddition characters of prose, and a user is reported once
+// minUnsourcedChars of such prose came with no reference in most of their additions
+const (
+	minSubstantialAddition = 200
+	minUnsourcedAdditions  = 2
+	minUnsourcedChars      = 2000
+	minUnsourcedRatio      = 0.8
+)
+
+// AnalyzeReferenceChurn compares the references of each revision with its parent
+// to measure how citations are added and removed over time, and who strips them
+func (sa *SourceAnalyzer) AnalyzeReferenceChurn(revisions []models.WikiRevision, contents map[int]string) *models.ReferenceChurn {
+	churn := &models.ReferenceChurn{
+		Changes:          []models.RevisionReferenceChange{},
+		CitationRemovers: []models.CitationRemover{},
+		UnsourcedAdders:  []models.UnsourcedAdder{},
+	}
+
+	keysByRevision := make(map[int]map[string]int)
+	referenceKeysOf := func(revID int) map[string]int {
+		if keys, exists := keysByRevision[revID]; exists {
+			return keys
+		}
+		keys := sa.referenceKeys(contents[revID])
+		keysByRevision[revID] = keys
+		return keys
+	}
+
+	removers := make(map[string]*models.CitationRemover)
+	adders := make(map[string]*models.UnsourcedAdder)
+	for _, rev := range revisions {
+		_, childExists := contents[rev.RevID]
+		_, parentExists := contents[rev.ParentID]
+		if !childExists || !parentExists {
+			continue
+		}
+
+		added, removed := diffReferenceKeys(referenceKeysOf(rev.ParentID), referenceKeysOf(rev.RevID))
+		churn.RevisionsAnalyzed++
+		churn.TotalAdded += added
+		churn.TotalRemoved += removed
+
+		// Prose growth, references excluded, tells substantial additions apart
+		// Revision-deleted authors count in the totals, but cannot be attributed to anyone
+		if proseAdded := sa.proseLength(contents[rev.RevID]) - sa.proseLength(contents[rev.ParentID]); proseAdded >= minSubstantialAddition && !rev.UserHidden {
+			adder, exists := adders[rev.User]
+			if !exists {
+				adder = &models.UnsourcedAdder{Username: rev.User}
+				adders[rev.User] = adder
+			}
+			adder.Additions++
+			adder.RefsAdded += added
+			if added == 0 {
+				adder.UnsourcedAdditions++
+				adder.UnsourcedChars += proseAdded
+			}
+		}
+
+		if added == 0 && removed == 0 {
+			continue
+		}
+
+		timestamp, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+		churn.Changes = append(churn.Changes, models.RevisionReferenceChange{
+			RevID:       rev.RevID,
+			Username:    rev.User,
+			Timestamp:   timestamp,
+			RefsAdded:   added,
+			RefsRemoved: removed,
+		})
+
+		if rev.UserHidden {
+			continue
+		}
+		remover, exists := removers[rev.User]
+		if !exists {
+			remover = &models.CitationRemover{Username: rev.User}
+			removers[rev.User] = remover
+		}
+		remover.RefsAdded += added
+		remover.RefsRemoved += removed
+		if removed > added {
+			remover.RemovalEdits++
+		}
+	}
+
+	for _, remover := range removers {
+		if remover.RemovalEdits >= minCitationRemovalEdits && remover.RefsRemoved > remover.RefsAdded {
+			churn.CitationRemovers = append(churn.CitationRemovers, *remover)
+		}
+	}
+
+	sort.Slice(churn.CitationRemovers, func(i, j int) bool {
+		if churn.CitationRemovers[i].RefsRemoved != churn.CitationRemovers[j].RefsRemoved {
+			return churn.CitationRemovers[i].RefsRemoved > churn.CitationRemovers[j].RefsRemoved
+		}
+		return churn.CitationRemovers[i].Username < churn.CitationRemovers[j].Username
+	})
+
+	for _, adder := range adders {
+		if adder.UnsourcedAdditions >= minUnsourcedAdditions && adder.UnsourcedChars >= minUnsourcedChars &&
+			float64(adder.UnsourcedAdditions)/float64(adder.Additions) >= minUnsourcedRatio {
+			churn.UnsourcedAdders = append(churn.UnsourcedAdders, *adder)
+		}
+	}
+
+	sort.Slice(churn.UnsourcedAdders, func(i, j int) bool {
+		if churn.UnsourcedAdders[i].UnsourcedChars != churn.UnsourcedAdders[j].UnsourcedChars {
+			return churn.UnsourcedAdders[i].UnsourcedChars > churn.UnsourcedAdders[j].UnsourcedChars
+		}
+		return churn.UnsourcedAdders[i].Username < churn.UnsourcedAdders[j].Username
+	})
+
+	return churn
+}
+
+// proseLength returns the length of a wikitext once its references are stripped
+func (sa *SourceAnalyzer) proseLength(wikitext string) int {
+	return len(sa.refStripPattern.ReplaceAllString(wikitext, ""))
+}
+
+// referenceKeys counts the references of a wikitext, keyed by URL when available
+func (sa *SourceAnalyzer) referenceKeys(wikitext string) map[string]int {
+	keys := make(map[string]int)
+	for _, ref := range sa.extractReferences(wikitext) {
+		key := ref.Content
+		if ref.URL != "" {
+			key = ref.URL
+		}
+		keys[key]++
+	}
+	return keys
+}
+
+// diffReferenceKeys returns how many references were added and removed between two revisions
+func diffReferenceKeys(parent, child map[string]int) (int, int) {
+	added, removed := 0, 0
+	for key, count := range child {
+		if count > parent[key] {
+			added += count - parent[key]
+		}
+	}
+	for key, count := range parent {
+		if count > child[key] {
+			removed += count - child[key]
+		}
+	}
+	return added, removed
+}
+
 func (sa *SourceAnalyzer) analyzeDomains(references []models.Reference) map[string]int {
 	domainCounts := make(map[string]int)
 	for _, ref := range references {
@@ -167,10 +333,8 @@ func (sa *SourceAnalyzer) calculateReliabilityScore(domainDist map[string]int) f
 
 	for domain, count := range domainDist {
 		totalSources += count
-		if reliability, exists := sa.reliableDomains[domain]; exists {
-			if reliability == "reliable" {
-				reliableSources += count
-			}
+		if reliability, exists := sa.classifier.Classify(domain); exists && reliability.Level == sources.LevelReliable {
+			reliableSources += count
 		}
 	}
 
@@ -190,12 +354,12 @@ func (sa *SourceAnalyzer) identifyUnreliableSources(references []models.Referenc
 			domain := strings.ToLower(strings.TrimPrefix(ref.Domain, "www."))
 			domainCounts[domain] += ref.UsageCount
 
-			if reliability, exists := sa.reliableDomains[domain]; exists && reliability != "reliable" {
+			if reliability, exists := sa.classifier.Classify(domain); exists && reliability.Level != sources.LevelReliable {
 				unreliable = append(unreliable, models.UnreliableSource{
 					URL:              ref.URL,
 					Domain:           domain,
-					ReliabilityLevel: reliability,
-					Reason:           getUnreliabilityReason(reliability),
+					ReliabilityLevel: reliability.Level,
+					Reason:           reliability.Reason,
 					UsageCount:       ref.UsageCount,
 				})
 			}
@@ -204,40 +368,3 @@ func (sa *SourceAnalyzer) identifyUnreliableSources(references []models.Referenc
 
 	return unreliable
 }
-
-func getReliableDomains() map[string]string {
-	return map[string]string{
-		"pubmed.ncbi.nlm.nih.gov": "reliable",
-		"doi.org":                 "reliable",
-		"nature.com":              "reliable",
-		"science.org":             "reliable",
-		"bbc.com":                 "reliable",
-		"reuters.com":             "reliable",
-		"gov":                     "reliable",
-		"edu":                     "reliable",
-		"lemonde.fr":              "reliable",
-		"lefigaro.fr":             "reliable",
-		"liberation.fr":           "reliable",
-		"wikipedia.org":           "questionable",
-		"blog":                    "unreliable",
-		"blogspot.com":            "unreliable",
-		"wordpress.com":           "questionable",
-		"youtube.com":             "questionable",
-		"facebook.com":            "unreliable",
-		"twitter.com":             "unreliable",
-		"reddit.com":              "unreliable",
-	}
-}
-
-func getUnreliabilityReason(level string) string {
-	switch level {
-	case "unreliable":
-		return "Source généralement considérée comme non fiable"
-	case "questionable":
-		return "Fiabilité à vérifier selon le contexte"
-	case "deprecated":
-		return "Source obsolète ou dépréciée"
-	default:
-		return "Niveau de fiabilité indéterminé"
-	}
-}
\ No newline at end of file
@@ -0,0 +1,136 @@
+// internal/analyzer/stealth.go
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// stealthDiffMaxWords is the largest number of words a diff may add or remove
+// and still count as a tiny, one-word kind of change
+const stealthDiffMaxWords = 3
+
+// povIndicators are the words betraying a point of view, in edit summaries and
+// in added text alike
+var povIndicators = []string{
+	"obviously", "clearly", "undoubtedly", "best", "worst",
+	"always", "never", "perfect", "terrible", "amazing",
+}
+
+// loadedTerms are words to watch in article text: a single one inserted casts
+// doubt on, or vouches for, the sentence around it
+var loadedTerms = []string{
+	"alleged", "allegedly", "supposedly", "purported", "purportedly", "claimed",
+	"notorious", "infamous", "controversial", "extremist", "regime",
+	"prétendu", "prétendument", "soi-disant", "angeblich", "umstritten", "supuesto", "presunto",
+}
+
+// peacockTerms are the flattering words of promotional writing: loaded terms in
+// article text, and the tone of edits made on behalf of the subject. Words at
+// home in neutral prose ("best", "leading", "trusted", "solutions") are left out.
+var peacockTerms = []string{
+	"legendary", "world-class", "world leader", "award-winning", "renowned", "acclaimed",
+	"prestigious", "cutting-edge", "state-of-the-art", "industry-leading", "best-in-class",
+	"unparalleled", "unrivaled", "visionary",
+	"leader mondial", "incontournable", "weltweit führend",
+}
+
+// negationWords flip the meaning of a sentence when inserted or removed
+var negationWords = []string{
+	"not", "no", "never", "neither", "nor", "without",
+	"ne", "pas", "jamais", "nicht", "kein", "keine", "nie",
+}
+
+// changedWords lists the words added and removed between two revisions, compared
+// like wordChanges as multisets of words of their rendered text, in sorted order
+func changedWords(parentText, childText string) (added, removed []string) {
+	counts := make(map[string]int)
+	for _, word := range wordPattern.FindAllString(strings.ToLower(normalizeRenderedText(parentText)), -1) {
+		counts[word]--
+	}
+	for _, word := range wordPattern.FindAllString(strings.ToLower(normalizeRenderedText(childText)), -1) {
+		counts[word]++
+	}
+
+	for word, count := range counts {
+		for ; count > 0; count-- {
+			added = append(added, word)
+		}
+		for ; count < 0; count++ {
+			removed = append(removed, word)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// isPOVTerm reports whether a single word is a POV indicator, a loaded term or a
+// peacock term
+func isPOVTerm(word string) bool {
+	return utils.Contains(povIndicators, word) || utils.Contains(loadedTerms, word) || utils.Contains(peacockTerms, word)
+}
+
+// isFigure reports whether a word is a number
+func isFigure(word string) bool {
+	for _, r := range word {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return word != ""
+}
+
+// addedPOVTerms finds the POV indicators, loaded and peacock terms among added words
+func addedPOVTerms(added []string) []string {
+	var terms []string
+	for _, word := range added {
+		if isPOVTerm(word) && !utils.Contains(terms, word) {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// detectStealthChanges describes the meaning-changing substitutions of a tiny
+// diff: a loaded word inserted, a negation inserted or removed, a figure
+// changed. Larger diffs are left to the other checks: a loaded word in a new
+// paragraph is not stealthy.
+func detectStealthChanges(added, removed []string) []string {
+	if len(added)+len(removed) == 0 || utils.Max(len(added), len(removed)) > stealthDiffMaxWords {
+		return nil
+	}
+
+	var changes []string
+	var addedFigures, removedFigures []string
+	for _, word := range added {
+		switch {
+		case utils.Contains(negationWords, word):
+			changes = append(changes, fmt.Sprintf("negation %q inserted", word))
+		case isPOVTerm(word):
+			changes = append(changes, fmt.Sprintf("loaded term %q inserted", word))
+		case isFigure(word):
+			addedFigures = append(addedFigures, word)
+		}
+	}
+	for _, word := range removed {
+		switch {
+		case utils.Contains(negationWords, word):
+			changes = append(changes, fmt.Sprintf("negation %q removed", word))
+		case isFigure(word):
+			removedFigures = append(removedFigures, word)
+		}
+	}
+
+	// A figure swapped for another changes a fact; a figure only added or only
+	// removed is more likely a date or a reference number
+	if len(addedFigures) > 0 && len(removedFigures) > 0 {
+		changes = append(changes, fmt.Sprintf("figure %s changed to %s",
+			strings.Join(removedFigures, ", "), strings.Join(addedFigures, ", ")))
+	}
+
+	return changes
+}
@@ -0,0 +1,54 @@
+// internal/analyzer/summary.go
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// misleadingSummaryMinWords is the number of words an edit must add or remove
+// before a typo or formatting summary stops describing it
+const misleadingSummaryMinWords = 10
+
+// trivialSummaryKeywords are the edit summaries announcing a change that leaves
+// the meaning of the text alone. Unlike isTrivialEdit, "fix" and "correct" are
+// left out: "fix date" or "correct figures" do announce content changes.
+// Keywords match whole words: "format" is not found in "information".
+var trivialSummaryKeywords = []string{
+	"typo", "typos", "spelling", "grammar", "punctuation", "format", "formatting", "copyedit", "copy edit",
+	"whitespace", "cleanup", "clean up", "wikify", "style",
+	"coquille", "orthographe", "mise en forme", "tippfehler", "rechtschreibung", "errata",
+}
+
+// wordPattern matches the words of a text, markup punctuation excluded
+var wordPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// describesTrivialChange reports whether an edit summary claims a typo fix,
+// formatting or cleanup
+func describesTrivialChange(comment string) bool {
+	words := wordPattern.FindAllString(strings.ToLower(comment), -1)
+	joined := " " + strings.Join(words, " ") + " "
+	for _, keyword := range trivialSummaryKeywords {
+		if strings.Contains(joined, " "+keyword+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// wordChanges counts the words added and removed between two revisions, compared
+// as multisets of words of their rendered text, so that moved text and layout
+// changes do not count
+func wordChanges(parentText, childText string) (added, removed int) {
+	addedWords, removedWords := changedWords(parentText, childText)
+	return len(addedWords), len(removedWords)
+}
+
+// isMisleadingSummary reports whether an edit summary describes a trivial change
+// while the content diff adds or removes substantive text. A typo fix swaps a
+// word for another, so the larger of the two counts is what matters.
+func isMisleadingSummary(comment string, wordsAdded, wordsRemoved int) bool {
+	return describesTrivialChange(comment) && utils.Max(wordsAdded, wordsRemoved) >= misleadingSummaryMinWords
+}
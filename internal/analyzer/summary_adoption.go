@@ -0,0 +1,140 @@
+// internal/analyzer/summary_adoption.go
+package analyzer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Summary adoption: an account taking up another's distinctive edit summary.
+// A summary is distinctive when it has minDistinctiveSummaryWords words, is used
+// by exactly two accounts and minOriginatorUses times by the first; it is adopted
+// when the second account first uses it within summaryAdoptionWindow of the
+// first account's appearance in the analyzed history.
+const (
+	minDistinctiveSummaryWords = 3
+	minOriginatorUses          = 2
+	summaryAdoptionWindow      = 7 * 24 * time.Hour
+)
+
+var (
+	// sectionMarkerPattern matches the "/* Section */" prefix MediaWiki adds to section edits
+	sectionMarkerPattern = regexp.MustCompile(`/\*.*?\*/`)
+	// digitsPattern matches numbers, which vary from one use of a summary to the next
+	digitsPattern = regexp.MustCompile(`[0-9]+`)
+)
+
+// normalizeSummary reduces an edit summary to its phrasing: lowercase, without
+// section markers, numbers or extra spaces
+func normalizeSummary(comment string) string {
+	comment = sectionMarkerPattern.ReplaceAllString(strings.ToLower(comment), " ")
+	comment = digitsPattern.ReplaceAllString(comment, "#")
+	return strings.Join(strings.Fields(comment), " ")
+}
+
+// summaryUse is one account's use of a summary phrasing
+type summaryUse struct {
+	username string
+	first    time.Time
+	count    int
+	original string
+	pages    []string
+}
+
+// detectSummaryAdoptions finds accounts adopting another account's distinctive
+// edit summary shortly after it appears, and flags the adopters. Reverts are left
+// out: their summaries are generated by the software.
+func (cpa *CrossPageAnalyzer) detectSummaryAdoptions(contributors []models.CommonContributor, revisions []models.EditEvent) []models.SummaryAdoption {
+	firstSeen := make(map[string]time.Time)
+	uses := make(map[string]map[string]*summaryUse)
+	for _, revision := range revisions {
+		username := utils.NormalizeUsername(revision.Username)
+		if username == "" {
+			continue
+		}
+		if first, seen := firstSeen[username]; !seen || revision.Timestamp.Before(first) {
+			firstSeen[username] = revision.Timestamp
+		}
+
+		phrasing := normalizeSummary(revision.Comment)
+		if revision.IsRevert || len(strings.Fields(phrasing)) < minDistinctiveSummaryWords {
+			continue
+		}
+
+		if uses[phrasing] == nil {
+			uses[phrasing] = make(map[string]*summaryUse)
+		}
+		use, exists := uses[phrasing][username]
+		if !exists {
+			use = &summaryUse{username: username, first: revision.Timestamp, original: revision.Comment}
+			uses[phrasing][username] = use
+		}
+		use.count++
+		if revision.Timestamp.Before(use.first) {
+			use.first = revision.Timestamp
+			use.original = revision.Comment
+		}
+		if !utils.Contains(use.pages, revision.PageTitle) {
+			use.pages = append(use.pages, revision.PageTitle)
+		}
+	}
+
+	adoptions := []models.SummaryAdoption{}
+	for _, users := range uses {
+		if len(users) != 2 {
+			continue
+		}
+
+		pair := make([]*summaryUse, 0, 2)
+		for _, use := range users {
+			pair = append(pair, use)
+		}
+		sort.Slice(pair, func(i, j int) bool { return pair[i].first.Before(pair[j].first) })
+		originator, adopter := pair[0], pair[1]
+
+		if originator.count < minOriginatorUses || !adopter.first.After(originator.first) {
+			continue
+		}
+		if adopter.first.Sub(firstSeen[originator.username]) > summaryAdoptionWindow {
+			continue
+		}
+
+		pages := append([]string(nil), originator.pages...)
+		for _, page := range adopter.pages {
+			if !utils.Contains(pages, page) {
+				pages = append(pages, page)
+			}
+		}
+
+		adoptions = append(adoptions, models.SummaryAdoption{
+			Originator:     originator.username,
+			Adopter:        adopter.username,
+			Summary:        originator.original,
+			OriginatorUses: originator.count,
+			AdopterUses:    adopter.count,
+			FirstUsed:      originator.first,
+			AdoptedAt:      adopter.first,
+			ReactionHours:  adopter.first.Sub(firstSeen[originator.username]).Hours(),
+			Pages:          pages,
+		})
+	}
+
+	sort.Slice(adoptions, func(i, j int) bool {
+		return adoptions[i].AdoptedAt.Before(adoptions[j].AdoptedAt)
+	})
+
+	for i := range contributors {
+		for _, adoption := range adoptions {
+			if adoption.Adopter == contributors[i].Username && !utils.Contains(contributors[i].SuspicionFlags, "ADOPTED_SUMMARY_STYLE") {
+				contributors[i].SuspicionFlags = append(contributors[i].SuspicionFlags, "ADOPTED_SUMMARY_STYLE")
+			}
+		}
+	}
+
+	return adoptions
+}
@@ -0,0 +1,140 @@
+// internal/analyzer/talk.go
+package analyzer
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// Talk heat: how much the discussion behind a page's reverts is argued. Activity
+// saturates at talkBusyRevisions talk edits over the history window; quick
+// replies are talk edits answering another participant within talkReplyWindow.
+const (
+	talkBusyRevisions = 20
+	talkReplyWindow   = time.Hour
+)
+
+// heatedSummaryKeywords are talk-page edit summary words of a dispute rather than
+// a calm discussion
+var heatedSummaryKeywords = []string{
+	"vandal", "pov", "npov", "bias", "propaganda", "edit war", "3rr", "stop",
+	"wrong", "false", "lie", "liar", "nonsense", "sock", "ani", "warning",
+	"mensonge", "faux", "propagande", "falsch", "lüge", "mentira", "falso",
+}
+
+// talkPageTitle returns the title of the talk page of a subject page, or "" when
+// the page already is a talk page (odd namespaces) or its namespace is unknown
+func talkPageTitle(title string, namespace int, namespaces map[int]string) string {
+	if namespace < 0 || namespace%2 == 1 {
+		return ""
+	}
+	talkNamespace, known := namespaces[namespace+1]
+	if !known {
+		return ""
+	}
+	if namespace != 0 {
+		if colon := strings.Index(title, ":"); colon >= 0 {
+			title = title[colon+1:]
+		}
+	}
+	return talkNamespace + ":" + title
+}
+
+// isHeatedSummary reports whether a talk-page edit summary reads like a dispute
+func isHeatedSummary(comment string) bool {
+	words := wordPattern.FindAllString(strings.ToLower(comment), -1)
+	joined := " " + strings.Join(words, " ") + " "
+	for _, keyword := range heatedSummaryKeywords {
+		if strings.Contains(joined, " "+keyword+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// analyzeTalkActivity fetches the talk page history over the analyzed window and
+// measures how heated the discussion is: talk activity, quick replies between
+// participants, dispute-like summaries and whether the editors behind the page's
+// reverts take part. Returns nil when the page has no talk page to fetch.
+func (pa *PageAnalyzer) analyzeTalkActivity(pageInfo *models.WikiPageInfo, conflicts models.ConflictStats) *models.TalkActivity {
+	namespaces, err := pa.client.GetNamespaceNames()
+	if err != nil {
+		logf("⚠️ [PAGE ANALYZER] Unable to retrieve namespace names: %v\n", err)
+		return nil
+	}
+	talkTitle := talkPageTitle(pageInfo.Title, pageInfo.NS, namespaces)
+	if talkTitle == "" {
+		return nil
+	}
+
+	talkHistory, err := pa.client.GetPageHistory(talkTitle, pa.numberOfDaysHistory)
+	if err != nil {
+		logf("⚠️ [PAGE ANALYZER] Unable to retrieve history of %s: %v\n", talkTitle, err)
+		return nil
+	}
+	talkHistory = ensureChronologicalOrder(talkHistory, false, talkTitle)
+
+	activity := &models.TalkActivity{
+		TalkTitle: talkTitle,
+		Revisions: len(talkHistory),
+	}
+
+	participants := make(map[string]bool)
+	var previousUser string
+	var previousTime time.Time
+	for _, rev := range talkHistory {
+		timestamp, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
+		if isHeatedSummary(rev.Comment) {
+			activity.HeatedSummaries++
+		}
+		if rev.UserHidden {
+			continue
+		}
+
+		user := utils.NormalizeUsername(rev.User)
+		participants[user] = true
+		if previousUser != "" && user != previousUser && timestamp.Sub(previousTime) <= talkReplyWindow {
+			activity.QuickReplies++
+		}
+		previousUser, previousTime = user, timestamp
+	}
+	activity.Participants = len(participants)
+
+	for _, user := range conflicts.ConflictingUsers {
+		if participants[utils.NormalizeUsername(user)] {
+			activity.ConflictParticipants++
+		}
+	}
+
+	activity.HeatScore = talkHeatScore(activity, len(conflicts.ConflictingUsers))
+	return activity
+}
+
+// talkHeatScore rates a talk page's discussion from 0 (silent) to 1 (busy,
+// argued and joined by the page's reverting editors)
+func talkHeatScore(activity *models.TalkActivity, conflictingUsers int) float64 {
+	if activity.Revisions == 0 {
+		return 0
+	}
+
+	volume := math.Min(float64(activity.Revisions)/talkBusyRevisions, 1.0)
+	replies := float64(activity.QuickReplies) / float64(activity.Revisions)
+	heated := float64(activity.HeatedSummaries) / float64(activity.Revisions)
+	involvement := 0.0
+	if conflictingUsers > 0 {
+		involvement = float64(activity.ConflictParticipants) / float64(conflictingUsers)
+	}
+
+	return math.Min(0.35*volume+0.2*replies+0.2*heated+0.25*involvement, 1.0)
+}
+
+// combinedControversy weighs a page's revert-based controversy with the heat of
+// its talk page: reverts over a silent talk page are likely vandalism patrol and
+// count half, reverts over a heated discussion count up to one and a half times
+func combinedControversy(controversy, talkHeat float64) float64 {
+	return math.Min(controversy*(0.5+talkHeat), 1.0)
+}
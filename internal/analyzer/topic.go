@@ -0,0 +1,180 @@
+// internal/analyzer/topic.go
+package analyzer
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// maxTopicPages bounds the number of distinct pages whose categories are fetched
+// when clustering a user's edits by topic (all in one API call)
+const maxTopicPages = 10
+
+// topicStopWords are title tokens too generic to link two pages together
+var topicStopWords = map[string]bool{
+	"with": true, "from": true, "list": true, "history": true,
+	"dans": true, "pour": true, "liste": true, "histoire": true,
+	"einer": true, "eines": true, "geschichte": true,
+	"lista": true, "para": true, "historia": true,
+}
+
+// maintenanceCategoryMarkers identify tracking/maintenance categories that say
+// nothing about a page's topic
+var maintenanceCategoryMarkers = []string{
+	"articles", "pages", "wikipedia", "cs1", "webarchive", "wikidata",
+	"short description", "use dmy", "use mdy", "stub", "ébauche", "article",
+	"page ", "artikel", "artículos",
+}
+
+// analyzeTopicClusters groups the main-namespace pages a user edited into topical
+// clusters. Two pages belong to the same cluster when they share a significant
+// title token or a non-maintenance category. Clusters are sorted by edit count.
+func (ua *UserAnalyzer) analyzeTopicClusters(contributions []models.WikiContribution) []models.TopicCluster {
+	editsByPage := make(map[string]int)
+	totalEdits := 0
+	for _, contrib := range contributions {
+		if contrib.NS != 0 {
+			continue
+		}
+		editsByPage[contrib.Title]++
+		totalEdits++
+	}
+
+	if len(editsByPage) < 2 {
+		return []models.TopicCluster{}
+	}
+
+	// Keep the most edited pages only, to bound API calls
+	var titles []string
+	for title := range editsByPage {
+		titles = append(titles, title)
+	}
+	sort.Slice(titles, func(i, j int) bool {
+		if editsByPage[titles[i]] != editsByPage[titles[j]] {
+			return editsByPage[titles[i]] > editsByPage[titles[j]]
+		}
+		return titles[i] < titles[j]
+	})
+	if len(titles) > maxTopicPages {
+		titles = titles[:maxTopicPages]
+	}
+
+	// Collect topic terms (title tokens + categories) for each page, the
+	// categories of all of them fetched at once
+	categoriesByPage, err := ua.client.GetPagesCategories(titles)
+	if err != nil {
+		logf("⚠️ [USER ANALYZER] Failed to get page categories: %v\n", err)
+	}
+	termsByPage := make(map[string]map[string]bool)
+	for _, title := range titles {
+		terms := make(map[string]bool)
+		for _, token := range topicTitleTokens(title) {
+			terms[token] = true
+		}
+
+		for _, category := range categoriesByPage[title] {
+			if !isMaintenanceCategory(category) {
+				terms["category:"+strings.ToLower(category)] = true
+			}
+		}
+
+		termsByPage[title] = terms
+	}
+
+	// Union pages sharing at least one term
+	parent := make(map[string]string)
+	for _, title := range titles {
+		parent[title] = title
+	}
+	var find func(string) string
+	find = func(title string) string {
+		if parent[title] != title {
+			parent[title] = find(parent[title])
+		}
+		return parent[title]
+	}
+
+	termOwner := make(map[string]string)
+	for _, title := range titles {
+		for term := range termsByPage[title] {
+			if owner, exists := termOwner[term]; exists {
+				parent[find(title)] = find(owner)
+			} else {
+				termOwner[term] = title
+			}
+		}
+	}
+
+	// Build clusters
+	members := make(map[string][]string)
+	for _, title := range titles {
+		root := find(title)
+		members[root] = append(members[root], title)
+	}
+
+	clusters := make([]models.TopicCluster, 0, len(members))
+	for _, pages := range members {
+		cluster := models.TopicCluster{
+			Pages:       pages,
+			SharedTerms: []string{},
+		}
+
+		termCount := make(map[string]int)
+		for _, page := range pages {
+			cluster.EditCount += editsByPage[page]
+			for term := range termsByPage[page] {
+				termCount[term]++
+			}
+		}
+		for term, count := range termCount {
+			if count > 1 {
+				cluster.SharedTerms = append(cluster.SharedTerms, strings.TrimPrefix(term, "category:"))
+			}
+		}
+		sort.Strings(cluster.SharedTerms)
+		sort.Strings(cluster.Pages)
+
+		if totalEdits > 0 {
+			cluster.EditRatio = float64(cluster.EditCount) / float64(totalEdits)
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].EditCount > clusters[j].EditCount
+	})
+
+	return clusters
+}
+
+// topicTitleTokens splits a page title into lowercase tokens significant enough
+// to relate two pages (disambiguation parentheses included)
+func topicTitleTokens(title string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	var tokens []string
+	for _, field := range fields {
+		if len([]rune(field)) < 4 || topicStopWords[field] {
+			continue
+		}
+		tokens = append(tokens, field)
+	}
+	return tokens
+}
+
+// isMaintenanceCategory checks if a category is a tracking/maintenance category
+func isMaintenanceCategory(category string) bool {
+	categoryLower := strings.ToLower(category)
+	for _, marker := range maintenanceCategoryMarkers {
+		if strings.Contains(categoryLower, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@
 package analyzer
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
@@ -10,11 +11,16 @@ import (
 
 	"github.com/intMeric/wikipedia-analyser/internal/client"
 	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
 )
 
 // UserAnalyzer analyzes Wikipedia user data
 type UserAnalyzer struct {
-	client *client.WikipediaClient
+	client         *client.WikipediaClient
+	includeDeleted bool  // Fold deleted contributions into the profile (admin access)
+	skipRevoked    bool  // Never run the per-page revoked contributions analysis
+	scanTopPages   bool  // Scan the most edited pages for conflicts
+	namespaces     []int // Namespace IDs the analyzed contributions are limited to, all if empty
 }
 
 // RevokedAnalysisConfig configuration for revoked contributions analysis
@@ -23,6 +29,25 @@ type RevokedAnalysisConfig struct {
 	MaxRevisionsPerPage int  `json:"max_revisions_per_page"`
 	EnableDeepAnalysis  bool `json:"enable_deep_analysis"`
 	RecentDaysOnly      int  `json:"recent_days_only"`
+	VerifyContent       bool `json:"verify_content"` // Deep analysis only: drop reverts that restored no earlier content
+}
+
+// Validate rejects configurations under which the revoked analysis would check
+// nothing. RecentDaysOnly 0 disables the age limit.
+func (c RevokedAnalysisConfig) Validate() error {
+	if c.MaxPagesToAnalyze < 1 {
+		return fmt.Errorf("max pages to analyze must be at least 1 (got %d), skip the revoked analysis instead", c.MaxPagesToAnalyze)
+	}
+	if c.EnableDeepAnalysis && c.MaxRevisionsPerPage < 1 {
+		return fmt.Errorf("max revisions per page must be at least 1 for deep analysis (got %d)", c.MaxRevisionsPerPage)
+	}
+	if c.VerifyContent && !c.EnableDeepAnalysis {
+		return fmt.Errorf("revert content verification requires deep analysis")
+	}
+	if c.RecentDaysOnly < 0 {
+		return fmt.Errorf("recent days limit cannot be negative (got %d)", c.RecentDaysOnly)
+	}
+	return nil
 }
 
 // QuickRevertResult result from quick revert analysis
@@ -39,6 +64,61 @@ func NewUserAnalyzer(client *client.WikipediaClient) *UserAnalyzer {
 	}
 }
 
+// SetIncludeDeleted enables fetching the user's deleted contributions, which the
+// API only returns to sessions with the deletedhistory right
+func (ua *UserAnalyzer) SetIncludeDeleted(include bool) {
+	ua.includeDeleted = include
+}
+
+// SetSkipRevoked bypasses the revoked contributions analysis, which costs one
+// revision query per edited page, whatever configuration is passed
+func (ua *UserAnalyzer) SetSkipRevoked(skip bool) {
+	ua.skipRevoked = skip
+}
+
+// SetScanTopPages enables a quick conflict scan of the user's most edited pages,
+// which costs one revision query per scanned page
+func (ua *UserAnalyzer) SetScanTopPages(scan bool) {
+	ua.scanTopPages = scan
+}
+
+// SetNamespaces limits the analyzed contributions to the given namespace IDs, so
+// that article work and talk page behavior can be profiled apart. The filter is
+// applied by the API, and to deleted contributions as they are folded in.
+func (ua *UserAnalyzer) SetNamespaces(namespaces []int) {
+	ua.namespaces = namespaces
+}
+
+// fetchContributions retrieves the recent contributions a profile is built from,
+// within the namespace filter
+func (ua *UserAnalyzer) fetchContributions(username string) ([]models.WikiContribution, error) {
+	if len(ua.namespaces) > 0 {
+		return ua.client.GetUserContributionsInNamespaces(username, 200, ua.namespaces)
+	}
+
+	// Without change tags, revert detection falls back on edit summaries
+	if !ua.client.Capabilities().ChangeTags() {
+		return ua.client.GetUserContributions(username, 200)
+	}
+	return ua.client.GetUserContributionsWithTags(username, 200)
+}
+
+// filterNamespaces keeps the contributions made in one of the namespaces
+func filterNamespaces(contributions []models.WikiContribution, namespaces []int) []models.WikiContribution {
+	wanted := make(map[int]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		wanted[namespace] = true
+	}
+
+	var filtered []models.WikiContribution
+	for _, contrib := range contributions {
+		if wanted[contrib.NS] {
+			filtered = append(filtered, contrib)
+		}
+	}
+	return filtered
+}
+
 // GetUserProfile retrieves and analyzes a complete user profile using default configuration
 // This method is kept for compatibility with other analyzers (PageAnalyzer, CrossPageAnalyzer)
 func (ua *UserAnalyzer) GetUserProfile(username string) (*models.UserProfile, error) {
@@ -49,6 +129,12 @@ func (ua *UserAnalyzer) GetUserProfile(username string) (*models.UserProfile, er
 
 // GetUserProfileWithConfig retrieves and analyzes a complete user profile with custom configuration
 func (ua *UserAnalyzer) GetUserProfileWithConfig(username string, config *RevokedAnalysisConfig) (*models.UserProfile, error) {
+	if config != nil {
+		if err := config.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid revoked analysis configuration: %w", err)
+		}
+	}
+
 	// 1. Get basic information
 	userInfo, err := ua.client.GetUserInfo(username)
 	if err != nil {
@@ -56,13 +142,13 @@ func (ua *UserAnalyzer) GetUserProfileWithConfig(username string, config *Revoke
 	}
 
 	// 2. Get recent contributions with tags
-	contributions, err := ua.client.GetUserContributionsWithTags(username, 200)
+	contributions, err := ua.fetchContributions(username)
 	if err != nil {
-		// Fallback to standard contributions if tags are not available
-		contributions, err = ua.client.GetUserContributions(username, 100)
-		if err != nil {
-			return nil, fmt.Errorf("unable to retrieve contributions: %w", err)
-		}
+		return nil, fmt.Errorf("unable to retrieve contributions: %w", err)
+	}
+	// Deleted contributions may still make a profile
+	if len(contributions) == 0 && !ua.includeDeleted {
+		return nil, ua.noContributionsError(userInfo)
 	}
 
 	// 3. Create basic profile
@@ -73,17 +159,13 @@ func (ua *UserAnalyzer) GetUserProfileWithConfig(username string, config *Revoke
 		Groups:         userInfo.Groups,
 		ImplicitGroups: userInfo.ImplicitGroups,
 		RightsInfo:     userInfo.Rights,
+		Namespaces:     ua.namespaces,
 		Language:       ua.client.Language(),
 		RetrievedAt:    time.Now(),
 	}
 
-	// 4. Parse registration date
-	if userInfo.Registration != "" {
-		regDate, err := time.Parse("2006-01-02T15:04:05Z", userInfo.Registration)
-		if err == nil {
-			profile.RegistrationDate = &regDate
-		}
-	}
+	// 4. Parse registration date (estimated from first edit for very old accounts)
+	profile.RegistrationDate, profile.RegistrationEst = resolveRegistrationDate(ua.client, userInfo)
 
 	// 5. Analyze block information
 	profile.BlockInfo = ua.analyzeBlockInfo(userInfo)
@@ -92,26 +174,51 @@ func (ua *UserAnalyzer) GetUserProfileWithConfig(username string, config *Revoke
 	profile.RecentContribs = ua.convertContributions(contributions)
 	profile.TopPages = ua.analyzeTopPages(contributions)
 	profile.ActivityStats = ua.analyzeActivity(contributions, profile.RegistrationDate)
+	profile.TopicClusters = ua.analyzeTopicClusters(contributions)
+	profile.CosmeticStats = ua.analyzeCosmeticEdits(contributions)
+	profile.AutoconfirmedJump = ua.analyzeAutoconfirmedJump(contributions, profile)
+	profile.Concentration = ua.analyzeEditConcentration(contributions)
+	profile.ConflictOfInterest = ua.analyzeConflictOfInterest(userInfo.Name, contributions)
+	profile.MassCreation = ua.analyzeMassCreation(contributions)
 
 	// 7. Analyze revoked contributions using provided configuration (or skip if nil)
+	var coverage coverageReport
 	var revokedContribs []models.RevokedContribution
-	if config != nil {
+	if config != nil && !ua.skipRevoked {
 		revokedContribs, err = ua.analyzeRevokedContributions(username, contributions, *config)
 		if err != nil {
-			fmt.Printf("⚠️ [USER ANALYZER] Failed to analyze revoked contributions: %v\n", err)
+			logf("⚠️ [USER ANALYZER] Failed to analyze revoked contributions: %v\n", err)
 			revokedContribs = []models.RevokedContribution{}
+			coverage.unavailable("Revoked contributions", fmt.Sprintf("analysis failed: %v", err))
+		} else {
+			coverage.ran("Revoked contributions")
+		}
+
+		switch {
+		case !config.EnableDeepAnalysis:
+			coverage.skipped("Deep revert analysis", "quick check only, reverters not identified")
+		case config.VerifyContent:
+			coverage.ran("Deep revert analysis")
+			coverage.ran("Revert content verification")
+		default:
+			coverage.ran("Deep revert analysis")
+			coverage.skipped("Revert content verification", "not requested")
 		}
 	} else {
 		// If no config provided, skip revoked analysis
 		revokedContribs = []models.RevokedContribution{}
+		profile.RevokedSkipped = true
+		coverage.skipped("Revoked contributions", "disabled")
 	}
 
 	// Calculate revoked contribution statistics
 	profile.RevokedContribs = revokedContribs
 	profile.RevokedCount = len(revokedContribs)
 
-	if len(contributions) > 0 {
-		profile.RevokedRatio = float64(profile.RevokedCount) / float64(len(contributions))
+	// Ratios are over the analyzed sample, not the lifetime edit count
+	profile.SampleSize = len(contributions)
+	if profile.SampleSize > 0 {
+		profile.RevokedRatio = float64(profile.RevokedCount) / float64(profile.SampleSize)
 	}
 
 	// Analyze who reverts this user most often
@@ -124,12 +231,258 @@ func (ua *UserAnalyzer) GetUserProfileWithConfig(username string, config *Revoke
 	// Mark revoked contributions in the recent contributions list
 	ua.markRevokedContributions(profile)
 
+	// Look for a return after a long inactivity gap (needs the revocation data)
+	profile.Reactivation = ua.analyzeReactivation(contributions, revokedContribs)
+
+	// Fold in deleted contributions when requested, skipping them when unauthorized
+	if ua.includeDeleted {
+		deletedContribs, err := ua.client.GetUserDeletedContributions(username, 500)
+		switch {
+		case errors.Is(err, client.ErrPermissionDenied):
+			logf("⚠️ [USER ANALYZER] Deleted contributions require administrator rights, skipping\n")
+			coverage.unavailable("Deleted contributions", "requires administrator rights")
+		case err != nil:
+			logf("⚠️ [USER ANALYZER] Failed to retrieve deleted contributions: %v\n", err)
+			coverage.unavailable("Deleted contributions", fmt.Sprintf("request failed: %v", err))
+		default:
+			if len(ua.namespaces) > 0 {
+				deletedContribs = filterNamespaces(deletedContribs, ua.namespaces)
+			}
+			profile.DeletedContribs = ua.convertContributions(deletedContribs)
+			profile.DeletedCount = len(deletedContribs)
+			coverage.ran("Deleted contributions")
+		}
+	} else {
+		coverage.skipped("Deleted contributions", "not requested")
+	}
+	if len(profile.RecentContribs) == 0 && profile.DeletedCount == 0 {
+		return nil, ua.noContributionsError(userInfo)
+	}
+
+	// Tell whether the activity concentrates on disputed pages
+	if ua.scanTopPages {
+		if scanned := ua.scanTopPagesControversy(profile.TopPages); scanned > 0 {
+			coverage.ran("Top pages conflict scan")
+		} else if len(profile.TopPages) > 0 {
+			coverage.unavailable("Top pages conflict scan", "revision requests failed")
+		}
+	} else {
+		coverage.skipped("Top pages conflict scan", "not requested")
+	}
+
+	// Small, spread-out edits to one article (needs the revocation data and the scan)
+	profile.LowProfileCampaign = ua.analyzeLowProfileCampaign(contributions, profile.TopPages, revokedContribs)
+	profile.Coverage = coverage.result(ua.client)
+
 	// 8. Calculate suspicion score (now with revocation data)
-	profile.SuspicionScore, profile.SuspicionFlags = ua.calculateSuspicionScore(profile)
+	profile.SuspicionScore, profile.SuspicionFlags, profile.ScoreBreakdown = ua.calculateSuspicionScore(profile)
 
 	return profile, nil
 }
 
+// noContributionsError explains why a user has no contributions to analyze
+func (ua *UserAnalyzer) noContributionsError(userInfo *models.WikiUserInfo) error {
+	switch {
+	case len(ua.namespaces) > 0:
+		return fmt.Errorf("%w: %s has no contributions in namespaces %v", ErrNoData, userInfo.Name, ua.namespaces)
+	case userInfo.EditCount == 0:
+		return fmt.Errorf("%w: %s has not made any edit", ErrNoData, userInfo.Name)
+	default:
+		return fmt.Errorf("%w: none of the %d edits of %s is visible (deleted or suppressed)", ErrNoData, userInfo.EditCount, userInfo.Name)
+	}
+}
+
+// resolveRegistrationDate parses the registration date of a user. Accounts created
+// before MediaWiki tracked registration have an empty field: the timestamp of their
+// earliest contribution is then used as an estimate (second return value true).
+func resolveRegistrationDate(wikiClient *client.WikipediaClient, userInfo *models.WikiUserInfo) (*time.Time, bool) {
+	if userInfo.Registration != "" {
+		regDate, err := time.Parse("2006-01-02T15:04:05Z", userInfo.Registration)
+		if err == nil {
+			return &regDate, false
+		}
+	}
+
+	firstContrib, err := wikiClient.GetUserFirstContribution(userInfo.Name)
+	if err != nil || firstContrib == nil {
+		return nil, false
+	}
+
+	firstEdit, err := time.Parse("2006-01-02T15:04:05Z", firstContrib.Timestamp)
+	if err != nil {
+		return nil, false
+	}
+
+	return &firstEdit, true
+}
+
+// Autoconfirmed status is granted after 10 edits and 4 days on most wikis
+const (
+	autoconfirmedEdits   = 10
+	autoconfirmedDays    = 4
+	autoconfirmedMaxJump = 5 // Edits beyond the threshold still considered "just reached"
+)
+
+// analyzeAutoconfirmedJump finds the first edit of a young account to a page then
+// semi-protected, and records how many edits and days the account had at that
+// moment. Only the edits up to the end of a jump are checked, one protection log
+// per page: the protection in force at the edit counts, not the current one.
+func (ua *UserAnalyzer) analyzeAutoconfirmedJump(contributions []models.WikiContribution, profile *models.UserProfile) *models.AutoconfirmedJump {
+	// Only meaningful when the full history is available and the registration date is exact
+	if profile.RegistrationDate == nil || profile.RegistrationEst || profile.EditCount > len(contributions) {
+		return nil
+	}
+
+	// Contributions are newest first: walk them in chronological order
+	protectionLogs := make(map[string][]models.WikiProtectionEvent)
+	for i := len(contributions) - 1; i >= 0; i-- {
+		contrib := contributions[i]
+		editsBefore := len(contributions) - 1 - i
+		if editsBefore > autoconfirmedEdits+autoconfirmedMaxJump {
+			break
+		}
+		if contrib.NS != 0 {
+			continue
+		}
+
+		timestamp, err := time.Parse("2006-01-02T15:04:05Z", contrib.Timestamp)
+		if err != nil {
+			return nil
+		}
+
+		events, fetched := protectionLogs[contrib.Title]
+		if !fetched {
+			events, err = ua.client.GetProtectionLog(contrib.Title)
+			if err != nil {
+				logf("⚠️ [USER ANALYZER] Failed to get the protection log of %s: %v\n", contrib.Title, err)
+			}
+			protectionLogs[contrib.Title] = events
+		}
+		if !semiProtectedAt(events, timestamp) {
+			continue
+		}
+
+		return &models.AutoconfirmedJump{
+			PageTitle:      contrib.Title,
+			Timestamp:      timestamp,
+			EditsBefore:    editsBefore,
+			AccountAgeDays: timestamp.Sub(*profile.RegistrationDate).Hours() / 24,
+		}
+	}
+
+	return nil
+}
+
+// Dormancy thresholds: a long gap followed by a burst of edits
+const (
+	dormancyMinDays      = 365 // Inactivity gap considered dormancy
+	reactivationWindow   = 30  // Days after the return counted as the reactivation burst
+	reactivationMinBurst = 10  // Edits within the window considered a burst
+)
+
+// analyzeReactivation finds the longest inactivity gap in the contributions and,
+// when it is long enough to be dormancy, describes the edits that followed it
+func (ua *UserAnalyzer) analyzeReactivation(contributions []models.WikiContribution, revoked []models.RevokedContribution) *models.Reactivation {
+	if len(contributions) < 2 {
+		return nil
+	}
+
+	timestamps := make([]time.Time, len(contributions))
+	for i, contrib := range contributions {
+		timestamps[i], _ = time.Parse("2006-01-02T15:04:05Z", contrib.Timestamp)
+	}
+
+	// Contributions are newest first: the gap before contributions[i] is t[i] - t[i+1]
+	returnIndex := -1
+	var longestGap time.Duration
+	for i := 0; i < len(contributions)-1; i++ {
+		if gap := timestamps[i].Sub(timestamps[i+1]); gap > longestGap {
+			longestGap = gap
+			returnIndex = i
+		}
+	}
+
+	gapDays := int(longestGap.Hours() / 24)
+	if returnIndex < 0 || gapDays < dormancyMinDays {
+		return nil
+	}
+
+	revokedRevIDs := make(map[int]bool)
+	for _, revokedContrib := range revoked {
+		revokedRevIDs[revokedContrib.OriginalContrib.RevID] = true
+	}
+
+	reactivation := &models.Reactivation{
+		DormantSince:  timestamps[returnIndex+1],
+		ReactivatedAt: timestamps[returnIndex],
+		GapDays:       gapDays,
+		WindowDays:    reactivationWindow,
+	}
+	windowEnd := timestamps[returnIndex].AddDate(0, 0, reactivationWindow)
+
+	for i := returnIndex; i >= 0 && !timestamps[i].After(windowEnd); i-- {
+		contrib := contributions[i]
+		reactivation.BurstEdits++
+		if !utils.Contains(reactivation.BurstPages, contrib.Title) {
+			reactivation.BurstPages = append(reactivation.BurstPages, contrib.Title)
+		}
+		if revokedRevIDs[contrib.RevID] || ua.isRevokedByTags(contrib.Tags) {
+			reactivation.ContentiousEdits++
+		}
+	}
+
+	return reactivation
+}
+
+// Campaign thresholds: most of the edits inside one calendar window
+const (
+	campaignWindowDays = 30
+	campaignMinEdits   = 20
+	campaignMinRatio   = 0.9
+)
+
+// analyzeEditConcentration finds the densest window of campaignWindowDays days in
+// the contributions and the share of all edits it holds
+func (ua *UserAnalyzer) analyzeEditConcentration(contributions []models.WikiContribution) *models.EditConcentration {
+	if len(contributions) == 0 {
+		return nil
+	}
+
+	timestamps := make([]time.Time, 0, len(contributions))
+	for _, contrib := range contributions {
+		if timestamp, err := time.Parse("2006-01-02T15:04:05Z", contrib.Timestamp); err == nil {
+			timestamps = append(timestamps, timestamp)
+		}
+	}
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i].Before(timestamps[j])
+	})
+
+	// Sliding window: for each start edit, count the edits within the window
+	window := time.Duration(campaignWindowDays) * 24 * time.Hour
+	concentration := &models.EditConcentration{
+		WindowDays: campaignWindowDays,
+		TotalEdits: len(timestamps),
+	}
+	end := 0
+	for start := range timestamps {
+		for end < len(timestamps) && timestamps[end].Sub(timestamps[start]) <= window {
+			end++
+		}
+		if count := end - start; count > concentration.WindowEdits {
+			concentration.WindowEdits = count
+			concentration.WindowStart = timestamps[start]
+			concentration.WindowEnd = timestamps[end-1]
+		}
+	}
+
+	if concentration.TotalEdits > 0 {
+		concentration.Ratio = float64(concentration.WindowEdits) / float64(concentration.TotalEdits)
+	}
+
+	return concentration
+}
+
 // analyzeBlockInfo analyzes block information
 func (ua *UserAnalyzer) analyzeBlockInfo(userInfo *models.WikiUserInfo) *models.BlockInfo {
 	blockInfo := &models.BlockInfo{
@@ -226,10 +579,95 @@ func (ua *UserAnalyzer) analyzeTopPages(contributions []models.WikiContribution)
 	return topPages
 }
 
+// Top pages conflict scan: the topPagesScanned most edited pages, each over its
+// topPagesScanRevisions latest revisions. A page is disputed from
+// disputedPageControversy controversy.
+const (
+	topPagesScanned         = 5
+	topPagesScanRevisions   = 50
+	disputedPageControversy = 0.3
+)
+
+// scanTopPagesControversy annotates the most edited pages with a quick conflict
+// scan of their latest revisions, by anyone. Pages whose revisions cannot be
+// retrieved are left unannotated. Returns the number of pages scanned.
+func (ua *UserAnalyzer) scanTopPagesControversy(topPages []models.PageEditSummary) int {
+	pageAnalyzer := NewPageAnalyzer(ua.client, PageAnalysisOptions{})
+	scanned := 0
+	for i := range topPages {
+		if i >= topPagesScanned {
+			break
+		}
+		revisions, err := ua.client.GetPageRevisions(topPages[i].PageTitle, topPagesScanRevisions)
+		if err != nil {
+			logf("⚠️ [USER ANALYZER] Failed to scan %s for conflicts: %v\n", topPages[i].PageTitle, err)
+			continue
+		}
+		topPages[i].Controversy = pageControversy(pageAnalyzer, revisions)
+		scanned++
+	}
+	return scanned
+}
+
+// pageControversy scores a page's latest revisions with the page analysis's
+// conflict formula: the same revert weights, self-revert and tool-assisted
+// exclusions, on the same 0 to 1 scale as a page profile's controversy score
+func pageControversy(pageAnalyzer *PageAnalyzer, revisions []models.WikiRevision) *models.PageControversy {
+	controversy := &models.PageControversy{
+		ScannedRevisions: len(revisions),
+		Reverters:        []string{},
+		StabilityScore:   1.0,
+	}
+	if len(revisions) == 0 {
+		return controversy
+	}
+
+	stats := pageAnalyzer.analyzeConflicts(pageAnalyzer.newHistoryPass(revisions))
+	controversy.Reverts = stats.ReversionsCount
+	controversy.Reverters = append(controversy.Reverters, stats.ConflictingUsers...)
+	sort.Strings(controversy.Reverters)
+	controversy.StabilityScore = stats.StabilityScore
+	controversy.ControversyScore = stats.ControversyScore
+	controversy.Disputed = controversy.ControversyScore >= disputedPageControversy
+
+	return controversy
+}
+
+// defaultNamespaceNames names the English Wikipedia namespaces when siteinfo is unavailable
+var defaultNamespaceNames = map[int]string{
+	0:   "Main",
+	1:   "Talk",
+	2:   "User",
+	3:   "User talk",
+	4:   "Wikipedia",
+	6:   "File",
+	10:  "Template",
+	14:  "Category",
+	100: "Portal",
+}
+
+// namespaceNames returns the localized namespace names of the wiki, falling back
+// to the English names when siteinfo cannot be retrieved
+func (ua *UserAnalyzer) namespaceNames() map[int]string {
+	siteNames, err := ua.client.GetNamespaceNames()
+	if err != nil {
+		logf("⚠️ [USER ANALYZER] Unable to retrieve namespace names, using English defaults: %v\n", err)
+		return defaultNamespaceNames
+	}
+
+	names := make(map[int]string, len(siteNames))
+	for id, name := range siteNames {
+		names[id] = name
+	}
+	names[0] = defaultNamespaceNames[0] // The article namespace has no name
+	return names
+}
+
 // analyzeActivity analyzes activity patterns
 func (ua *UserAnalyzer) analyzeActivity(contributions []models.WikiContribution, regDate *time.Time) models.ActivityStats {
 	stats := models.ActivityStats{
 		NamespaceDistrib: make(map[string]int),
+		NamespaceCounts:  make(map[int]int),
 		RecentActivity:   make([]models.DailyActivity, 0),
 	}
 
@@ -237,18 +675,8 @@ func (ua *UserAnalyzer) analyzeActivity(contributions []models.WikiContribution,
 		return stats
 	}
 
-	// Analyze namespaces
-	namespaceNames := map[int]string{
-		0:   "Main",
-		1:   "Talk",
-		2:   "User",
-		3:   "User talk",
-		4:   "Wikipedia",
-		6:   "File",
-		10:  "Template",
-		14:  "Category",
-		100: "Portal",
-	}
+	// Analyze namespaces, named as on this wiki
+	namespaceNames := ua.namespaceNames()
 
 	hourStats := make(map[int]int)
 	dayStats := make(map[string]int)
@@ -263,6 +691,7 @@ func (ua *UserAnalyzer) analyzeActivity(contributions []models.WikiContribution,
 			nsName = fmt.Sprintf("NS_%d", contrib.NS)
 		}
 		stats.NamespaceDistrib[nsName]++
+		stats.NamespaceCounts[contrib.NS]++
 
 		// Hour stats
 		hourStats[timestamp.Hour()]++
@@ -310,11 +739,11 @@ func (ua *UserAnalyzer) analyzeActivity(contributions []models.WikiContribution,
 	}
 	sort.Strings(dates)
 
+	// Limit to the last 30 active days
+	if len(dates) > 30 {
+		dates = dates[len(dates)-30:]
+	}
 	for _, date := range dates {
-		if len(stats.RecentActivity) >= 30 { // Limit to last 30 days
-			break
-		}
-
 		parsedDate, _ := time.Parse("2006-01-02", date)
 		stats.RecentActivity = append(stats.RecentActivity, models.DailyActivity{
 			Date:      parsedDate,
@@ -369,7 +798,7 @@ func (ua *UserAnalyzer) analyzeRevokedContributions(username string, contributio
 		if lightAnalysis.HasReverts {
 			// Deep analysis only if necessary and enabled
 			if config.EnableDeepAnalysis {
-				pageReverts, err := ua.deepRevertAnalysis(username, contrib.Title, config.MaxRevisionsPerPage)
+				pageReverts, err := ua.deepRevertAnalysis(username, contrib.Title, config.MaxRevisionsPerPage, config.VerifyContent)
 				if err == nil {
 					revokedContribs = append(revokedContribs, pageReverts...)
 				}
@@ -516,6 +945,11 @@ func (ua *UserAnalyzer) isRevokedByTags(tags []string) bool {
 
 // classifyRevertTypeFromTags classifies revert type from tags
 func (ua *UserAnalyzer) classifyRevertTypeFromTags(tags []string) string {
+	return classifyRevertTags(tags)
+}
+
+// classifyRevertTags classifies the type of a revert from its change tags
+func classifyRevertTags(tags []string) string {
 	for _, tag := range tags {
 		tagLower := strings.ToLower(tag)
 		if strings.Contains(tagLower, "vandal") {
@@ -545,7 +979,7 @@ func (ua *UserAnalyzer) quickRevertCheck(username string, pageTitle string) Quic
 	for _, rev := range revisions {
 		comment := strings.ToLower(rev.Comment)
 		userMentioned := strings.Contains(comment, strings.ToLower(username))
-		isRevert := ua.detectRevert(rev.Comment)
+		isRevert := ua.isRevertRevision(rev)
 
 		if isRevert && userMentioned {
 			revertCount++
@@ -569,21 +1003,24 @@ func (ua *UserAnalyzer) quickRevertCheck(username string, pageTitle string) Quic
 }
 
 // deepRevertAnalysis performs detailed analysis of reverts for a specific page
-func (ua *UserAnalyzer) deepRevertAnalysis(username string, pageTitle string, maxRevisions int) ([]models.RevokedContribution, error) {
+func (ua *UserAnalyzer) deepRevertAnalysis(username string, pageTitle string, maxRevisions int, verifyContent bool) ([]models.RevokedContribution, error) {
 	// Get page revision history
 	pageHistory, err := ua.client.GetPageRevisions(pageTitle, maxRevisions)
 	if err != nil {
 		return nil, fmt.Errorf("could not get history for %s: %w", pageTitle, err)
 	}
+	pageHistory = ensureChronologicalOrder(pageHistory, true, pageTitle)
 
 	// Find reverts of user's contributions
-	userReverts := ua.findUserReverts(username, pageHistory, pageTitle)
+	userReverts := ua.findUserReverts(username, pageHistory, pageTitle, verifyContent)
 
 	return userReverts, nil
 }
 
-// findUserReverts finds reverts of a specific user's contributions
-func (ua *UserAnalyzer) findUserReverts(username string, pageHistory []models.WikiRevision, pageTitle string) []models.RevokedContribution {
+// findUserReverts finds reverts of a specific user's contributions. With
+// verifyContent, a revert must also have restored the content from before the
+// reverted edit: a "revert" summary over a new edit is not counted.
+func (ua *UserAnalyzer) findUserReverts(username string, pageHistory []models.WikiRevision, pageTitle string, verifyContent bool) []models.RevokedContribution {
 	var reverts []models.RevokedContribution
 
 	// Create a map of revisions by user
@@ -603,6 +1040,9 @@ func (ua *UserAnalyzer) findUserReverts(username string, pageHistory []models.Wi
 
 		// Check if this revision reverts a user's contribution
 		revertInfo := ua.detectUserRevert(rev, userRevisions)
+		if revertInfo != nil && verifyContent && !ua.verifyRestoredContent(rev, *revertInfo, pageHistory) {
+			revertInfo = nil
+		}
 		if revertInfo != nil {
 			timestamp, _ := time.Parse("2006-01-02T15:04:05Z", rev.Timestamp)
 			originalTimestamp, _ := time.Parse("2006-01-02T15:04:05Z", revertInfo.Timestamp)
@@ -623,6 +1063,11 @@ func (ua *UserAnalyzer) findUserReverts(username string, pageHistory []models.Wi
 				RevertType:    ua.classifyRevertType(rev.Comment),
 			}
 
+			// Change tags give the revert type reliably, whatever the wiki language
+			if tagRevertType := revertTypeFromTags(rev.Tags); tagRevertType != "" {
+				revert.RevertType = tagRevertType
+			}
+
 			reverts = append(reverts, revert)
 		}
 	}
@@ -648,11 +1093,14 @@ func (ua *UserAnalyzer) detectUserRevert(revision models.WikiRevision, userRevis
 		revertKeywords = []string{"revert", "undo", "undid", "rv", "reverted", "restore", "restored", "rollback", "rolled back"}
 	}
 
-	isRevert := false
-	for _, keyword := range revertKeywords {
-		if strings.Contains(comment, keyword) {
-			isRevert = true
-			break
+	// Change tags are authoritative; fall back to keywords otherwise
+	isRevert := revertTypeFromTags(revision.Tags) != ""
+	if !isRevert {
+		for _, keyword := range revertKeywords {
+			if strings.Contains(comment, keyword) {
+				isRevert = true
+				break
+			}
 		}
 	}
 
@@ -736,9 +1184,15 @@ func (ua *UserAnalyzer) detectUserRevert(revision models.WikiRevision, userRevis
 
 // classifyRevertType classifies the type of revert
 func (ua *UserAnalyzer) classifyRevertType(comment string) string {
+	return classifyRevertComment(comment, ua.client.Language())
+}
+
+// classifyRevertComment classifies the type of a revert from its summary, in
+// the keywords of the wiki's language
+func classifyRevertComment(comment, language string) string {
 	comment = strings.ToLower(comment)
 
-	switch ua.client.Language() {
+	switch language {
 	case "fr":
 		if strings.Contains(comment, "vandalisme") || strings.Contains(comment, "vandalisé") {
 			return "vandalism_revert"
@@ -823,29 +1277,25 @@ func (ua *UserAnalyzer) markRevokedContributions(profile *models.UserProfile) {
 }
 
 // calculateSuspicionScore calculates a suspicion score including revoked contributions
-func (ua *UserAnalyzer) calculateSuspicionScore(profile *models.UserProfile) (int, []string) {
-	score := 0
-	flags := []string{}
+func (ua *UserAnalyzer) calculateSuspicionScore(profile *models.UserProfile) (int, []string, *models.ScoreBreakdown) {
+	card := &scoreCard{}
 
 	// 1. Recent account with high activity
 	if profile.RegistrationDate != nil {
 		daysSinceReg := int(time.Since(*profile.RegistrationDate).Hours() / 24)
 		if daysSinceReg < 30 && profile.EditCount > 100 {
-			score += 20
-			flags = append(flags, "RECENT_ACCOUNT_HIGH_ACTIVITY")
+			card.add("RECENT_ACCOUNT_HIGH_ACTIVITY", 20, fmt.Sprintf("account %d days old with %d edits", daysSinceReg, profile.EditCount))
 		}
 	}
 
 	// 2. Blocked user
 	if profile.BlockInfo != nil && profile.BlockInfo.Blocked {
-		score += 30
-		flags = append(flags, "USER_BLOCKED")
+		card.add("USER_BLOCKED", 30, fmt.Sprintf("blocked by %s: %s", profile.BlockInfo.BlockedBy, profile.BlockInfo.Reason))
 	}
 
 	// 3. Focus on small number of pages
 	if len(profile.TopPages) > 0 && profile.TopPages[0].EditCount > profile.EditCount/2 {
-		score += 15
-		flags = append(flags, "SINGLE_PAGE_FOCUS")
+		card.add("SINGLE_PAGE_FOCUS", 15, fmt.Sprintf("%d of %d edits on %s", profile.TopPages[0].EditCount, profile.EditCount, profile.TopPages[0].PageTitle))
 	}
 
 	// 4. No special groups (unconfirmed user)
@@ -857,25 +1307,21 @@ func (ua *UserAnalyzer) calculateSuspicionScore(profile *models.UserProfile) (in
 		}
 	}
 	if !hasSpecialGroups && profile.EditCount > 50 {
-		score += 10
-		flags = append(flags, "NO_SPECIAL_GROUPS")
+		card.add("NO_SPECIAL_GROUPS", 10, fmt.Sprintf("%d edits without any special group", profile.EditCount))
 	}
 
-	// 5. Activity only in sensitive namespaces
-	sensitiveNamespaces := []string{"Main", "Wikipedia", "Portal"}
+	// 5. Activity only in sensitive namespaces (article, project, portal)
+	sensitiveNamespaces := map[int]bool{0: true, 4: true, 100: true}
 	totalSensitive := 0
 	totalEdits := 0
-	for ns, count := range profile.ActivityStats.NamespaceDistrib {
+	for ns, count := range profile.ActivityStats.NamespaceCounts {
 		totalEdits += count
-		for _, sensitive := range sensitiveNamespaces {
-			if ns == sensitive {
-				totalSensitive += count
-			}
+		if sensitiveNamespaces[ns] {
+			totalSensitive += count
 		}
 	}
 	if totalEdits > 0 && float64(totalSensitive)/float64(totalEdits) > 0.9 {
-		score += 15
-		flags = append(flags, "SENSITIVE_NAMESPACE_FOCUS")
+		card.add("SENSITIVE_NAMESPACE_FOCUS", 15, fmt.Sprintf("%d of %d edits in Main, Wikipedia and Portal namespaces", totalSensitive, totalEdits))
 	}
 
 	// 6. Empty or repetitive edit comments
@@ -886,29 +1332,23 @@ func (ua *UserAnalyzer) calculateSuspicionScore(profile *models.UserProfile) (in
 		}
 	}
 	if len(profile.RecentContribs) > 0 && float64(emptyComments)/float64(len(profile.RecentContribs)) > 0.7 {
-		score += 10
-		flags = append(flags, "FREQUENT_EMPTY_COMMENTS")
+		card.add("FREQUENT_EMPTY_COMMENTS", 10, fmt.Sprintf("%d of %d recent edits without a comment", emptyComments, len(profile.RecentContribs)))
 	}
 
 	// 7. High ratio of revoked contributions
 	if profile.RevokedRatio > 0.5 { // More than 50% revoked
-		score += 30
-		flags = append(flags, "VERY_HIGH_REVOKED_RATIO")
+		card.add("VERY_HIGH_REVOKED_RATIO", 30, fmt.Sprintf("%.1f%% of %d sampled contributions revoked", profile.RevokedRatio*100, profile.SampleSize))
 	} else if profile.RevokedRatio > 0.3 { // More than 30%
-		score += 20
-		flags = append(flags, "HIGH_REVOKED_RATIO")
+		card.add("HIGH_REVOKED_RATIO", 20, fmt.Sprintf("%.1f%% of %d sampled contributions revoked", profile.RevokedRatio*100, profile.SampleSize))
 	} else if profile.RevokedRatio > 0.2 { // More than 20%
-		score += 10
-		flags = append(flags, "MODERATE_REVOKED_RATIO")
+		card.add("MODERATE_REVOKED_RATIO", 10, fmt.Sprintf("%.1f%% of %d sampled contributions revoked", profile.RevokedRatio*100, profile.SampleSize))
 	}
 
 	// 8. Many revoked contributions in absolute value
 	if profile.RevokedCount > 50 {
-		score += 15
-		flags = append(flags, "MANY_REVOKED_CONTRIBUTIONS")
+		card.add("MANY_REVOKED_CONTRIBUTIONS", 15, fmt.Sprintf("%d revoked contributions", profile.RevokedCount))
 	} else if profile.RevokedCount > 20 {
-		score += 10
-		flags = append(flags, "SOME_REVOKED_CONTRIBUTIONS")
+		card.add("SOME_REVOKED_CONTRIBUTIONS", 10, fmt.Sprintf("%d revoked contributions", profile.RevokedCount))
 	}
 
 	// 9. Revoked mainly for vandalism
@@ -920,11 +1360,9 @@ func (ua *UserAnalyzer) calculateSuspicionScore(profile *models.UserProfile) (in
 	}
 
 	if vandalismReverts > 10 {
-		score += 25
-		flags = append(flags, "VANDALISM_PATTERN")
+		card.add("VANDALISM_PATTERN", 25, fmt.Sprintf("%d reverts classified as vandalism", vandalismReverts))
 	} else if vandalismReverts > 5 {
-		score += 15
-		flags = append(flags, "SOME_VANDALISM_REVERTS")
+		card.add("SOME_VANDALISM_REVERTS", 15, fmt.Sprintf("%d reverts classified as vandalism", vandalismReverts))
 	}
 
 	for username, count := range profile.RevertedByUsers {
@@ -933,9 +1371,8 @@ func (ua *UserAnalyzer) calculateSuspicionScore(profile *models.UserProfile) (in
 		}
 
 		if count > 5 && profile.RevokedCount > 0 && float64(count)/float64(profile.RevokedCount) > 0.5 {
-			score += 15
 			conflictFlag := fmt.Sprintf("CONFLICT_WITH_SPECIFIC_USER_%s", username)
-			flags = append(flags, conflictFlag)
+			card.add(conflictFlag, 15, fmt.Sprintf("%d of %d revocations by %s", count, profile.RevokedCount, username))
 			break
 		}
 	}
@@ -944,17 +1381,94 @@ func (ua *UserAnalyzer) calculateSuspicionScore(profile *models.UserProfile) (in
 	if profile.RegistrationDate != nil {
 		daysSinceReg := int(time.Since(*profile.RegistrationDate).Hours() / 24)
 		if daysSinceReg < 30 && profile.RevokedCount > 10 {
-			score += 20
-			flags = append(flags, "NEW_ACCOUNT_MANY_REVERTS")
+			card.add("NEW_ACCOUNT_MANY_REVERTS", 20, fmt.Sprintf("account %d days old with %d revoked contributions", daysSinceReg, profile.RevokedCount))
+		}
+	}
+
+	// 12. Nearly all edits in one topical cluster spanning several pages
+	if len(profile.TopicClusters) > 0 {
+		dominant := profile.TopicClusters[0]
+		if len(dominant.Pages) > 1 && dominant.EditCount >= 10 && dominant.EditRatio >= 0.9 {
+			card.add("SINGLE_PURPOSE_ACCOUNT", 20, fmt.Sprintf("%.0f%% of edits on %d related pages (%s)", dominant.EditRatio*100, len(dominant.Pages), strings.Join(dominant.SharedTerms, ", ")))
+		}
+	}
+
+	// 13. Edit count inflated by cosmetic-only edits
+	if profile.CosmeticStats != nil && profile.CosmeticStats.SampledEdits >= minCosmeticSample && profile.CosmeticStats.CosmeticRatio >= 0.5 {
+		card.add("COSMETIC_EDIT_INFLATION", 15, fmt.Sprintf("%d of %d sampled edits cosmetic only", profile.CosmeticStats.CosmeticEdits, profile.CosmeticStats.SampledEdits))
+	}
+
+	// 14. Minimum edits to become autoconfirmed, then straight to a semi-protected page
+	if jump := profile.AutoconfirmedJump; jump != nil {
+		if jump.EditsBefore >= autoconfirmedEdits && jump.EditsBefore <= autoconfirmedEdits+autoconfirmedMaxJump &&
+			jump.AccountAgeDays >= autoconfirmedDays && jump.AccountAgeDays < autoconfirmedDays+3 {
+			card.add("AUTOCONFIRMED_GAMING", 25, fmt.Sprintf("edited semi-protected %s after %d edits, %.1f days after registration", jump.PageTitle, jump.EditsBefore, jump.AccountAgeDays))
+		}
+	}
+
+	// 15. Dormant account reactivated with a burst of contested edits
+	if reactivation := profile.Reactivation; reactivation != nil {
+		if reactivation.BurstEdits >= reactivationMinBurst && reactivation.ContentiousEdits >= 2 {
+			card.add("REACTIVATED_AFTER_DORMANCY", 20, fmt.Sprintf("%d days inactive, then %d edits (%d reverted)", reactivation.GapDays, reactivation.BurstEdits, reactivation.ContentiousEdits))
+		}
+	}
+
+	// 16. Large share of contributions deleted by administrators
+	if profile.DeletedCount >= 5 {
+		deletedRatio := float64(profile.DeletedCount) / float64(profile.DeletedCount+len(profile.RecentContribs))
+		if deletedRatio >= 0.2 {
+			card.add("HIGH_DELETED_CONTRIBUTIONS", 15, fmt.Sprintf("%d deleted contributions (%.0f%%)", profile.DeletedCount, deletedRatio*100))
 		}
 	}
 
-	// Limit score to 100
-	if score > 100 {
-		score = 100
+	// 17. Nearly all edits crammed into one window, then silence (campaign account).
+	// Only meaningful when the whole history was fetched.
+	if concentration := profile.Concentration; concentration != nil && profile.EditCount <= concentration.TotalEdits {
+		sinceWindow := time.Since(concentration.WindowEnd)
+		if concentration.TotalEdits >= campaignMinEdits && concentration.Ratio >= campaignMinRatio &&
+			sinceWindow > time.Duration(campaignWindowDays)*24*time.Hour {
+			card.add("CAMPAIGN_WINDOW_CONCENTRATION", 20, fmt.Sprintf("%d of %d edits between %s and %s", concentration.WindowEdits, concentration.TotalEdits, concentration.WindowStart.Format("2006-01-02"), concentration.WindowEnd.Format("2006-01-02")))
+		}
+	}
+
+	// 18. Edits centered on one person or organization, adding promotional wording
+	if coi := profile.ConflictOfInterest; coi != nil && coi.PromotionalEdits >= coiMinPromotionalEdits {
+		evidence := fmt.Sprintf("%d of %d edits on %s and related pages, promotional wording in %d of %d sampled edits (%s)",
+			coi.EntityEdits, coi.TotalEdits, coi.Entity, coi.PromotionalEdits, coi.SampledEdits, strings.Join(coi.PromotionalTerms, ", "))
+		if coi.MatchesUsername {
+			evidence += ", entity named like the account"
+		}
+		card.add("POSSIBLE_COI", 20, evidence)
 	}
 
-	return score, flags
+	// 19. Many stubs created from one boilerplate skeleton
+	if creation := profile.MassCreation; creation != nil && len(creation.TemplatePages) >= massCreationMinPages {
+		card.add("MASS_STUB_CREATION", 25, fmt.Sprintf("%d of %d created pages share a template skeleton (%.0f%% similar, %d bytes on average)",
+			len(creation.TemplatePages), creation.CreatedPages, creation.TemplateSimilarity*100, creation.AverageStubBytes))
+	}
+
+	// 20. Many small, spread-out edits chipping at one contentious article
+	if campaign := profile.LowProfileCampaign; campaign != nil && campaign.Contentious {
+		evidence := fmt.Sprintf("%d edits (%.0f%% of sample) to %s over %d days, %d bytes on average, at most %d a day",
+			campaign.Edits, campaign.Share*100, campaign.PageTitle, campaign.SpanDays, campaign.AverageBytes, campaign.MaxDailyEdits)
+		if campaign.RevertedEdits > 0 {
+			evidence += fmt.Sprintf(", %d reverted", campaign.RevertedEdits)
+		}
+		if campaign.Disputed {
+			evidence += ", page disputed"
+		}
+		card.add("LOW_PROFILE_SUSTAINED_CAMPAIGN", 20, evidence)
+	}
+
+	return card.result()
+}
+
+// isRevertRevision checks if a revision is a revert, preferring change tags over comment keywords
+func (ua *UserAnalyzer) isRevertRevision(revision models.WikiRevision) bool {
+	if revertTypeFromTags(revision.Tags) != "" {
+		return true
+	}
+	return ua.detectRevert(revision.Comment)
 }
 
 // detectRevert checks if a comment indicates a revert
@@ -0,0 +1,107 @@
+// internal/analyzer/vandalism.go
+package analyzer
+
+import (
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// knownVandalism is a page version reverted as vandalism, by content hash
+type knownVandalism struct {
+	vandal models.WikiRevision // Reverted revision
+	revert models.WikiRevision
+}
+
+// isVandalismRevert reports whether a revert gives vandalism as its reason, in
+// its summary or its change tags
+func isVandalismRevert(rev models.WikiRevision, language string) bool {
+	return classifyRevertComment(rev.Comment, language) == "vandalism_revert" ||
+		classifyRevertTags(rev.Tags) == "vandalism_revert"
+}
+
+// undoesRevert reports whether a revision is an undo or a rollback of revert
+// itself, the way a patroller takes back a mistaken revert, rather than the
+// content being put back by hand
+func undoesRevert(rev, revert models.WikiRevision) bool {
+	if rev.ParentID != revert.RevID {
+		return false
+	}
+	switch revertTypeFromTags(rev.Tags) {
+	case "undo", "rollback":
+		return true
+	}
+	return false
+}
+
+// detectReintroducedVandalism finds the revisions restoring content a patroller
+// reverted as vandalism. Each vandalism revert fingerprints the version it
+// removed, its parent, by sha1; a later revision with the same sha1 puts the
+// very same content back. Only exact restorations match: the content re-added
+// on top of other changes hashes differently. The reverter restoring the
+// version, or anyone undoing or rolling back the revert itself, is taking back
+// a mistaken revert and is left out.
+func (pa *PageAnalyzer) detectReintroducedVandalism(history *historyPass) []models.ReintroducedVandalism {
+	language := pa.client.Language()
+	knownBad := make(map[string]knownVandalism)
+
+	var reintroduced []models.ReintroducedVandalism
+	for i, rev := range history.revisions {
+		if rev.SHA1 != "" {
+			bad, exists := knownBad[rev.SHA1]
+			takesBack := exists && (!rev.UserHidden && !bad.revert.UserHidden && rev.User == bad.revert.User || undoesRevert(rev, bad.revert))
+			if exists && rev.RevID != bad.vandal.RevID && !takesBack {
+				reintroduced = append(reintroduced, models.ReintroducedVandalism{
+					RevisionID:       rev.RevID,
+					User:             rev.User,
+					Timestamp:        history.timestamps[i],
+					VandalRevisionID: bad.vandal.RevID,
+					VandalUser:       bad.vandal.User,
+					RevertRevisionID: bad.revert.RevID,
+					RevertedBy:       bad.revert.User,
+					SameAccount:      !rev.UserHidden && !bad.vandal.UserHidden && utils.NormalizeUsername(rev.User) == utils.NormalizeUsername(bad.vandal.User),
+				})
+			}
+		}
+
+		if !history.isRevert[rev.RevID] || !isVandalismRevert(rev, language) {
+			continue
+		}
+		vandal, exists := history.revisionsByID[rev.ParentID]
+		if !exists || vandal.SHA1 == "" || vandal.SHA1 == rev.SHA1 {
+			continue
+		}
+		if _, exists := knownBad[vandal.SHA1]; !exists {
+			knownBad[vandal.SHA1] = knownVandalism{vandal: vandal, revert: rev}
+		}
+	}
+
+	return reintroduced
+}
+
+// markReintroducedVandalism flags the revisions and the contributors that
+// restored content reverted as vandalism
+func markReintroducedVandalism(profile *models.PageProfile) {
+	reintroduced := profile.ConflictStats.ReintroducedVandalism
+	if len(reintroduced) == 0 {
+		return
+	}
+
+	revisionIDs := make(map[int]bool, len(reintroduced))
+	users := make(map[string]bool)
+	for _, restore := range reintroduced {
+		revisionIDs[restore.RevisionID] = true
+		users[restore.User] = true
+	}
+
+	for i := range profile.RecentRevisions {
+		if revisionIDs[profile.RecentRevisions[i].RevID] {
+			profile.RecentRevisions[i].ReintroducesVandalism = true
+		}
+	}
+	for i := range profile.Contributors {
+		contributor := &profile.Contributors[i]
+		if users[contributor.Username] && !utils.Contains(contributor.SuspicionFlags, "REINTRODUCED_VANDALISM") {
+			contributor.SuspicionFlags = append(contributor.SuspicionFlags, "REINTRODUCED_VANDALISM")
+		}
+	}
+}
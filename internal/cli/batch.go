@@ -0,0 +1,309 @@
+// internal/cli/batch.go
+package cli
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/intMeric/wikipedia-analyser/internal/analyzer"
+	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
+	"github.com/spf13/cobra"
+)
+
+var (
+	batchOutputFormat string
+	batchLanguage     string
+	batchOutputDir    string
+	batchManifestFile string
+	batchResume       bool
+	batchRetries      int
+	batchSkipRevoked  bool
+)
+
+// batchCmd represents the batch command
+var batchCmd = &cobra.Command{
+	Use:   "batch",
+	Short: "Analyze a list of users or pages, resumably",
+	Long: `Analyze every user or page listed in a file, one per line (blank lines
+and lines starting with # are ignored), saving one report per entity.
+
+A JSON manifest records which entities completed and which failed, rewritten
+after each entity. After a crash or a partly failed run, run the same command
+with --resume: completed entities are skipped and failed ones tried again.
+
+Configuration options:
+  --out-dir: Directory receiving the reports (default: batch)
+  --manifest: Manifest file (default: manifest.json in --out-dir)
+  --resume: Continue the batch recorded in the manifest
+  --retries: Extra attempts for an entity whose analysis fails, 2s apart then
+    doubling (default: 2)
+
+Examples:
+  wikiosint batch users accounts.txt --out-dir sweep
+  wikiosint batch pages pages.txt --lang fr --output table
+  wikiosint batch pages pages.txt --resume`,
+}
+
+// batchUsersCmd represents the batch users command
+var batchUsersCmd = &cobra.Command{
+	Use:   "users [file]",
+	Short: "Profile every user listed in a file",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBatchUsers,
+}
+
+// batchPagesCmd represents the batch pages command
+var batchPagesCmd = &cobra.Command{
+	Use:   "pages [file]",
+	Short: "Analyze every page listed in a file",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBatchPages,
+}
+
+func init() {
+	batchCmd.AddCommand(batchUsersCmd)
+	batchCmd.AddCommand(batchPagesCmd)
+
+	for _, cmd := range []*cobra.Command{batchUsersCmd, batchPagesCmd} {
+		cmd.Flags().StringVarP(&batchOutputFormat, "output", "o", "json", "report format of each entity (table, plain, json, yaml)")
+		cmd.Flags().StringVarP(&batchLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
+		cmd.Flags().StringVar(&batchOutputDir, "out-dir", "batch", "directory receiving one report per entity")
+		cmd.Flags().StringVar(&batchManifestFile, "manifest", "", "manifest recording completed and failed entities (default: manifest.json in --out-dir)")
+		cmd.Flags().BoolVar(&batchResume, "resume", false, "continue the batch recorded in the manifest, skipping completed entities")
+		cmd.Flags().IntVar(&batchRetries, "retries", 2, "extra attempts for an entity whose analysis fails")
+	}
+
+	batchUsersCmd.Flags().BoolVar(&batchSkipRevoked, "skip-revoked", false, "skip the revoked contributions analysis for faster profiles")
+
+	batchPagesCmd.Flags().IntVar(&pageMaxRevisions, "max-revisions", 100, "maximum number of revisions to analyze")
+	batchPagesCmd.Flags().IntVar(&pageMaxContributors, "max-contributors", 20, "maximum number of contributors to analyze")
+	batchPagesCmd.Flags().IntVar(&pageMaxHistory, "max-history", 30, "maximum number of days for detailed history")
+}
+
+func runBatchUsers(cmd *cobra.Command, args []string) error {
+	analysisClient, err := newAnalysisClient(cmd, batchLanguage)
+	if err != nil {
+		return err
+	}
+
+	userOptions := wikiosint.UserOptions{SkipRevoked: batchSkipRevoked}
+
+	return runBatch(args[0], "users", func(username string) (string, error) {
+		profile, err := analysisClient.AnalyzeUser(username, userOptions)
+		if err != nil {
+			return "", err
+		}
+		return formatter.FormatUserProfile(profile, batchOutputFormat)
+	})
+}
+
+func runBatchPages(cmd *cobra.Command, args []string) error {
+	domainClassifier, err := newDomainClassifier()
+	if err != nil {
+		return err
+	}
+
+	analysisOptions := wikiosint.PageOptions{
+		NumberOfPageRevisions: pageMaxRevisions,
+		NumberOfDaysHistory:   pageMaxHistory,
+		NumberOfContributors:  pageMaxContributors,
+		DomainClassifier:      domainClassifier,
+	}
+	if err := analysisOptions.Validate(); err != nil {
+		return fmt.Errorf("invalid analysis options: %w", err)
+	}
+
+	analysisClient, err := newAnalysisClient(cmd, batchLanguage)
+	if err != nil {
+		return err
+	}
+
+	return runBatch(args[0], "pages", func(title string) (string, error) {
+		profile, err := analysisClient.AnalyzePage(title, analysisOptions)
+		if err != nil {
+			return "", err
+		}
+		return formatter.FormatPageProfile(profile, batchOutputFormat)
+	})
+}
+
+// runBatch reads the entities listed in inputFile and runs the batch under the
+// manifest, writing each rendered report to the output directory
+func runBatch(inputFile, kind string, render func(entity string) (string, error)) error {
+	if _, exists := outputFileExtensions[batchOutputFormat]; !exists {
+		return fmt.Errorf("unsupported output format: %s (supported: table, plain, json, yaml)", batchOutputFormat)
+	}
+	if batchRetries < 0 {
+		return fmt.Errorf("retries must not be negative")
+	}
+
+	entities, err := readBatchEntities(inputFile)
+	if err != nil {
+		return err
+	}
+	if len(entities) == 0 {
+		return fmt.Errorf("no %s listed in %s", kind, inputFile)
+	}
+
+	if err := os.MkdirAll(batchOutputDir, 0755); err != nil {
+		return fmt.Errorf("unable to create output directory: %w", err)
+	}
+
+	manifestPath := batchManifestPath()
+	var manifest *analyzer.BatchManifest
+	if batchResume {
+		manifest, err = loadBatchManifest(manifestPath, kind, batchLanguage)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Starting over would forget the progress of the previous run
+		if _, err := os.Stat(manifestPath); err == nil {
+			return fmt.Errorf("batch manifest %s already exists: use --resume to continue it, or remove it to start over", manifestPath)
+		}
+		manifest = analyzer.NewBatchManifest(kind, batchLanguage)
+	}
+
+	fmt.Printf("📦 Batch analysis of %d %s from %s\n", len(entities), kind, inputFile)
+	fmt.Printf("📡 Fetching data from %s.wikipedia.org...\n", batchLanguage)
+	fmt.Printf("📝 Manifest: %s\n", manifestPath)
+
+	options := analyzer.BatchOptions{
+		Retries: batchRetries,
+		Checkpoint: func(manifest *analyzer.BatchManifest) error {
+			return saveBatchManifest(manifestPath, manifest)
+		},
+		Progress: func(index, total int, entity string, entry *analyzer.BatchEntity) {
+			if entry.Status == analyzer.BatchCompleted {
+				fmt.Printf("✅ [%d/%d] %s: %s\n", index, total, entity, entry.Output)
+			} else {
+				fmt.Printf("❌ [%d/%d] %s: %s\n", index, total, entity, entry.Error)
+			}
+		},
+	}
+
+	summary, err := analyzer.RunBatch(entities, manifest, options, func(entity string) (string, error) {
+		output, err := render(entity)
+		if err != nil {
+			return "", err
+		}
+		path := filepath.Join(batchOutputDir, batchFileName(entity)+"."+outputFileExtensions[batchOutputFormat])
+		if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+			return "", fmt.Errorf("error saving file: %w", err)
+		}
+		return path, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("📊 Batch done: %d completed, %d failed, %d skipped (already completed)\n",
+		summary.Completed, summary.Failed, summary.Skipped)
+	if summary.Failed > 0 {
+		fmt.Printf("💡 Run the same command with --resume to retry the failed %s\n", kind)
+	}
+
+	return nil
+}
+
+// batchManifestPath returns the manifest file, by default in the output directory
+func batchManifestPath() string {
+	if batchManifestFile != "" {
+		return batchManifestFile
+	}
+	return filepath.Join(batchOutputDir, "manifest.json")
+}
+
+// loadBatchManifest reopens the manifest at path to resume a batch. A missing
+// manifest starts empty; an existing one must belong to the same kind of batch
+// and wiki.
+func loadBatchManifest(path, kind, language string) (*analyzer.BatchManifest, error) {
+	manifest := analyzer.NewBatchManifest(kind, language)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read batch manifest: %w", err)
+	}
+
+	var stored analyzer.BatchManifest
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("unable to decode batch manifest %s: %w", path, err)
+	}
+	if stored.Kind != kind || stored.Language != language {
+		return nil, fmt.Errorf("batch manifest %s records a %s batch on %s.wikipedia.org, not %s on %s.wikipedia.org",
+			path, stored.Kind, stored.Language, kind, language)
+	}
+	if stored.Entities != nil {
+		manifest.Entities = stored.Entities
+	}
+
+	return manifest, nil
+}
+
+// saveBatchManifest writes the manifest to path
+func saveBatchManifest(path string, manifest *analyzer.BatchManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to encode batch manifest: %w", err)
+	}
+
+	// Write then rename, so a crash never leaves a truncated manifest
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("unable to write batch manifest: %w", err)
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// readBatchEntities reads one entity per line, skipping blank lines, # comments
+// and duplicates
+func readBatchEntities(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open batch input: %w", err)
+	}
+	defer file.Close()
+
+	var entities []string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entity := strings.TrimSpace(scanner.Text())
+		if entity == "" || strings.HasPrefix(entity, "#") || seen[entity] {
+			continue
+		}
+		seen[entity] = true
+		entities = append(entities, entity)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read batch input: %w", err)
+	}
+
+	return entities, nil
+}
+
+// batchFileName turns a username or page title into a file name, replacing the
+// characters file systems reject. Distinct entities can map to the same
+// characters ("A/B" and "A_B"), so a hash of the entity keeps their files apart.
+func batchFileName(entity string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '.' {
+			return r
+		}
+		return '_'
+	}, entity)
+	sum := sha256.Sum256([]byte(entity))
+	return name + "-" + hex.EncodeToString(sum[:4])
+}
@@ -3,14 +3,14 @@ package cli
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/intMeric/wikipedia-analyser/internal/analyzer"
 	"github.com/intMeric/wikipedia-analyser/internal/client"
 	"github.com/intMeric/wikipedia-analyser/internal/formatter"
 	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +21,7 @@ var (
 	contributionAnalysisDepth  string
 	contributionIncludeContent bool
 	contributionIncludeContext bool
+	contributionMaxProfiles    int
 )
 
 // contributionCmd represents the contribution command
@@ -33,7 +34,7 @@ suspicious editing patterns, content quality issues, and potential violations.`,
 
 // analyzeContributionCmd represents the contribution analyze command
 var analyzeContributionCmd = &cobra.Command{
-	Use:   "analyze [revision_id] [page_title]",
+	Use:   "analyze [revision_id|diff_url] [page_title]",
 	Short: "Analyze a specific Wikipedia contribution",
 	Long: `Comprehensive analysis of a specific Wikipedia contribution including:
 - Author analysis and behavior patterns
@@ -47,6 +48,8 @@ You can specify either:
   - Just revision ID: analyze [revision_id]
   - Revision ID and page: analyze [revision_id] [page_title]
   - Page and find latest: analyze latest [page_title]
+  - A diff or permalink URL: analyze "https://en.wikipedia.org/w/index.php?diff=12345&oldid=12000"
+    (language and page title are taken from the URL)
 
 Configuration options:
   --depth: Analysis depth (basic, standard, deep) - default: standard
@@ -99,27 +102,38 @@ func init() {
 	contributionCmd.AddCommand(suspiciousContributionsCmd)
 
 	// Flags for analyze command
-	analyzeContributionCmd.Flags().StringVarP(&contributionOutputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	analyzeContributionCmd.Flags().StringVarP(&contributionOutputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
 	analyzeContributionCmd.Flags().StringVarP(&contributionLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
 	analyzeContributionCmd.Flags().StringVar(&contributionSaveToFile, "save", "", "save result to file")
+	analyzeContributionCmd.Flags().IntVar(&contributionMaxProfiles, "max-author-profiles", 25, "maximum number of full author profiles to analyze")
 	analyzeContributionCmd.Flags().StringVar(&contributionAnalysisDepth, "depth", "standard", "analysis depth (basic, standard, deep)")
 	analyzeContributionCmd.Flags().BoolVar(&contributionIncludeContent, "include-content", true, "include detailed content analysis")
 	analyzeContributionCmd.Flags().BoolVar(&contributionIncludeContext, "include-context", false, "include contextual analysis (auto-enabled for deep)")
+	analyzeContributionCmd.Flags().BoolVar(&listFlagged, "list-flagged", false, "only print the revision ID, if its suspicion score meets --flagged-threshold")
+	analyzeContributionCmd.Flags().IntVar(&flaggedThreshold, "flagged-threshold", formatter.DefaultFlaggedThreshold, "suspicion score from which --list-flagged lists an entry (0-100)")
 
 	// Flags for recent command
-	recentContributionsCmd.Flags().StringVarP(&contributionOutputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	recentContributionsCmd.Flags().StringVarP(&contributionOutputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
 	recentContributionsCmd.Flags().StringVarP(&contributionLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
 	recentContributionsCmd.Flags().StringVar(&contributionSaveToFile, "save", "", "save result to file")
+	recentContributionsCmd.Flags().IntVar(&contributionMaxProfiles, "max-author-profiles", 25, "maximum number of full author profiles to analyze")
 	recentContributionsCmd.Flags().StringVar(&contributionAnalysisDepth, "depth", "basic", "analysis depth (basic, standard)")
 	recentContributionsCmd.Flags().IntVar(&recentLimit, "limit", 10, "number of recent contributions to analyze (5-50)")
+	recentContributionsCmd.Flags().BoolVar(&listFlagged, "list-flagged", false, "only print the IDs of the revisions whose suspicion score meets --flagged-threshold, one per line")
+	recentContributionsCmd.Flags().IntVar(&flaggedThreshold, "flagged-threshold", formatter.DefaultFlaggedThreshold, "suspicion score from which --list-flagged lists an entry (0-100)")
 
 	// Flags for suspicious command
-	suspiciousContributionsCmd.Flags().StringVarP(&contributionOutputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	suspiciousContributionsCmd.Flags().StringVarP(&contributionOutputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
 	suspiciousContributionsCmd.Flags().StringVarP(&contributionLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
 	suspiciousContributionsCmd.Flags().StringVar(&contributionSaveToFile, "save", "", "save result to file")
+	suspiciousContributionsCmd.Flags().IntVar(&contributionMaxProfiles, "max-author-profiles", 25, "maximum number of full author profiles to analyze")
 	suspiciousContributionsCmd.Flags().IntVar(&suspicionThreshold, "threshold", 40, "minimum suspicion score threshold (0-100)")
 	suspiciousContributionsCmd.Flags().IntVar(&scanDays, "days", 30, "number of days to scan back")
 	suspiciousContributionsCmd.Flags().IntVar(&suspiciousLimit, "limit", 20, "maximum suspicious contributions to show")
+	suspiciousContributionsCmd.Flags().IntVar(&scanConcurrency, "concurrency", 4, "number of revisions analyzed at once (1-16)")
+	suspiciousContributionsCmd.Flags().DurationVar(&scanMaxDuration, "max-duration", 0, "stop scanning after this long and report what was found so far (0 for unlimited)")
+	suspiciousContributionsCmd.Flags().BoolVar(&listFlagged, "list-flagged", false, "only print the IDs of the revisions whose suspicion score meets --flagged-threshold, one per line")
+	suspiciousContributionsCmd.Flags().IntVar(&flaggedThreshold, "flagged-threshold", formatter.DefaultFlaggedThreshold, "suspicion score from which --list-flagged lists an entry (0-100)")
 }
 
 var (
@@ -127,15 +141,36 @@ var (
 	suspicionThreshold int = 40
 	scanDays           int = 30
 	suspiciousLimit    int = 20
+	scanConcurrency    int = 4
+	scanMaxDuration    time.Duration
 )
 
 func runContributionAnalyze(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(contributionOutputFormat)
+	if err != nil {
+		return err
+	}
+
 	// Parse arguments
 	var revisionID int
 	var pageTitle string
-	var err error
 
-	if strings.ToLower(args[0]) == "latest" {
+	if strings.HasPrefix(args[0], "http://") || strings.HasPrefix(args[0], "https://") {
+		// Diff or permalink URL pasted from the browser
+		link, err := client.ParseRevisionURL(args[0])
+		if err != nil {
+			return err
+		}
+		revisionID = link.RevisionID
+		pageTitle = link.Title
+		if len(args) > 1 {
+			pageTitle = args[1]
+		}
+		if !cmd.Flags().Changed("lang") {
+			contributionLanguage = link.Language
+		}
+	} else if strings.ToLower(args[0]) == "latest" {
 		// Special case: analyze latest revision of a page
 		if len(args) != 2 {
 			return fmt.Errorf("when using 'latest', you must specify a page title")
@@ -166,67 +201,64 @@ func runContributionAnalyze(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create Wikipedia client
-	wikiClient := client.NewWikipediaClient(contributionLanguage)
+	analysisClient, err := newAnalysisClient(cmd, contributionLanguage)
+	if err != nil {
+		return err
+	}
 
 	// Create contribution analysis options
-	analysisOptions := analyzer.ContributionAnalysisOptions{
-		AnalysisDepth:  contributionAnalysisDepth,
-		IncludeContent: contributionIncludeContent,
-		IncludeContext: contributionIncludeContext,
+	analysisOptions := wikiosint.ContributionOptions{
+		AnalysisDepth:     contributionAnalysisDepth,
+		IncludeContent:    contributionIncludeContent,
+		IncludeContext:    contributionIncludeContext,
+		MaxAuthorProfiles: contributionMaxProfiles,
 	}
 
-	// Create contribution analyzer with options
-	contributionAnalyzer := analyzer.NewContributionAnalyzer(wikiClient, analysisOptions)
-
 	// Display analysis start info
 	if revisionID == 0 {
-		fmt.Printf("🔍 Analyzing latest contribution to: %s\n", pageTitle)
+		fmt.Fprintf(progressOutput, "🔍 Analyzing latest contribution to: %s\n", pageTitle)
 	} else if pageTitle != "" {
-		fmt.Printf("🔍 Analyzing contribution: Revision %d on %s\n", revisionID, pageTitle)
+		fmt.Fprintf(progressOutput, "🔍 Analyzing contribution: Revision %d on %s\n", revisionID, pageTitle)
 	} else {
-		fmt.Printf("🔍 Analyzing contribution: Revision %d\n", revisionID)
+		fmt.Fprintf(progressOutput, "🔍 Analyzing contribution: Revision %d\n", revisionID)
 	}
-	fmt.Printf("📡 Fetching data from %s.wikipedia.org...\n", contributionLanguage)
-	fmt.Printf("📊 Analysis depth: %s\n", contributionAnalysisDepth)
+	fmt.Fprintf(progressOutput, "📡 Fetching data from %s.wikipedia.org...\n", contributionLanguage)
+	fmt.Fprintf(progressOutput, "📊 Analysis depth: %s\n", contributionAnalysisDepth)
 	if contributionIncludeContent {
-		fmt.Printf("📝 Including detailed content analysis...\n")
+		fmt.Fprintf(progressOutput, "📝 Including detailed content analysis...\n")
 	}
 	if contributionIncludeContext {
-		fmt.Printf("🔍 Including contextual analysis...\n")
+		fmt.Fprintf(progressOutput, "🔍 Including contextual analysis...\n")
 	}
 
 	// Retrieve and analyze contribution
-	contributionProfile, err := contributionAnalyzer.GetContributionProfile(revisionID, pageTitle)
+	contributionProfile, err := analysisClient.AnalyzeContribution(revisionID, pageTitle, analysisOptions)
 	if err != nil {
 		return fmt.Errorf("error retrieving contribution profile: %w", err)
 	}
 
-	fmt.Printf("✅ Analysis completed! Revision %d analyzed\n", contributionProfile.RevisionID)
+	fmt.Fprintf(progressOutput, "✅ Analysis completed! Revision %d analyzed\n", contributionProfile.RevisionID)
 	if contributionProfile.SuspicionScore > 50 {
-		fmt.Printf("⚠️  High suspicion score detected: %d/100\n", contributionProfile.SuspicionScore)
-	}
-
-	// Format and display results
-	output, err := formatter.FormatContributionProfile(contributionProfile, contributionOutputFormat)
-	if err != nil {
-		return fmt.Errorf("error formatting output: %w", err)
+		fmt.Fprintf(progressOutput, "⚠️  High suspicion score detected: %d/100\n", contributionProfile.SuspicionScore)
 	}
 
-	// Display or save
-	if contributionSaveToFile != "" {
-		err = os.WriteFile(contributionSaveToFile, []byte(output), 0644)
-		if err != nil {
-			return fmt.Errorf("error saving file: %w", err)
-		}
-		fmt.Printf("✅ Results saved to: %s\n", contributionSaveToFile)
-	} else {
-		fmt.Print(output)
+	if listFlagged {
+		return emitFlaggedList(formatter.FlaggedContributions([]*models.ContributionProfile{contributionProfile}, flaggedThreshold))
 	}
 
-	return nil
+	// Format and display results
+	return emitOutput(outputFormats, contributionSaveToFile, "Results saved to", func(format string) (string, error) {
+		return formatter.FormatContributionProfile(contributionProfile, format)
+	})
 }
 
 func runRecentContributions(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(contributionOutputFormat)
+	if err != nil {
+		return err
+	}
+
 	pageTitle := args[0]
 
 	// Validate limit
@@ -239,86 +271,91 @@ func runRecentContributions(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("for recent contributions analysis, depth must be 'basic' or 'standard'")
 	}
 
-	// Create Wikipedia client
-	wikiClient := client.NewWikipediaClient(contributionLanguage)
-
-	// Create analysis options
-	analysisOptions := analyzer.ContributionAnalysisOptions{
-		AnalysisDepth:  contributionAnalysisDepth,
-		IncludeContent: contributionAnalysisDepth == "standard",
-		IncludeContext: false, // Too expensive for bulk analysis
+	// Create analysis client
+	analysisClient, err := newAnalysisClient(cmd, contributionLanguage)
+	if err != nil {
+		return err
 	}
 
-	contributionAnalyzer := analyzer.NewContributionAnalyzer(wikiClient, analysisOptions)
-
-	fmt.Printf("🔍 Analyzing %d recent contributions to: %s\n", recentLimit, pageTitle)
-	fmt.Printf("📡 Fetching data from %s.wikipedia.org...\n", contributionLanguage)
-	fmt.Printf("📊 Analysis depth: %s\n", contributionAnalysisDepth)
+	fmt.Fprintf(progressOutput, "🔍 Analyzing %d recent contributions to: %s\n", recentLimit, pageTitle)
+	fmt.Fprintf(progressOutput, "📡 Fetching data from %s.wikipedia.org...\n", contributionLanguage)
+	fmt.Fprintf(progressOutput, "📊 Analysis depth: %s\n", contributionAnalysisDepth)
 
 	// Get recent revisions
-	revisions, err := wikiClient.GetPageRevisions(pageTitle, recentLimit)
+	revisions, err := analysisClient.RecentRevisions(pageTitle, recentLimit)
 	if err != nil {
 		return fmt.Errorf("error retrieving page revisions: %w", err)
 	}
 
 	if len(revisions) == 0 {
-		fmt.Printf("❌ No revisions found for page: %s\n", pageTitle)
+		fmt.Fprintf(progressOutput, "❌ No revisions found for page: %s\n", pageTitle)
 		return nil
 	}
 
-	fmt.Printf("📊 Found %d recent revisions, analyzing...\n", len(revisions))
+	fmt.Fprintf(progressOutput, "📊 Found %d recent revisions, analyzing...\n", len(revisions))
 
 	// Analyze each revision
-	var results []string
-	suspiciousCount := 0
-
+	revisionIDs := make([]int, len(revisions))
 	for i, revision := range revisions {
-		fmt.Printf("📝 Analyzing revision %d/%d (ID: %d)...\n", i+1, len(revisions), revision.RevID)
-
-		profile, err := contributionAnalyzer.GetContributionProfile(revision.RevID, pageTitle)
-		if err != nil {
-			fmt.Printf("⚠️  Failed to analyze revision %d: %v\n", revision.RevID, err)
-			continue
-		}
-
-		if profile.SuspicionScore >= 30 {
-			suspiciousCount++
-		}
-
-		// Format individual result
-		output, err := formatter.FormatContributionProfile(profile, contributionOutputFormat)
-		if err != nil {
-			fmt.Printf("⚠️  Failed to format revision %d: %v\n", revision.RevID, err)
-			continue
-		}
+		revisionIDs[i] = revision.RevID
+	}
 
-		results = append(results, output)
-		results = append(results, "\n"+strings.Repeat("═", 80)+"\n\n")
+	suspiciousCount := 0
+	profiles, err := analysisClient.AnalyzeContributions(revisionIDs, pageTitle, wikiosint.ContributionsOptions{
+		ContributionOptions: wikiosint.ContributionOptions{
+			AnalysisDepth:     contributionAnalysisDepth,
+			IncludeContent:    contributionAnalysisDepth == "standard",
+			IncludeContext:    false, // Too expensive for bulk analysis
+			MaxAuthorProfiles: contributionMaxProfiles,
+		},
+		OnRevisionAnalyzed: func(index, total, revisionID int, profile *models.ContributionProfile, err error) {
+			if err != nil {
+				fmt.Fprintf(progressOutput, "⚠️  Failed to analyze revision %d: %v\n", revisionID, err)
+				return
+			}
+			fmt.Fprintf(progressOutput, "📝 Analyzed revision %d/%d (ID: %d)\n", index, total, revisionID)
+			if profile.SuspicionScore >= 30 {
+				suspiciousCount++
+			}
+		},
+	})
+	if err != nil {
+		return err
 	}
 
-	fmt.Printf("✅ Analysis completed! %d revisions analyzed\n", len(results)/2)
+	fmt.Fprintf(progressOutput, "✅ Analysis completed! %d revisions analyzed\n", len(profiles))
 	if suspiciousCount > 0 {
-		fmt.Printf("⚠️  Found %d contributions with elevated suspicion scores\n", suspiciousCount)
+		fmt.Fprintf(progressOutput, "⚠️  Found %d contributions with elevated suspicion scores\n", suspiciousCount)
 	}
 
-	// Combine all results
-	finalOutput := strings.Join(results, "")
-
-	// Display or save
-	if contributionSaveToFile != "" {
-		err = os.WriteFile(contributionSaveToFile, []byte(finalOutput), 0644)
-		if err != nil {
-			return fmt.Errorf("error saving file: %w", err)
-		}
-		fmt.Printf("✅ Results saved to: %s\n", contributionSaveToFile)
-	} else {
-		fmt.Print(finalOutput)
+	if listFlagged {
+		return emitFlaggedList(formatter.FlaggedContributions(profiles, flaggedThreshold))
 	}
 
-	return nil
+	// Format, combine and display results
+	return emitOutput(outputFormats, contributionSaveToFile, "Results saved to", func(format string) (string, error) {
+		var results []string
+		for _, profile := range profiles {
+			output, err := formatter.FormatContributionProfile(profile, format)
+			if err != nil {
+				fmt.Fprintf(progressOutput, "⚠️  Failed to format revision %d: %v\n", profile.RevisionID, err)
+				continue
+			}
+
+			results = append(results, output)
+			results = append(results, "\n"+strings.Repeat("═", 80)+"\n\n")
+		}
+		return strings.Join(results, ""), nil
+	})
 }
 
 func runSuspiciousContributions(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(contributionOutputFormat)
+	if err != nil {
+		return err
+	}
+
 	pageTitle := args[0]
 
 	// Validate parameters
@@ -331,104 +368,95 @@ func runSuspiciousContributions(cmd *cobra.Command, args []string) error {
 	if suspiciousLimit < 1 || suspiciousLimit > 100 {
 		return fmt.Errorf("suspicious limit must be between 1 and 100")
 	}
+	if scanConcurrency < 1 || scanConcurrency > 16 {
+		return fmt.Errorf("concurrency must be between 1 and 16")
+	}
+	if scanMaxDuration < 0 {
+		return fmt.Errorf("max duration must not be negative")
+	}
 
-	// Create Wikipedia client
-	wikiClient := client.NewWikipediaClient(contributionLanguage)
+	// Create analysis client
+	analysisClient, err := newAnalysisClient(cmd, contributionLanguage)
+	if err != nil {
+		return err
+	}
 
 	// Create analysis options (use basic for bulk scanning)
-	analysisOptions := analyzer.ContributionAnalysisOptions{
-		AnalysisDepth:  "basic",
-		IncludeContent: false,
-		IncludeContext: false,
+	analysisOptions := wikiosint.ContributionOptions{
+		AnalysisDepth:     "basic",
+		IncludeContent:    false,
+		IncludeContext:    false,
+		MaxAuthorProfiles: contributionMaxProfiles,
 	}
 
-	contributionAnalyzer := analyzer.NewContributionAnalyzer(wikiClient, analysisOptions)
-
-	fmt.Printf("🔍 Scanning for suspicious contributions to: %s\n", pageTitle)
-	fmt.Printf("📊 Threshold: %d/100, Scanning: %d days back\n", suspicionThreshold, scanDays)
-	fmt.Printf("📡 Fetching data from %s.wikipedia.org...\n", contributionLanguage)
+	fmt.Fprintf(progressOutput, "🔍 Scanning for suspicious contributions to: %s\n", pageTitle)
+	fmt.Fprintf(progressOutput, "📊 Threshold: %d/100, Scanning: %d days back\n", suspicionThreshold, scanDays)
+	fmt.Fprintf(progressOutput, "📡 Fetching data from %s.wikipedia.org...\n", contributionLanguage)
 
 	// Get page history for the specified time period
-	history, err := wikiClient.GetPageHistory(pageTitle, scanDays)
+	history, err := analysisClient.PageHistory(pageTitle, scanDays)
 	if err != nil {
 		return fmt.Errorf("error retrieving page history: %w", err)
 	}
 
 	if len(history) == 0 {
-		fmt.Printf("❌ No revisions found in the last %d days for page: %s\n", scanDays, pageTitle)
+		fmt.Fprintf(progressOutput, "❌ No revisions found in the last %d days for page: %s\n", scanDays, pageTitle)
 		return nil
 	}
 
-	fmt.Printf("📊 Found %d revisions in the last %d days, scanning for suspicious activity...\n", len(history), scanDays)
-
-	// Scan and analyze suspicious revisions
-	var suspiciousProfiles []*models.ContributionProfile
-	scannedCount := 0
-
-	for _, revision := range history {
-		scannedCount++
-		if scannedCount%10 == 0 {
-			fmt.Printf("📝 Scanned %d/%d revisions...\n", scannedCount, len(history))
-		}
-
-		// Quick analysis to get suspicion score
-		profile, err := contributionAnalyzer.GetContributionProfile(revision.RevID, pageTitle)
-		if err != nil {
-			continue // Skip failed analyses
-		}
-
-		// Check if meets suspicion threshold
-		if profile.SuspicionScore >= suspicionThreshold {
-			suspiciousProfiles = append(suspiciousProfiles, profile)
-
-			// Stop if we've found enough suspicious contributions
-			if len(suspiciousProfiles) >= suspiciousLimit {
-				break
+	fmt.Fprintf(progressOutput, "📊 Found %d revisions in the last %d days, scanning for suspicious activity...\n", len(history), scanDays)
+
+	// Scan and analyze suspicious revisions, highest suspicion first
+	scan, err := analysisClient.ScanContributions(pageTitle, history, analysisOptions, wikiosint.ScanOptions{
+		Threshold:   suspicionThreshold,
+		Limit:       suspiciousLimit,
+		Concurrency: scanConcurrency,
+		MaxDuration: scanMaxDuration,
+		Progress: func(scanned, total int) {
+			if scanned%10 == 0 {
+				fmt.Fprintf(progressOutput, "📝 Scanned %d/%d revisions...\n", scanned, total)
 			}
-		}
+		},
+	})
+	if err != nil {
+		return err
 	}
+	suspiciousProfiles := scan.Profiles
 
-	fmt.Printf("✅ Scan completed! Found %d suspicious contributions\n", len(suspiciousProfiles))
+	if scan.TimedOut {
+		fmt.Fprintf(progressOutput, "⏱️  Scan stopped after %s (--max-duration): %d/%d revisions scanned, results are partial\n",
+			scanMaxDuration, scan.Scanned, len(history))
+	}
+	fmt.Fprintf(progressOutput, "✅ Scan completed! Found %d suspicious contributions\n", len(suspiciousProfiles))
 
 	if len(suspiciousProfiles) == 0 {
-		fmt.Printf("🎉 No suspicious contributions found with threshold %d/100\n", suspicionThreshold)
+		fmt.Fprintf(progressOutput, "🎉 No suspicious contributions found with threshold %d/100\n", suspicionThreshold)
 		return nil
 	}
 
-	// Sort by suspicion score (highest first)
-	// Note: In a real implementation, you'd want to sort the slice
-	// For now, they're already in chronological order
-
-	// Format results
-	var results []string
-	results = append(results, fmt.Sprintf("🚨 SUSPICIOUS CONTRIBUTIONS REPORT\n"))
-	results = append(results, fmt.Sprintf("Page: %s | Threshold: %d/100 | Found: %d contributions\n\n", pageTitle, suspicionThreshold, len(suspiciousProfiles)))
-
-	for i, profile := range suspiciousProfiles {
-		results = append(results, fmt.Sprintf("=== SUSPICIOUS CONTRIBUTION #%d ===\n", i+1))
-
-		output, err := formatter.FormatContributionProfile(profile, contributionOutputFormat)
-		if err != nil {
-			results = append(results, fmt.Sprintf("Error formatting contribution %d: %v\n", profile.RevisionID, err))
-			continue
-		}
-
-		results = append(results, output)
-		results = append(results, "\n"+strings.Repeat("═", 80)+"\n\n")
+	if listFlagged {
+		return emitFlaggedList(formatter.FlaggedContributions(suspiciousProfiles, flaggedThreshold))
 	}
 
-	finalOutput := strings.Join(results, "")
+	// Format results
+	return emitOutput(outputFormats, contributionSaveToFile, "Suspicious contributions report saved to", func(format string) (string, error) {
+		var results []string
+		results = append(results, fmt.Sprintf("🚨 SUSPICIOUS CONTRIBUTIONS REPORT\n"))
+		results = append(results, fmt.Sprintf("Page: %s | Threshold: %d/100 | Found: %d contributions\n\n", pageTitle, suspicionThreshold, len(suspiciousProfiles)))
+
+		for i, profile := range suspiciousProfiles {
+			results = append(results, fmt.Sprintf("=== SUSPICIOUS CONTRIBUTION #%d (score %d/100) ===\n", i+1, profile.SuspicionScore))
+
+			output, err := formatter.FormatContributionProfile(profile, format)
+			if err != nil {
+				results = append(results, fmt.Sprintf("Error formatting contribution %d: %v\n", profile.RevisionID, err))
+				continue
+			}
 
-	// Display or save
-	if contributionSaveToFile != "" {
-		err = os.WriteFile(contributionSaveToFile, []byte(finalOutput), 0644)
-		if err != nil {
-			return fmt.Errorf("error saving file: %w", err)
+			results = append(results, output)
+			results = append(results, "\n"+strings.Repeat("═", 80)+"\n\n")
 		}
-		fmt.Printf("✅ Suspicious contributions report saved to: %s\n", contributionSaveToFile)
-	} else {
-		fmt.Print(finalOutput)
-	}
 
-	return nil
+		return strings.Join(results, ""), nil
+	})
 }
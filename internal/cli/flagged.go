@@ -0,0 +1,44 @@
+// internal/cli/flagged.go
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listFlagged      bool
+	flaggedThreshold int
+
+	// progressOutput is where the commands and the analyzers report their
+	// progress. In --list-flagged mode it is stderr, so only the list reaches a
+	// pipe.
+	progressOutput io.Writer = os.Stdout
+)
+
+// initListFlagged validates the --list-flagged options and sends everything but
+// the list to stderr
+func initListFlagged() {
+	if !listFlagged {
+		return
+	}
+	if flaggedThreshold < 0 || flaggedThreshold > 100 {
+		cobra.CheckErr(fmt.Errorf("flagged threshold must be between 0 and 100"))
+	}
+
+	progressOutput = os.Stderr
+	wikiosint.SetLogOutput(os.Stderr)
+}
+
+// emitFlaggedList writes the flagged entities, one per line and nothing else
+func emitFlaggedList(entities []string) error {
+	if _, err := io.WriteString(os.Stdout, formatter.FormatFlaggedList(entities)); err != nil {
+		return fmt.Errorf("error writing flagged list: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// internal/cli/investigate.go
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
+	"github.com/spf13/cobra"
+)
+
+var (
+	investigateOutputFormat    string
+	investigateLanguage        string
+	investigateSaveToFile      string
+	investigateAnalysisDepth   string
+	investigateMaxRevisions    int
+	investigateMaxContributors int
+	investigateMaxHistory      int
+)
+
+// investigateCmd represents the investigate command
+var investigateCmd = &cobra.Command{
+	Use:   "investigate [revision_id] [page_title]",
+	Short: "Build a unified risk report for a single revision",
+	Long: `Investigate a single revision by combining three analyses:
+- The contribution itself (content, revert, timing)
+- The author's full user profile
+- The target page's conflict state
+
+Signals raised by several analyses are deduplicated and a combined
+risk verdict is computed.
+
+Configuration options:
+  --depth: Contribution analysis depth (basic, standard, deep) - default: standard
+  --max-revisions: Number of page revisions to analyze (default: 100)
+  --max-contributors: Number of page contributors to analyze (default: 20)
+  --max-history: Days of detailed page history to analyze (default: 30)`,
+	Args: cobra.ExactArgs(2),
+	RunE: runInvestigate,
+}
+
+func init() {
+	investigateCmd.Flags().StringVarP(&investigateOutputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
+	investigateCmd.Flags().StringVarP(&investigateLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
+	investigateCmd.Flags().StringVar(&investigateSaveToFile, "save", "", "save result to file")
+	investigateCmd.Flags().StringVar(&investigateAnalysisDepth, "depth", "standard", "contribution analysis depth (basic, standard, deep)")
+	investigateCmd.Flags().IntVar(&investigateMaxRevisions, "max-revisions", 100, "maximum number of page revisions to analyze")
+	investigateCmd.Flags().IntVar(&investigateMaxContributors, "max-contributors", 20, "maximum number of page contributors to analyze")
+	investigateCmd.Flags().IntVar(&investigateMaxHistory, "max-history", 30, "maximum number of days for detailed page history")
+}
+
+func runInvestigate(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(investigateOutputFormat)
+	if err != nil {
+		return err
+	}
+
+	revisionID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid revision ID: %s", args[0])
+	}
+	pageTitle := args[1]
+
+	// Validate analysis depth
+	if investigateAnalysisDepth != "basic" && investigateAnalysisDepth != "standard" && investigateAnalysisDepth != "deep" {
+		return fmt.Errorf("invalid analysis depth: %s (must be: basic, standard, deep)", investigateAnalysisDepth)
+	}
+
+	// Create analysis client
+	analysisClient, err := newAnalysisClient(cmd, investigateLanguage)
+	if err != nil {
+		return err
+	}
+
+	domainClassifier, err := newDomainClassifier()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🕵️  Investigating revision %d on %s\n", revisionID, pageTitle)
+	fmt.Printf("📡 Fetching data from %s.wikipedia.org...\n", investigateLanguage)
+	fmt.Printf("📊 Running contribution, author and page analysis...\n")
+
+	report, err := analysisClient.Investigate(revisionID, pageTitle, wikiosint.InvestigationOptions{
+		AnalysisDepth:         investigateAnalysisDepth,
+		NumberOfPageRevisions: investigateMaxRevisions,
+		NumberOfDaysHistory:   investigateMaxHistory,
+		NumberOfContributors:  investigateMaxContributors,
+		DomainClassifier:      domainClassifier,
+	})
+	if err != nil {
+		return fmt.Errorf("error building investigation report: %w", err)
+	}
+
+	fmt.Printf("✅ Investigation completed! %d signals collected\n", len(report.Signals))
+	if report.RiskScore > 50 {
+		fmt.Printf("⚠️  High combined risk detected: %d/100\n", report.RiskScore)
+	}
+
+	// Format and display results
+	return emitOutput(outputFormats, investigateSaveToFile, "Results saved to", func(format string) (string, error) {
+		return formatter.FormatInvestigationReport(report, format)
+	})
+}
@@ -0,0 +1,179 @@
+// internal/cli/output.go
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/intMeric/wikipedia-analyser/internal/analyzer"
+	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+)
+
+// outputFileExtensions maps each supported output format to the suffix used
+// when several formats are saved at once
+var outputFileExtensions = map[string]string{
+	"table": "txt",
+	"plain": "plain.txt",
+	"json":  "json",
+	"yaml":  "yaml",
+}
+
+// terminalWidth returns the width of the terminal attached to stdout, or 0 when
+// stdout is redirected to a file or a pipe
+func terminalWidth() int {
+	fd := os.Stdout.Fd()
+	if !term.IsTerminal(fd) {
+		return 0
+	}
+
+	width, _, err := term.GetSize(fd)
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// parseOutputFormats splits a comma-separated --output value (e.g. "table,json")
+// into validated formats. The first format is the primary one.
+func parseOutputFormats(value string) ([]string, error) {
+	var formats []string
+	for _, part := range strings.Split(value, ",") {
+		format := strings.ToLower(strings.TrimSpace(part))
+		switch format {
+		case "":
+			continue
+		case "yaml", "yml":
+			format = "yaml"
+		}
+		if _, exists := outputFileExtensions[format]; !exists {
+			return nil, fmt.Errorf("unsupported output format: %s (supported: table, plain, json, yaml)", format)
+		}
+		if !utils.Contains(formats, format) {
+			formats = append(formats, format)
+		}
+	}
+
+	if len(formats) == 0 {
+		formats = []string{"table"}
+	}
+
+	return formats, nil
+}
+
+// emitOutput renders a result in every requested format.
+// With a single format the result is printed, or written to saveTo when set.
+// With several formats the primary one is printed and, when saveTo is set, each
+// format is written to saveTo suffixed with its extension (report.txt, report.json...).
+func emitOutput(formats []string, saveTo string, savedMessage string, render func(format string) (string, error)) error {
+	if len(formats) == 1 {
+		output, err := render(formats[0])
+		if err != nil {
+			return fmt.Errorf("error formatting output: %w", err)
+		}
+
+		if saveTo != "" {
+			if err := os.WriteFile(saveTo, []byte(output), 0644); err != nil {
+				return fmt.Errorf("error saving file: %w", err)
+			}
+			fmt.Fprintf(progressOutput, "✅ %s: %s\n", savedMessage, saveTo)
+		} else {
+			fmt.Print(output)
+		}
+		return nil
+	}
+
+	outputs := make(map[string]string, len(formats))
+	for _, format := range formats {
+		output, err := render(format)
+		if err != nil {
+			return fmt.Errorf("error formatting %s output: %w", format, err)
+		}
+		outputs[format] = output
+	}
+
+	fmt.Print(outputs[formats[0]])
+
+	if saveTo == "" {
+		fmt.Fprintf(progressOutput, "\n💡 Use --save to write the %s output(s) to files\n", strings.Join(formats[1:], ", "))
+		return nil
+	}
+
+	base := strings.TrimSuffix(saveTo, filepath.Ext(saveTo))
+	for _, format := range formats {
+		path := base + "." + outputFileExtensions[format]
+		if err := os.WriteFile(path, []byte(outputs[format]), 0644); err != nil {
+			return fmt.Errorf("error saving file: %w", err)
+		}
+		fmt.Fprintf(progressOutput, "✅ %s (%s): %s\n", savedMessage, format, path)
+	}
+
+	return nil
+}
+
+// dryRun makes the analysis commands print the API requests they would send
+// instead of sending them
+var dryRun bool
+
+// emitCallEstimate prints a dry-run estimate in the requested formats
+func emitCallEstimate(formats []string, estimate models.CallEstimate) error {
+	return emitOutput(formats, "", "", func(format string) (string, error) {
+		return formatter.FormatCallEstimate(&estimate, format)
+	})
+}
+
+// noDataOutcome turns an analysis finding nothing to analyze into a message
+// with a hint to act on, instead of a failure: the entity exists, it is just
+// empty. Other errors are wrapped with context.
+func noDataOutcome(err error, context string, hint string) error {
+	if !errors.Is(err, analyzer.ErrNoData) {
+		return fmt.Errorf("%s: %w", context, err)
+	}
+	fmt.Fprintf(progressOutput, "❌ %v\n", err)
+	fmt.Fprintf(progressOutput, "💡 %s\n", hint)
+	return nil
+}
+
+// exportActivityCalendar writes a user's daily activity to path, as a date,count
+// CSV unless the extension is .ics
+func exportActivityCalendar(path string, profile *models.UserProfile) error {
+	format := "csv"
+	if strings.ToLower(filepath.Ext(path)) == ".ics" {
+		format = "ics"
+	}
+
+	calendar := analyzer.BuildActivityCalendar(profile.RecentContribs)
+	output, err := formatter.FormatActivityCalendar(profile.Username, calendar, format)
+	if err != nil {
+		return fmt.Errorf("error formatting activity calendar: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+		return fmt.Errorf("error saving activity calendar: %w", err)
+	}
+	fmt.Fprintf(progressOutput, "📅 Activity calendar (%d days) saved to: %s\n", len(calendar), path)
+	return nil
+}
+
+// exportEdgeList writes an interaction edge list to path, as CSV unless the
+// extension is .json
+func exportEdgeList(path string, edges []models.InteractionEdge) error {
+	format := "csv"
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		format = "json"
+	}
+
+	output, err := formatter.FormatEdgeList(edges, format)
+	if err != nil {
+		return fmt.Errorf("error formatting edge list: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+		return fmt.Errorf("error saving edge list: %w", err)
+	}
+	fmt.Fprintf(progressOutput, "🕸️  Edge list (%d edges) saved to: %s\n", len(edges), path)
+	return nil
+}
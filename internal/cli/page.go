@@ -3,11 +3,11 @@ package cli
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/intMeric/wikipedia-analyser/internal/analyzer"
-	"github.com/intMeric/wikipedia-analyser/internal/client"
 	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/internal/tui"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +20,19 @@ var (
 	pageMaxContributors  int
 	pageMaxHistory       int
 	pageAnalyzeSources   bool
+	pageWithPageViews    bool
+	pageWithTalk         bool
+	pageWithProtection   bool
+	pageCountSelfReverts bool
+	pageRelativeScoring  bool
+	pageFollowMoves      bool
+	pageMinRevisions     int
+	pageWindows          []int
+	pageConflictWindow   int
+	pageTUI              bool
+	pageExportEdges      string
+	pageCheckProxies     bool
+	pageProxyList        string
 )
 
 // pageCmd represents the page command
@@ -44,7 +57,10 @@ var analyzeCmd = &cobra.Command{
 Configuration options:
   --max-revisions: Number of revisions to analyze (default: 100)
   --max-contributors: Number of contributors to analyze (default: 20)
-  --max-history: Days of detailed history to analyze (default: 30)`,
+  --max-history: Days of detailed history to analyze (default: 30)
+  --relative-scoring: Judge contributor activity against this page's norm
+  --follow-moves: Include history left under former titles of the page
+  --check-proxies: Flag anonymous edits from hosting, VPN and proxy ranges`,
 	Args: cobra.ExactArgs(1),
 	RunE: runPageAnalyze,
 }
@@ -90,176 +106,276 @@ func init() {
 	pageCmd.AddCommand(conflictsCmd)
 
 	// Flags for analyze command
-	analyzeCmd.Flags().StringVarP(&pageOutputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	analyzeCmd.Flags().StringVarP(&pageOutputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
 	analyzeCmd.Flags().StringVarP(&pageLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
 	analyzeCmd.Flags().StringVar(&pageSaveToFile, "save", "", "save result to file")
 	analyzeCmd.Flags().IntVar(&pageAnalyzeDays, "days", 30, "number of days to analyze")
 	analyzeCmd.Flags().IntVar(&pageMaxRevisions, "max-revisions", 100, "maximum number of revisions to analyze")
 	analyzeCmd.Flags().IntVar(&pageMaxContributors, "max-contributors", 20, "maximum number of contributors to analyze")
 	analyzeCmd.Flags().IntVar(&pageMaxHistory, "max-history", 30, "maximum number of days for detailed history")
-	analyzeCmd.Flags().BoolVar(&pageAnalyzeSources, "analyse-sources", false, "analyze page sources and references")
+	analyzeCmd.Flags().BoolVar(&pageCountSelfReverts, "count-self-reverts", false, "count self-reverts as conflicts")
+	analyzeCmd.Flags().BoolVar(&pageAnalyzeSources, "analyse-sources", false, "analyze page sources, references and reference churn")
+	analyzeCmd.Flags().BoolVar(&pageWithPageViews, "with-pageviews", false, "correlate editing bursts with pageview traffic")
+	analyzeCmd.Flags().BoolVar(&pageWithTalk, "with-talk", false, "weigh the controversy score with the talk page discussion")
+	analyzeCmd.Flags().BoolVar(&pageWithProtection, "with-protection", false, "replay the protection log to find editing rushes once protection ended")
+	analyzeCmd.Flags().BoolVar(&pageFollowMoves, "follow-moves", false, "include history left under the page's former titles (page moves)")
+	analyzeCmd.Flags().BoolVar(&pageCheckProxies, "check-proxies", false, "flag anonymous edits from known hosting, VPN and open proxy ranges")
+	analyzeCmd.Flags().StringVar(&pageProxyList, "proxy-list", "", "YAML file of extra proxy ranges (range, kind, provider) for --check-proxies")
+	analyzeCmd.Flags().BoolVar(&pageRelativeScoring, "relative-scoring", false, "score contributor activity relative to the page's median contributor")
+	analyzeCmd.Flags().IntVar(&pageMinRevisions, "min-revisions", 5, "history revisions needed before stability, controversy and diversity are scored")
+	analyzeCmd.Flags().IntSliceVar(&pageWindows, "windows", []int{7, 30, 90}, "edit frequency windows in days, comma-separated")
+	analyzeCmd.Flags().IntVar(&pageConflictWindow, "conflict-window", 7, "days within which a revert counts as a recent conflict")
+	analyzeCmd.Flags().BoolVar(&pageTUI, "tui", false, "browse the profile in an interactive terminal UI")
+	analyzeCmd.Flags().StringVar(&pageExportEdges, "export-edges", "", "export who-reverted-whom as an edge list (.csv or .json) for network analysis tools")
+	analyzeCmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the expected number of API requests without sending any")
+	analyzeCmd.Flags().BoolVar(&listFlagged, "list-flagged", false, "only print the IDs of the revisions by contributors whose suspicion score meets --flagged-threshold, one per line")
+	analyzeCmd.Flags().IntVar(&flaggedThreshold, "flagged-threshold", formatter.DefaultFlaggedThreshold, "suspicion score from which --list-flagged lists an entry (0-100)")
 
 	// Flags for history command
-	historyCmd.Flags().StringVarP(&pageOutputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	historyCmd.Flags().StringVarP(&pageOutputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
 	historyCmd.Flags().StringVarP(&pageLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
 	historyCmd.Flags().StringVar(&pageSaveToFile, "save", "", "save result to file")
 	historyCmd.Flags().IntVar(&pageAnalyzeDays, "days", 30, "number of days to analyze")
 	historyCmd.Flags().IntVar(&pageMaxRevisions, "max-revisions", 100, "maximum number of revisions to analyze")
 	historyCmd.Flags().IntVar(&pageMaxContributors, "max-contributors", 20, "maximum number of contributors to analyze")
 	historyCmd.Flags().IntVar(&pageMaxHistory, "max-history", 30, "maximum number of days for detailed history")
+	historyCmd.Flags().BoolVar(&pageCountSelfReverts, "count-self-reverts", false, "count self-reverts as conflicts")
+	historyCmd.Flags().BoolVar(&pageWithPageViews, "with-pageviews", false, "show pageview traffic alongside the edit timeline")
+	historyCmd.Flags().IntSliceVar(&pageWindows, "windows", []int{7, 30, 90}, "edit frequency windows in days, comma-separated")
 
 	// Flags for conflicts command
-	conflictsCmd.Flags().StringVarP(&pageOutputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	conflictsCmd.Flags().StringVarP(&pageOutputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
 	conflictsCmd.Flags().StringVarP(&pageLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
 	conflictsCmd.Flags().StringVar(&pageSaveToFile, "save", "", "save result to file")
 	conflictsCmd.Flags().IntVar(&pageAnalyzeDays, "days", 30, "number of days to analyze")
 	conflictsCmd.Flags().IntVar(&pageMaxRevisions, "max-revisions", 100, "maximum number of revisions to analyze")
 	conflictsCmd.Flags().IntVar(&pageMaxContributors, "max-contributors", 20, "maximum number of contributors to analyze")
 	conflictsCmd.Flags().IntVar(&pageMaxHistory, "max-history", 30, "maximum number of days for detailed history")
+	conflictsCmd.Flags().BoolVar(&pageCountSelfReverts, "count-self-reverts", false, "count self-reverts as conflicts")
+	conflictsCmd.Flags().IntVar(&pageMinRevisions, "min-revisions", 5, "history revisions needed before stability and controversy are scored")
+	conflictsCmd.Flags().BoolVar(&pageWithTalk, "with-talk", false, "weigh the controversy score with the talk page discussion")
+	conflictsCmd.Flags().BoolVar(&pageWithProtection, "with-protection", false, "replay the protection log to find editing rushes once protection ended")
+	conflictsCmd.Flags().IntVar(&pageConflictWindow, "conflict-window", 7, "days within which a revert counts as a recent conflict")
+}
+
+// checkConflictWindow rejects --conflict-window 0: the analysis options read a
+// zero window as the default one
+func checkConflictWindow() error {
+	if pageConflictWindow == 0 {
+		return fmt.Errorf("--conflict-window must be at least 1 day")
+	}
+	return nil
 }
 
 func runPageAnalyze(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(pageOutputFormat)
+	if err != nil {
+		return err
+	}
+
+	if err := checkConflictWindow(); err != nil {
+		return err
+	}
+
 	pageTitle := args[0]
 
 	// Create Wikipedia client
-	wikiClient := client.NewWikipediaClient(pageLanguage)
+	analysisClient, err := newAnalysisClient(cmd, pageLanguage)
+	if err != nil {
+		return err
+	}
+
+	domainClassifier, err := newDomainClassifier()
+	if err != nil {
+		return err
+	}
+
+	proxyResolver, err := newProxyResolver(pageCheckProxies, pageProxyList)
+	if err != nil {
+		return err
+	}
 
 	// Create page analysis options
-	analysisOptions := analyzer.PageAnalysisOptions{
+	analysisOptions := wikiosint.PageOptions{
 		NumberOfPageRevisions: pageMaxRevisions,
 		NumberOfDaysHistory:   pageMaxHistory,
 		NumberOfContributors:  pageMaxContributors,
+		CountSelfReverts:      pageCountSelfReverts,
+		MinRevisions:          pageMinRevisions,
 		AnalyzeSources:        pageAnalyzeSources,
+		DomainClassifier:      domainClassifier,
+		ProxyResolver:         proxyResolver,
+		AnalyzePageViews:      pageWithPageViews,
+		RelativeScoring:       pageRelativeScoring,
+		FollowMoves:           pageFollowMoves,
+		AnalyzeTalkPage:       pageWithTalk,
+		CheckProtection:       pageWithProtection,
+		FrequencyWindows:      pageWindows,
+		ConflictWindow:        pageConflictWindow,
+	}
+
+	if err := analysisOptions.Validate(); err != nil {
+		return fmt.Errorf("invalid analysis options: %w", err)
 	}
 
-	// Create page analyzer with options
-	pageAnalyzer := analyzer.NewPageAnalyzer(wikiClient, analysisOptions)
+	if dryRun {
+		estimate, err := analysisClient.EstimatePage(analysisOptions)
+		if err != nil {
+			return err
+		}
+		return emitCallEstimate(outputFormats, estimate)
+	}
 
 	// Retrieve page data
-	fmt.Printf("🔍 Analyzing Wikipedia page: %s\n", pageTitle)
-	fmt.Printf("📡 Fetching data from %s.wikipedia.org...\n", pageLanguage)
-	fmt.Printf("📊 Analysis parameters: %d revisions, %d contributors, %d days history\n",
+	fmt.Fprintf(progressOutput, "🔍 Analyzing Wikipedia page: %s\n", pageTitle)
+	fmt.Fprintf(progressOutput, "📡 Fetching data from %s.wikipedia.org...\n", pageLanguage)
+	fmt.Fprintf(progressOutput, "📊 Analysis parameters: %d revisions, %d contributors, %d days history\n",
 		pageMaxRevisions, pageMaxContributors, pageMaxHistory)
-	fmt.Printf("👥 Including detailed contributor analysis...\n")
+	fmt.Fprintf(progressOutput, "👥 Including detailed contributor analysis...\n")
 
-	pageProfile, err := pageAnalyzer.GetPageProfile(pageTitle)
+	pageProfile, err := analysisClient.AnalyzePage(pageTitle, analysisOptions)
 	if err != nil {
-		return fmt.Errorf("error retrieving page profile: %w", err)
+		return noDataOutcome(err, "error retrieving page profile", "Check the page title and --lang")
 	}
 
-	fmt.Printf("✅ Analysis completed! Found %d contributors, %d revisions\n",
+	fmt.Fprintf(progressOutput, "✅ Analysis completed! Found %d contributors, %d revisions\n",
 		len(pageProfile.Contributors), len(pageProfile.RecentRevisions))
 
-	// Format and display results
-	output, err := formatter.FormatPageProfile(pageProfile, pageOutputFormat)
-	if err != nil {
-		return fmt.Errorf("error formatting output: %w", err)
+	if pageExportEdges != "" {
+		if err := exportEdgeList(pageExportEdges, analyzer.BuildPageInteractionEdges(pageProfile)); err != nil {
+			return err
+		}
 	}
 
-	// Display or save
-	if pageSaveToFile != "" {
-		err = os.WriteFile(pageSaveToFile, []byte(output), 0644)
-		if err != nil {
-			return fmt.Errorf("error saving file: %w", err)
+	if listFlagged {
+		return emitFlaggedList(formatter.FlaggedPageRevisions(pageProfile, flaggedThreshold))
+	}
+
+	render := func(format string) (string, error) {
+		return formatter.FormatPageProfile(pageProfile, format)
+	}
+
+	// Browse interactively, still saving the report when requested
+	if pageTUI {
+		if pageSaveToFile != "" {
+			if err := emitOutput(outputFormats, pageSaveToFile, "Results saved to", render); err != nil {
+				return err
+			}
 		}
-		fmt.Printf("✅ Results saved to: %s\n", pageSaveToFile)
-	} else {
-		fmt.Print(output)
+		return tui.Run(tui.NewPageModel(pageProfile))
 	}
 
-	return nil
+	// Format and display results
+	return emitOutput(outputFormats, pageSaveToFile, "Results saved to", render)
 }
 
 func runPageHistory(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(pageOutputFormat)
+	if err != nil {
+		return err
+	}
+
 	pageTitle := args[0]
 
 	// Create Wikipedia client
-	wikiClient := client.NewWikipediaClient(pageLanguage)
+	analysisClient, err := newAnalysisClient(cmd, pageLanguage)
+	if err != nil {
+		return err
+	}
+
+	domainClassifier, err := newDomainClassifier()
+	if err != nil {
+		return err
+	}
 
 	// Create page analysis options
-	analysisOptions := analyzer.PageAnalysisOptions{
+	analysisOptions := wikiosint.PageOptions{
 		NumberOfPageRevisions: pageMaxRevisions,
 		NumberOfDaysHistory:   pageMaxHistory,
 		NumberOfContributors:  pageMaxContributors,
+		CountSelfReverts:      pageCountSelfReverts,
+		AnalyzePageViews:      pageWithPageViews,
+		FrequencyWindows:      pageWindows,
+		DomainClassifier:      domainClassifier,
 	}
 
-	// Create page analyzer with options
-	pageAnalyzer := analyzer.NewPageAnalyzer(wikiClient, analysisOptions)
+	if err := analysisOptions.Validate(); err != nil {
+		return fmt.Errorf("invalid analysis options: %w", err)
+	}
 
 	// Retrieve page data with focus on history
-	fmt.Printf("🔍 Analyzing edit history for: %s\n", pageTitle)
-	fmt.Printf("📡 Fetching revision data from %s.wikipedia.org...\n", pageLanguage)
-	fmt.Printf("📊 Analysis parameters: %d revisions, %d days history\n",
+	fmt.Fprintf(progressOutput, "🔍 Analyzing edit history for: %s\n", pageTitle)
+	fmt.Fprintf(progressOutput, "📡 Fetching revision data from %s.wikipedia.org...\n", pageLanguage)
+	fmt.Fprintf(progressOutput, "📊 Analysis parameters: %d revisions, %d days history\n",
 		pageMaxRevisions, pageMaxHistory)
 
-	pageProfile, err := pageAnalyzer.GetPageProfile(pageTitle)
+	pageProfile, err := analysisClient.AnalyzePage(pageTitle, analysisOptions)
 	if err != nil {
-		return fmt.Errorf("error retrieving page profile: %w", err)
+		return noDataOutcome(err, "error retrieving page profile", "Check the page title and --lang")
 	}
 
 	// Format with focus on history (could be a separate formatter method)
-	output, err := formatter.FormatPageHistory(pageProfile, pageOutputFormat)
+	return emitOutput(outputFormats, pageSaveToFile, "Results saved to", func(format string) (string, error) {
+		return formatter.FormatPageHistory(pageProfile, format)
+	})
+}
+
+func runPageConflicts(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(pageOutputFormat)
 	if err != nil {
-		return fmt.Errorf("error formatting output: %w", err)
+		return err
 	}
 
-	// Display or save
-	if pageSaveToFile != "" {
-		err = os.WriteFile(pageSaveToFile, []byte(output), 0644)
-		if err != nil {
-			return fmt.Errorf("error saving file: %w", err)
-		}
-		fmt.Printf("✅ Results saved to: %s\n", pageSaveToFile)
-	} else {
-		fmt.Print(output)
+	if err := checkConflictWindow(); err != nil {
+		return err
 	}
 
-	return nil
-}
-
-func runPageConflicts(cmd *cobra.Command, args []string) error {
 	pageTitle := args[0]
 
 	// Create Wikipedia client
-	wikiClient := client.NewWikipediaClient(pageLanguage)
+	analysisClient, err := newAnalysisClient(cmd, pageLanguage)
+	if err != nil {
+		return err
+	}
+
+	domainClassifier, err := newDomainClassifier()
+	if err != nil {
+		return err
+	}
 
 	// Create page analysis options
-	analysisOptions := analyzer.PageAnalysisOptions{
+	analysisOptions := wikiosint.PageOptions{
 		NumberOfPageRevisions: pageMaxRevisions,
 		NumberOfDaysHistory:   pageMaxHistory,
 		NumberOfContributors:  pageMaxContributors,
+		CountSelfReverts:      pageCountSelfReverts,
+		MinRevisions:          pageMinRevisions,
+		AnalyzeTalkPage:       pageWithTalk,
+		CheckProtection:       pageWithProtection,
+		ConflictWindow:        pageConflictWindow,
+		DomainClassifier:      domainClassifier,
 	}
 
-	// Create page analyzer with options
-	pageAnalyzer := analyzer.NewPageAnalyzer(wikiClient, analysisOptions)
+	if err := analysisOptions.Validate(); err != nil {
+		return fmt.Errorf("invalid analysis options: %w", err)
+	}
 
 	// Retrieve page data with focus on conflicts
-	fmt.Printf("🔍 Analyzing conflicts for: %s\n", pageTitle)
-	fmt.Printf("📡 Detecting edit wars on %s.wikipedia.org...\n", pageLanguage)
-	fmt.Printf("📊 Analysis parameters: %d revisions, %d days for conflict detection\n",
+	fmt.Fprintf(progressOutput, "🔍 Analyzing conflicts for: %s\n", pageTitle)
+	fmt.Fprintf(progressOutput, "📡 Detecting edit wars on %s.wikipedia.org...\n", pageLanguage)
+	fmt.Fprintf(progressOutput, "📊 Analysis parameters: %d revisions, %d days for conflict detection\n",
 		pageMaxRevisions, pageMaxHistory)
 
-	pageProfile, err := pageAnalyzer.GetPageProfile(pageTitle)
+	pageProfile, err := analysisClient.AnalyzePage(pageTitle, analysisOptions)
 	if err != nil {
-		return fmt.Errorf("error retrieving page profile: %w", err)
+		return noDataOutcome(err, "error retrieving page profile", "Check the page title and --lang")
 	}
 
 	// Format with focus on conflicts (could be a separate formatter method)
-	output, err := formatter.FormatPageConflicts(pageProfile, pageOutputFormat)
-	if err != nil {
-		return fmt.Errorf("error formatting output: %w", err)
-	}
-
-	// Display or save
-	if pageSaveToFile != "" {
-		err = os.WriteFile(pageSaveToFile, []byte(output), 0644)
-		if err != nil {
-			return fmt.Errorf("error saving file: %w", err)
-		}
-		fmt.Printf("✅ Results saved to: %s\n", pageSaveToFile)
-	} else {
-		fmt.Print(output)
-	}
-
-	return nil
+	return emitOutput(outputFormats, pageSaveToFile, "Results saved to", func(format string) (string, error) {
+		return formatter.FormatPageConflicts(pageProfile, format)
+	})
 }
@@ -4,12 +4,15 @@ package cli
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/intMeric/wikipedia-analyser/internal/analyzer"
-	"github.com/intMeric/wikipedia-analyser/internal/client"
 	"github.com/intMeric/wikipedia-analyser/internal/formatter"
 	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/tui"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
 	"github.com/spf13/cobra"
 )
 
@@ -18,12 +21,19 @@ var (
 	pagesOutputFormat           string
 	pagesLanguage               string
 	pagesSaveToFile             string
+	pagesExportEvidence         string
+	pagesExportEdges            string
+	pagesTUI                    bool
+	pagesProgress               bool
+	pagesCacheDir               string
+	pagesCacheTTL               time.Duration
 	pagesMaxRevisions           int
 	pagesMaxContributors        int
 	pagesMaxHistory             int
 	crossPageMinCommonEdits     int
 	crossPageMaxReactionTime    int
 	crossPageMinSupportRatio    float64
+	crossPageMinFootprint       float64
 	crossPageEnableDeepAnalysis bool
 )
 
@@ -37,6 +47,7 @@ var pagesCmd = &cobra.Command{
 - Sockpuppet networks
 - Temporal synchronization
 - Tag-team editing strategies
+- Clusters of accounts editing the same set of pages
 
 This command helps identify sophisticated manipulation campaigns
 that operate across multiple pages simultaneously.
@@ -48,6 +59,7 @@ Configuration options:
   --min-common-edits: Minimum edits to be considered common contributor (default: 3)
   --max-reaction-time: Maximum minutes for suspicious reaction time (default: 60)
   --min-support-ratio: Minimum ratio for mutual support detection (default: 0.3)
+  --min-footprint-similarity: Minimum page-set overlap to cluster accounts (default: 0.8)
   --enable-deep-analysis: Enable resource-intensive analysis (default: false)
 
 Examples:
@@ -60,76 +72,145 @@ Examples:
 
 func init() {
 	// Flags for cross-page analysis
-	pagesCmd.Flags().StringVarP(&pagesOutputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	pagesCmd.Flags().StringVarP(&pagesOutputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
 	pagesCmd.Flags().StringVarP(&pagesLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
 	pagesCmd.Flags().StringVar(&pagesSaveToFile, "save", "", "save result to file")
+	pagesCmd.Flags().BoolVar(&pagesTUI, "tui", false, "browse the analysis in an interactive terminal UI")
+	pagesCmd.Flags().BoolVar(&pagesProgress, "progress", false, "print a summary line to stderr as each page completes")
+	pagesCmd.Flags().StringVar(&pagesCacheDir, "cache-dir", "", "cache page profiles in this directory and reuse them on later runs")
+	pagesCmd.Flags().DurationVar(&pagesCacheTTL, "cache-ttl", 24*time.Hour, "age after which cached page profiles are fetched again")
+	pagesCmd.Flags().StringVar(&pagesExportEvidence, "export-evidence", "", "export detected patterns with diff links to a file (.json or .yaml)")
+	pagesCmd.Flags().StringVar(&pagesExportEdges, "export-edges", "", "export user interactions (reverts, defenses) as an edge list (.csv or .json)")
 	pagesCmd.Flags().IntVar(&pagesMaxRevisions, "max-revisions", 200, "maximum number of revisions per page")
 	pagesCmd.Flags().IntVar(&pagesMaxContributors, "max-contributors", 50, "maximum number of contributors per page")
 	pagesCmd.Flags().IntVar(&pagesMaxHistory, "max-history", 90, "maximum number of days for detailed history")
 	pagesCmd.Flags().IntVar(&crossPageMinCommonEdits, "min-common-edits", 3, "minimum edits to be considered common contributor")
 	pagesCmd.Flags().IntVar(&crossPageMaxReactionTime, "max-reaction-time", 60, "maximum minutes for suspicious reaction time")
 	pagesCmd.Flags().Float64Var(&crossPageMinSupportRatio, "min-support-ratio", 0.3, "minimum ratio for mutual support detection")
+	pagesCmd.Flags().Float64Var(&crossPageMinFootprint, "min-footprint-similarity", 0.8, "minimum Jaccard similarity of edited page sets to cluster two accounts")
 	pagesCmd.Flags().BoolVar(&crossPageEnableDeepAnalysis, "enable-deep-analysis", false, "enable resource-intensive analysis")
+	pagesCmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the expected number of API requests without sending any")
+	pagesCmd.Flags().BoolVar(&listFlagged, "list-flagged", false, "only print the common contributors whose suspicion score meets --flagged-threshold, one per line")
+	pagesCmd.Flags().IntVar(&flaggedThreshold, "flagged-threshold", formatter.DefaultFlaggedThreshold, "suspicion score from which --list-flagged lists an entry (0-100)")
 }
 
 func runCrossPageAnalysis(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(pagesOutputFormat)
+	if err != nil {
+		return err
+	}
+
 	pageNames := args
 
+	// The analysis options read a zero reaction time as the default one
+	if crossPageMaxReactionTime == 0 {
+		return fmt.Errorf("--max-reaction-time must be at least 1 minute")
+	}
+
 	// Create Wikipedia client
-	wikiClient := client.NewWikipediaClient(pagesLanguage)
+	analysisClient, err := newAnalysisClient(cmd, pagesLanguage)
+	if err != nil {
+		return err
+	}
 
-	// Create cross-page analysis options
-	analysisOptions := models.CrossPageAnalysisOptions{
-		MaxRevisionsPerPage:    pagesMaxRevisions,
-		MaxContributorsPerPage: pagesMaxContributors,
-		HistoryDays:            pagesMaxHistory,
-		MinCommonEdits:         crossPageMinCommonEdits,
-		MaxReactionTime:        crossPageMaxReactionTime,
-		MinMutualSupportRatio:  crossPageMinSupportRatio,
-		EnableDeepAnalysis:     crossPageEnableDeepAnalysis,
+	domainClassifier, err := newDomainClassifier()
+	if err != nil {
+		return err
 	}
 
-	// Create cross-page analyzer
-	crossPageAnalyzer := analyzer.NewCrossPageAnalyzer(wikiClient, analysisOptions)
+	// Create cross-page analysis options
+	analysisOptions := wikiosint.PagesOptions{
+		CrossPageOptions: wikiosint.CrossPageOptions{
+			MaxRevisionsPerPage:    pagesMaxRevisions,
+			MaxContributorsPerPage: pagesMaxContributors,
+			HistoryDays:            pagesMaxHistory,
+			MinCommonEdits:         crossPageMinCommonEdits,
+			MaxReactionTime:        crossPageMaxReactionTime,
+			MinMutualSupportRatio:  crossPageMinSupportRatio,
+			MinFootprintSimilarity: crossPageMinFootprint,
+			EnableDeepAnalysis:     crossPageEnableDeepAnalysis,
+		},
+		DomainClassifier: domainClassifier,
+		CacheDir:         pagesCacheDir,
+		CacheTTL:         pagesCacheTTL,
+	}
+	if pagesProgress {
+		analysisOptions.OnPageAnalyzed = func(index, total int, profile *models.PageProfile) {
+			fmt.Fprint(os.Stderr, formatter.FormatPageProgress(index, total, profile))
+		}
+	}
+	if dryRun {
+		estimate, err := analysisClient.EstimatePages(pageNames, analysisOptions)
+		if err != nil {
+			return err
+		}
+		return emitCallEstimate(outputFormats, estimate)
+	}
 
 	// Start analysis
-	fmt.Printf("🔍 Starting cross-page coordination analysis\n")
-	fmt.Printf("📄 Pages to analyze: %s\n", strings.Join(pageNames, ", "))
-	fmt.Printf("🌍 Wikipedia language: %s\n", pagesLanguage)
-	fmt.Printf("📊 Analysis parameters:\n")
-	fmt.Printf("   - Max revisions per page: %d\n", pagesMaxRevisions)
-	fmt.Printf("   - Max contributors per page: %d\n", pagesMaxContributors)
-	fmt.Printf("   - History depth: %d days\n", pagesMaxHistory)
-	fmt.Printf("   - Min common edits: %d\n", crossPageMinCommonEdits)
-	fmt.Printf("   - Max reaction time: %d minutes\n", crossPageMaxReactionTime)
-	fmt.Printf("   - Min support ratio: %.2f\n", crossPageMinSupportRatio)
+	fmt.Fprintf(progressOutput, "🔍 Starting cross-page coordination analysis\n")
+	fmt.Fprintf(progressOutput, "📄 Pages to analyze: %s\n", strings.Join(pageNames, ", "))
+	fmt.Fprintf(progressOutput, "🌍 Wikipedia language: %s\n", pagesLanguage)
+	fmt.Fprintf(progressOutput, "📊 Analysis parameters:\n")
+	fmt.Fprintf(progressOutput, "   - Max revisions per page: %d\n", pagesMaxRevisions)
+	fmt.Fprintf(progressOutput, "   - Max contributors per page: %d\n", pagesMaxContributors)
+	fmt.Fprintf(progressOutput, "   - History depth: %d days\n", pagesMaxHistory)
+	fmt.Fprintf(progressOutput, "   - Min common edits: %d\n", crossPageMinCommonEdits)
+	fmt.Fprintf(progressOutput, "   - Max reaction time: %d minutes\n", crossPageMaxReactionTime)
+	fmt.Fprintf(progressOutput, "   - Min support ratio: %.2f\n", crossPageMinSupportRatio)
 	if crossPageEnableDeepAnalysis {
-		fmt.Printf("   - Deep analysis: enabled\n")
+		fmt.Fprintf(progressOutput, "   - Deep analysis: enabled\n")
 	}
-	fmt.Println()
+	fmt.Fprintln(progressOutput)
 
 	// Perform analysis
-	analysis, err := crossPageAnalyzer.AnalyzePages(pageNames)
+	analysis, err := analysisClient.AnalyzePages(pageNames, analysisOptions)
 	if err != nil {
-		return fmt.Errorf("error performing cross-page analysis: %w", err)
+		return noDataOutcome(err, "error performing cross-page analysis", "Check the page titles and --lang: the warnings above name the pages that failed")
 	}
 
-	// Format and display results
-	output, err := formatter.FormatCrossPageAnalysis(analysis, pagesOutputFormat)
-	if err != nil {
-		return fmt.Errorf("error formatting output: %w", err)
-	}
+	// Export citeable evidence if requested
+	if pagesExportEvidence != "" {
+		evidenceFormat := "json"
+		if ext := strings.ToLower(filepath.Ext(pagesExportEvidence)); ext == ".yaml" || ext == ".yml" {
+			evidenceFormat = "yaml"
+		}
 
-	// Display or save
-	if pagesSaveToFile != "" {
-		err = os.WriteFile(pagesSaveToFile, []byte(output), 0644)
+		evidence, err := formatter.FormatEvidenceExport(analyzer.BuildEvidenceExport(analysis), evidenceFormat)
 		if err != nil {
-			return fmt.Errorf("error saving file: %w", err)
+			return fmt.Errorf("error formatting evidence: %w", err)
+		}
+		if err := os.WriteFile(pagesExportEvidence, []byte(evidence), 0644); err != nil {
+			return fmt.Errorf("error saving evidence file: %w", err)
+		}
+		fmt.Fprintf(progressOutput, "📎 Evidence export saved to: %s\n", pagesExportEvidence)
+	}
+
+	if pagesExportEdges != "" {
+		if err := exportEdgeList(pagesExportEdges, analyzer.BuildCrossPageInteractionEdges(analysis)); err != nil {
+			return err
 		}
-		fmt.Printf("✅ Cross-page analysis results saved to: %s\n", pagesSaveToFile)
-	} else {
-		fmt.Print(output)
 	}
 
-	return nil
+	if listFlagged {
+		return emitFlaggedList(formatter.FlaggedCommonContributors(analysis, flaggedThreshold))
+	}
+
+	render := func(format string) (string, error) {
+		return formatter.FormatCrossPageAnalysis(analysis, format)
+	}
+
+	// Browse interactively, still saving the report when requested
+	if pagesTUI {
+		if pagesSaveToFile != "" {
+			if err := emitOutput(outputFormats, pagesSaveToFile, "Cross-page analysis results saved to", render); err != nil {
+				return err
+			}
+		}
+		return tui.Run(tui.NewCrossPageModel(analysis))
+	}
+
+	// Format and display results
+	return emitOutput(outputFormats, pagesSaveToFile, "Cross-page analysis results saved to", render)
 }
@@ -2,18 +2,59 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
 
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/internal/proxies"
+	"github.com/intMeric/wikipedia-analyser/internal/sources"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	cfgFile string
-	verbose bool
+	cfgFile       string
+	verbose       bool
+	dumpRawDir    string
+	tableWidth    int
+	explainScores bool
+	mergeFlags    bool
+	sessionCookie string
+	loginUsername string
+	loginPassword string
+	maxLag        int
+	maxAPICalls   int
+	uiLang        string
+	timeDisplay   string
+	outputFields  string
 )
 
+// budgetedClient is a client whose API calls count against --max-api-calls
+type budgetedClient interface {
+	APICalls() int
+	BudgetExhausted() bool
+}
+
+// commandClients lists the clients created by a command run, whose call budgets
+// are checked once it completes. It travels in the command's context.
+type commandClients struct {
+	clients []budgetedClient
+}
+
+// commandClientsKey is the context key of a run's commandClients
+type commandClientsKey struct{}
+
+// registerClient records a client created by the command, when run through Execute
+func registerClient(cmd *cobra.Command, wikiClient budgetedClient) {
+	if run, ok := cmd.Context().Value(commandClientsKey{}).(*commandClients); ok {
+		run.clients = append(run.clients, wikiClient)
+	}
+}
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "wikiosint",
@@ -26,9 +67,12 @@ Usage examples:
   wikiosint user profile username
   wikiosint user activity username --days 30
   wikiosint page analyze "Page Title"
+  wikiosint page watch "Page Title" --feed watch.xml
   wikiosint pages "Page 1" "Page 2" "Page 3"
   wikiosint contribution analyze 123456789
-  wikiosint contribution recent "Page Title"`,
+  wikiosint contribution recent "Page Title"
+  wikiosint investigate 123456789 "Page Title"
+  wikiosint batch users accounts.txt --resume`,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	// Run: func(cmd *cobra.Command, args []string) { },
@@ -36,16 +80,33 @@ Usage examples:
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
+// Call budgets are checked whether the command succeeded or failed: a command
+// failing for lack of budget needs the warning most.
 func Execute() error {
-	return rootCmd.Execute()
+	run := &commandClients{}
+	err := rootCmd.ExecuteContext(context.WithValue(context.Background(), commandClientsKey{}, run))
+	warnBudgetExhausted(run)
+	return err
 }
 
 func init() {
-	cobra.OnInitialize(initConfig)
+	cobra.OnInitialize(initConfig, initFormatter, initListFlagged)
 
 	// Define persistent flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.wikiosint.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
+	rootCmd.PersistentFlags().StringVar(&dumpRawDir, "dump-raw", "", "write each raw API response to a timestamped file in this directory (debugging)")
+	rootCmd.PersistentFlags().StringVar(&loginUsername, "username", "", "bot password username (Account@BotName) to log in with, or $WIKIOSINT_USERNAME")
+	rootCmd.PersistentFlags().StringVar(&loginPassword, "password", "", "bot password to log in with, or $WIKIOSINT_PASSWORD")
+	rootCmd.PersistentFlags().StringVar(&sessionCookie, "session-cookie", "", "session cookie of a logged-in account, sent with every API request")
+	rootCmd.PersistentFlags().BoolVar(&explainScores, "explain", false, "itemize the scoring rules behind each suspicion score in table output")
+	rootCmd.PersistentFlags().BoolVar(&mergeFlags, "merge-flags", false, "show identical suspicion indicators once with their count in table output")
+	rootCmd.PersistentFlags().IntVar(&maxLag, "maxlag", client.DefaultMaxLag, "seconds of server replication lag above which requests wait and retry (0 to disable)")
+	rootCmd.PersistentFlags().IntVar(&maxAPICalls, "max-api-calls", 0, "stop sending API requests after this many and report partial results (0 for unlimited)")
+	rootCmd.PersistentFlags().StringVar(&uiLang, "ui-lang", "en", "language of table report labels (en, fr, es, de)")
+	rootCmd.PersistentFlags().StringVar(&timeDisplay, "time-display", formatter.TimeDisplayAuto, "timestamps in table output: absolute, relative, both, or auto (each report's choice)")
+	rootCmd.PersistentFlags().StringVar(&outputFields, "fields", "", "trim JSON and YAML output to these comma-separated dot-paths (e.g. suspicion_score,top_contributors.username)")
+	rootCmd.PersistentFlags().IntVar(&tableWidth, "width", 0, "width of table output in columns (default: terminal width, 100 when not a terminal)")
 
 	// Bind flags to viper
 	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
@@ -55,6 +116,8 @@ func init() {
 	rootCmd.AddCommand(pageCmd)
 	rootCmd.AddCommand(pagesCmd)
 	rootCmd.AddCommand(contributionCmd)
+	rootCmd.AddCommand(investigateCmd)
+	rootCmd.AddCommand(batchCmd)
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -80,3 +143,84 @@ func initConfig() {
 		fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
 	}
 }
+
+// initFormatter applies the global output flags: table width (--width, or the
+// terminal width when stdout is a terminal), score explanations, UI language,
+// timestamp style and JSON/YAML field selection
+func initFormatter() {
+	width := tableWidth
+	if width <= 0 {
+		width = terminalWidth()
+	}
+	formatter.SetTableWidth(width)
+	formatter.SetExplainScores(explainScores)
+	formatter.SetMergeFlags(mergeFlags)
+	cobra.CheckErr(formatter.SetUILanguage(uiLang))
+	cobra.CheckErr(formatter.SetTimeDisplay(timeDisplay))
+	cobra.CheckErr(formatter.SetFields(outputFields))
+}
+
+// clientConfig gathers the client settings of the global flags
+func clientConfig(language string) client.Config {
+	return client.Config{
+		Language:      language,
+		MaxLag:        maxLag,
+		DisableMaxLag: maxLag == 0,
+		MaxAPICalls:   maxAPICalls,
+		SessionCookie: sessionCookie,
+		Username:      utils.SetOrDefault(loginUsername, os.Getenv("WIKIOSINT_USERNAME")),
+		Password:      utils.SetOrDefault(loginPassword, os.Getenv("WIKIOSINT_PASSWORD")),
+		RawDumpDir:    dumpRawDir,
+		DryRun:        dryRun,
+	}
+}
+
+// newAnalysisClient creates a library client configured from the global flags
+func newAnalysisClient(cmd *cobra.Command, language string) (*wikiosint.Client, error) {
+	analysisClient, err := wikiosint.NewClient(clientConfig(language))
+	if err != nil {
+		return nil, err
+	}
+	registerClient(cmd, analysisClient)
+	return analysisClient, nil
+}
+
+// warnBudgetExhausted tells the user when --max-api-calls cut the analysis short
+func warnBudgetExhausted(run *commandClients) {
+	for _, wikiClient := range run.clients {
+		if wikiClient.BudgetExhausted() {
+			fmt.Fprintf(os.Stderr, "⚠️  API call budget exhausted after %d calls (--max-api-calls): results are partial\n", wikiClient.APICalls())
+		}
+	}
+}
+
+// newDomainClassifier creates the source reliability classifier, applying the
+// source_reliability overrides (domain: level) of the config file
+func newDomainClassifier() (*sources.DomainClassifier, error) {
+	classifier := sources.NewDomainClassifier()
+	for domain, level := range viper.GetStringMapString("source_reliability") {
+		if err := classifier.Override(domain, level); err != nil {
+			return nil, fmt.Errorf("invalid source_reliability config: %w", err)
+		}
+	}
+	return classifier, nil
+}
+
+// newProxyResolver creates the proxy range resolver when the check is requested,
+// extended with the ranges of listFile
+func newProxyResolver(check bool, listFile string) (proxies.Resolver, error) {
+	if !check {
+		if listFile != "" {
+			return nil, fmt.Errorf("--proxy-list requires --check-proxies")
+		}
+		return nil, nil
+	}
+
+	ranges := proxies.NewRangeList()
+	if listFile != "" {
+		if err := ranges.LoadFile(listFile); err != nil {
+			return nil, err
+		}
+	}
+	return ranges, nil
+}
@@ -3,11 +3,10 @@ package cli
 
 import (
 	"fmt"
-	"os"
 
-	"github.com/intMeric/wikipedia-analyser/internal/analyzer"
-	"github.com/intMeric/wikipedia-analyser/internal/client"
 	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/internal/tui"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
 	"github.com/spf13/cobra"
 )
 
@@ -15,13 +14,22 @@ var (
 	outputFormat string
 	language     string
 	saveToFile   string
+	userTUI      bool
 
 	// Revoked contributions analysis options
 	maxPagesToAnalyze   int
 	maxRevisionsPerPage int
 	enableDeepAnalysis  bool
+	verifyRevertContent bool
 	recentDaysOnly      int
 	skipRevokedAnalysis bool
+
+	includeDeletedContribs bool
+	scanTopPages           bool
+	exportCalendar         string
+	userNamespaces         []int
+
+	footprintMaxPages int
 )
 
 // userCmd represents the user command
@@ -52,105 +60,326 @@ Configuration options:
   --max-pages-analyze: Maximum number of pages to analyze for reverts (default: 10)
   --max-revisions-page: Maximum revisions per page to check (default: 50)
   --enable-deep-analysis: Enable thorough analysis (slower but more accurate)
+  --verify-revert-content: With deep analysis, only count reverts restoring earlier content
   --recent-days-only: Only analyze contributions from last N days (default: 90)
-  --skip-revoked-analysis: Skip revoked contributions analysis entirely
+  --skip-revoked, --skip-revoked-analysis: Skip revoked contributions analysis entirely
+  --scan-top-pages: Scan the most edited pages for conflicts (one request per page)
+  --export-calendar: Export the daily activity (.csv heatmap data or .ics busy periods)
 
 Examples:
   wikiosint user profile "Username"
   wikiosint user profile "Username" --enable-deep-analysis --max-pages-analyze 20
-  wikiosint user profile "Username" --recent-days-only 30 --output json`,
+  wikiosint user profile "Username" --recent-days-only 30 --output json
+  wikiosint user profile "Username" --skip-revoked`,
 	Args: cobra.ExactArgs(1),
 	RunE: runUserProfile,
 }
 
+// adversariesCmd represents the user adversaries command
+var adversariesCmd = &cobra.Command{
+	Use:   "adversaries [username]",
+	Short: "Rank the users who revert a user most",
+	Long: `Runs the revoked contributions analysis of a user and ranks the users
+who revert them, with:
+- The number of the user's edits each one reverted, and their share
+- The pages where the reverts happened
+- Whether the relationship is reciprocal: the user reverts them back
+
+A reciprocal relationship points at a feud; a one-sided one with a single
+dominant reverter often at a patroller following a problematic account.
+
+Examples:
+  wikiosint user adversaries "Username"
+  wikiosint user adversaries "Username" --enable-deep-analysis --max-pages-analyze 20
+  wikiosint user adversaries "Username" --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUserAdversaries,
+}
+
+// footprintCmd represents the user footprint command
+var footprintCmd = &cobra.Command{
+	Use:   "footprint [username]",
+	Short: "Show a user's role on the pages they edit most",
+	Long: `Profiles a user, then runs the page analysis on the pages they edit most
+and shows, for each page, the user's role there:
+- Their edits and share of the page's analyzed revisions, and their rank
+  among its top contributors
+- The reverts they made of others' edits, and those others made of theirs
+- Their part in the page's conflicts: conflicting user, edit war participant,
+  or the editor reverting most others' changes
+
+Configuration options:
+  --max-pages: Number of most edited pages to analyze (default: 5)
+  --max-revisions: Maximum revisions to analyze per page (default: 100)
+  --max-contributors: Maximum contributors to analyze per page (default: 20)
+  --max-history: Maximum days of detailed history per page (default: 30)
+
+Examples:
+  wikiosint user footprint "Username"
+  wikiosint user footprint "Username" --max-pages 10 --lang fr
+  wikiosint user footprint "Username" --output json --save footprint.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUserFootprint,
+}
+
 func init() {
 	// Add subcommands
 	userCmd.AddCommand(profileCmd)
+	userCmd.AddCommand(adversariesCmd)
+	userCmd.AddCommand(footprintCmd)
 
 	// Flags for profile command
-	profileCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, json, yaml)")
+	profileCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
 	profileCmd.Flags().StringVarP(&language, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
 	profileCmd.Flags().StringVar(&saveToFile, "save", "", "save result to file")
+	profileCmd.Flags().BoolVar(&userTUI, "tui", false, "browse the profile in an interactive terminal UI")
 
 	// Revoked contributions analysis flags
 	profileCmd.Flags().IntVar(&maxPagesToAnalyze, "max-pages-analyze", 10, "Maximum number of pages to analyze for revoked contributions.")
 	profileCmd.Flags().IntVar(&maxRevisionsPerPage, "max-revisions-page", 50, "Maximum number of revisions to check per page for revoked contributions.")
 	profileCmd.Flags().BoolVar(&enableDeepAnalysis, "enable-deep-analysis", false, "Enable thorough analysis for revoked contributions (slower but more accurate).")
+	profileCmd.Flags().BoolVar(&verifyRevertContent, "verify-revert-content", false, "With deep analysis, only count reverts whose content matches a version from before the reverted edit.")
 	profileCmd.Flags().IntVar(&recentDaysOnly, "recent-days-only", 90, "Only analyze revoked contributions from the last N days.")
 	profileCmd.Flags().BoolVar(&skipRevokedAnalysis, "skip-revoked-analysis", false, "Skip the entire revoked contributions analysis.")
+	profileCmd.Flags().BoolVar(&skipRevokedAnalysis, "skip-revoked", false, "Skip the revoked contributions analysis for a fast profile (same as --skip-revoked-analysis).")
+	profileCmd.Flags().BoolVar(&includeDeletedContribs, "include-deleted", false, "Include deleted contributions (requires an administrator session, see --session-cookie).")
+	profileCmd.Flags().BoolVar(&scanTopPages, "scan-top-pages", false, "Scan the most edited pages for conflicts, showing whether the activity concentrates on disputed pages (one request per page).")
+	profileCmd.Flags().IntSliceVar(&userNamespaces, "namespace", nil, "only analyze contributions in these namespace IDs, comma-separated (e.g. 0 for articles, 1 for talk pages)")
+	profileCmd.Flags().StringVar(&exportCalendar, "export-calendar", "", "export the daily activity as a date,count heatmap CSV, or as iCalendar busy periods with an .ics extension")
+	profileCmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the expected number of API requests without sending any")
+	profileCmd.Flags().BoolVar(&listFlagged, "list-flagged", false, "only print the username, if the user's suspicion score meets --flagged-threshold")
+	profileCmd.Flags().IntVar(&flaggedThreshold, "flagged-threshold", formatter.DefaultFlaggedThreshold, "suspicion score from which --list-flagged lists an entry (0-100)")
+
+	// Flags for adversaries command
+	adversariesCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
+	adversariesCmd.Flags().StringVarP(&language, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
+	adversariesCmd.Flags().StringVar(&saveToFile, "save", "", "save result to file")
+	adversariesCmd.Flags().IntVar(&maxPagesToAnalyze, "max-pages-analyze", 10, "Maximum number of pages to analyze for revoked contributions.")
+	adversariesCmd.Flags().IntVar(&maxRevisionsPerPage, "max-revisions-page", 50, "Maximum number of revisions to check per page for revoked contributions.")
+	adversariesCmd.Flags().BoolVar(&enableDeepAnalysis, "enable-deep-analysis", false, "Enable thorough analysis for revoked contributions (slower but more accurate).")
+	adversariesCmd.Flags().BoolVar(&verifyRevertContent, "verify-revert-content", false, "With deep analysis, only count reverts whose content matches a version from before the reverted edit.")
+	adversariesCmd.Flags().IntVar(&recentDaysOnly, "recent-days-only", 90, "Only analyze revoked contributions from the last N days.")
+	adversariesCmd.Flags().IntSliceVar(&userNamespaces, "namespace", nil, "only analyze contributions in these namespace IDs, comma-separated (e.g. 0 for articles, 1 for talk pages)")
+
+	// Flags for footprint command
+	footprintCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, plain, json, yaml), comma-separated for several (e.g. table,json)")
+	footprintCmd.Flags().StringVarP(&language, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
+	footprintCmd.Flags().StringVar(&saveToFile, "save", "", "save result to file")
+	footprintCmd.Flags().IntVar(&footprintMaxPages, "max-pages", 5, "number of the user's most edited pages to analyze")
+	footprintCmd.Flags().IntVar(&pageMaxRevisions, "max-revisions", 100, "maximum number of revisions to analyze per page")
+	footprintCmd.Flags().IntVar(&pageMaxContributors, "max-contributors", 20, "maximum number of contributors to analyze per page")
+	footprintCmd.Flags().IntVar(&pageMaxHistory, "max-history", 30, "maximum number of days for detailed history per page")
 }
 
 func runUserProfile(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(outputFormat)
+	if err != nil {
+		return err
+	}
+	if err := validateNamespaces(userNamespaces); err != nil {
+		return err
+	}
+
 	username := args[0]
 
 	// Create Wikipedia client
-	wikiClient := client.NewWikipediaClient(language)
+	analysisClient, err := newAnalysisClient(cmd, language)
+	if err != nil {
+		return err
+	}
 
-	// Create user analyzer
-	userAnalyzer := analyzer.NewUserAnalyzer(wikiClient)
+	// Create user analysis options from CLI flags
+	userOptions := wikiosint.UserOptions{
+		IncludeDeleted: includeDeletedContribs,
+		ScanTopPages:   scanTopPages,
+		Namespaces:     userNamespaces,
+		SkipRevoked:    skipRevokedAnalysis,
+		Revoked: &wikiosint.RevokedOptions{
+			MaxPagesToAnalyze:   maxPagesToAnalyze,
+			MaxRevisionsPerPage: maxRevisionsPerPage,
+			EnableDeepAnalysis:  enableDeepAnalysis,
+			RecentDaysOnly:      recentDaysOnly,
+			VerifyContent:       verifyRevertContent,
+		},
+	}
+
+	if dryRun {
+		estimate, err := analysisClient.EstimateUser(userOptions)
+		if err != nil {
+			return err
+		}
+		return emitCallEstimate(outputFormats, estimate)
+	}
 
 	// Configure revoked analysis if not skipped
 	if !skipRevokedAnalysis {
-		fmt.Printf("🔍 Analyzing user profile: %s\n", username)
-		fmt.Printf("📡 Fetching data from %s.wikipedia.org...\n", language)
-		fmt.Printf("🚫 Revoked contributions analysis: enabled\n")
-		fmt.Printf("   📊 Max pages to analyze: %d\n", maxPagesToAnalyze)
-		fmt.Printf("   📄 Max revisions per page: %d\n", maxRevisionsPerPage)
-		fmt.Printf("   📅 Recent days only: %d\n", recentDaysOnly)
+		fmt.Fprintf(progressOutput, "🔍 Analyzing user profile: %s\n", username)
+		fmt.Fprintf(progressOutput, "📡 Fetching data from %s.wikipedia.org...\n", language)
+		fmt.Fprintf(progressOutput, "🚫 Revoked contributions analysis: enabled\n")
+		fmt.Fprintf(progressOutput, "   📊 Max pages to analyze: %d\n", maxPagesToAnalyze)
+		fmt.Fprintf(progressOutput, "   📄 Max revisions per page: %d\n", maxRevisionsPerPage)
+		fmt.Fprintf(progressOutput, "   📅 Recent days only: %d\n", recentDaysOnly)
 		if enableDeepAnalysis {
-			fmt.Printf("   🔬 Deep analysis: enabled (slower but more accurate)\n")
+			fmt.Fprintf(progressOutput, "   🔬 Deep analysis: enabled (slower but more accurate)\n")
+			if verifyRevertContent {
+				fmt.Fprintf(progressOutput, "   🧾 Revert content verification: enabled\n")
+			}
 		} else {
-			fmt.Printf("   ⚡ Quick analysis: enabled (faster but less detailed)\n")
+			fmt.Fprintf(progressOutput, "   ⚡ Quick analysis: enabled (faster but less detailed)\n")
 		}
 	} else {
-		fmt.Printf("🔍 Analyzing user profile: %s\n", username)
-		fmt.Printf("📡 Fetching data from %s.wikipedia.org...\n", language)
-		fmt.Printf("⚠️  Revoked contributions analysis: skipped\n")
-	}
-
-	// Create revoked analysis configuration from CLI flags
-	var revokedConfig *analyzer.RevokedAnalysisConfig
-	if !skipRevokedAnalysis {
-		revokedConfig = &analyzer.RevokedAnalysisConfig{
-			MaxPagesToAnalyze:   maxPagesToAnalyze,
-			MaxRevisionsPerPage: maxRevisionsPerPage,
-			EnableDeepAnalysis:  enableDeepAnalysis,
-			RecentDaysOnly:      recentDaysOnly,
-		}
+		fmt.Fprintf(progressOutput, "🔍 Analyzing user profile: %s\n", username)
+		fmt.Fprintf(progressOutput, "📡 Fetching data from %s.wikipedia.org...\n", language)
+		fmt.Fprintf(progressOutput, "⚠️  Revoked contributions analysis: skipped\n")
 	}
 
 	// Get user profile with custom configuration
-	userProfile, err := userAnalyzer.GetUserProfileWithConfig(username, revokedConfig)
+	userProfile, err := analysisClient.AnalyzeUser(username, userOptions)
 	if err != nil {
-		return fmt.Errorf("error retrieving profile: %w", err)
+		return noDataOutcome(err, "error retrieving profile", "Check the username, or widen or drop --namespace")
 	}
 
 	// Display analysis results summary
 	if !skipRevokedAnalysis && userProfile.RevokedCount > 0 {
-		fmt.Printf("🚫 Found %d revoked contributions (%.1f%% of total)\n",
-			userProfile.RevokedCount, userProfile.RevokedRatio*100)
+		fmt.Fprintf(progressOutput, "🚫 Found %d revoked contributions (%.1f%% of %d sampled)\n",
+			userProfile.RevokedCount, userProfile.RevokedRatio*100, userProfile.SampleSize)
 
 		if userProfile.RevokedRatio > 0.3 {
-			fmt.Printf("⚠️  High revocation rate detected - potential issues\n")
+			fmt.Fprintf(progressOutput, "⚠️  High revocation rate detected - potential issues\n")
 		}
 	}
 
+	if exportCalendar != "" {
+		if err := exportActivityCalendar(exportCalendar, userProfile); err != nil {
+			return err
+		}
+	}
+
+	if listFlagged {
+		return emitFlaggedList(formatter.FlaggedUsers(userProfile, flaggedThreshold))
+	}
+
+	render := func(format string) (string, error) {
+		return formatter.FormatUserProfile(userProfile, format)
+	}
+
+	// Browse interactively, still saving the report when requested
+	if userTUI {
+		if saveToFile != "" {
+			if err := emitOutput(outputFormats, saveToFile, "Results saved to", render); err != nil {
+				return err
+			}
+		}
+		return tui.Run(tui.NewUserModel(userProfile))
+	}
+
 	// Format and display results
-	output, err := formatter.FormatUserProfile(userProfile, outputFormat)
+	return emitOutput(outputFormats, saveToFile, "Results saved to", render)
+}
+
+func runUserAdversaries(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(outputFormat)
 	if err != nil {
-		return fmt.Errorf("error formatting output: %w", err)
+		return err
+	}
+	if err := validateNamespaces(userNamespaces); err != nil {
+		return err
 	}
 
-	// Display or save
-	if saveToFile != "" {
-		err = os.WriteFile(saveToFile, []byte(output), 0644)
-		if err != nil {
-			return fmt.Errorf("error saving file: %w", err)
-		}
-		fmt.Printf("✅ Results saved to: %s\n", saveToFile)
-	} else {
-		fmt.Print(output)
+	username := args[0]
+
+	// Create analysis client
+	analysisClient, err := newAnalysisClient(cmd, language)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(progressOutput, "⚔️  Ranking the adversaries of: %s\n", username)
+	fmt.Fprintf(progressOutput, "📡 Fetching data from %s.wikipedia.org...\n", language)
+
+	report, err := analysisClient.AnalyzeAdversaries(username, wikiosint.UserOptions{
+		Namespaces: userNamespaces,
+		Revoked: &wikiosint.RevokedOptions{
+			MaxPagesToAnalyze:   maxPagesToAnalyze,
+			MaxRevisionsPerPage: maxRevisionsPerPage,
+			EnableDeepAnalysis:  enableDeepAnalysis,
+			RecentDaysOnly:      recentDaysOnly,
+			VerifyContent:       verifyRevertContent,
+		},
+	})
+	if err != nil {
+		return noDataOutcome(err, "error ranking adversaries", "Check the username, or widen or drop --namespace")
+	}
+
+	fmt.Fprintf(progressOutput, "👥 Found %d users reverting %s (%d reciprocal)\n", len(report.Adversaries), report.Username, report.ReciprocalCount)
+
+	return emitOutput(outputFormats, saveToFile, "Results saved to", func(format string) (string, error) {
+		return formatter.FormatAdversaryReport(report, format)
+	})
+}
+
+func runUserFootprint(cmd *cobra.Command, args []string) error {
+	// Validate output formats
+	outputFormats, err := parseOutputFormats(outputFormat)
+	if err != nil {
+		return err
+	}
+	if footprintMaxPages <= 0 {
+		return fmt.Errorf("max-pages must be positive")
+	}
+	pageOptions := wikiosint.PageOptions{
+		NumberOfPageRevisions: pageMaxRevisions,
+		NumberOfDaysHistory:   pageMaxHistory,
+		NumberOfContributors:  pageMaxContributors,
+	}
+	if err := pageOptions.Validate(); err != nil {
+		return fmt.Errorf("invalid analysis options: %w", err)
 	}
 
+	username := args[0]
+
+	// Create analysis client
+	analysisClient, err := newAnalysisClient(cmd, language)
+	if err != nil {
+		return err
+	}
+
+	domainClassifier, err := newDomainClassifier()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(progressOutput, "👣 Mapping the footprint of: %s\n", username)
+	fmt.Fprintf(progressOutput, "📡 Fetching data from %s.wikipedia.org...\n", language)
+	fmt.Fprintf(progressOutput, "📄 Analyzing up to %d most edited pages\n", footprintMaxPages)
+
+	report, err := analysisClient.AnalyzeFootprint(username, wikiosint.FootprintOptions{
+		MaxPages:              footprintMaxPages,
+		NumberOfPageRevisions: pageMaxRevisions,
+		NumberOfDaysHistory:   pageMaxHistory,
+		NumberOfContributors:  pageMaxContributors,
+		DomainClassifier:      domainClassifier,
+	})
+	if err != nil {
+		return noDataOutcome(err, "error mapping footprint", "Check the username")
+	}
+
+	fmt.Fprintf(progressOutput, "✅ Analyzed %d pages edited by %s\n", len(report.Pages), report.Username)
+
+	return emitOutput(outputFormats, saveToFile, "Results saved to", func(format string) (string, error) {
+		return formatter.FormatUserFootprint(report, format)
+	})
+}
+
+// validateNamespaces rejects the virtual namespaces (Special, Media), which hold
+// no contributions
+func validateNamespaces(namespaces []int) error {
+	for _, namespace := range namespaces {
+		if namespace < 0 {
+			return fmt.Errorf("invalid namespace %d: namespace IDs must not be negative", namespace)
+		}
+	}
 	return nil
 }
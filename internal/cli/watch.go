@@ -0,0 +1,221 @@
+// internal/cli/watch.go
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/wikiosint"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchLanguage    string
+	watchInterval    time.Duration
+	watchThreshold   int
+	watchFeedFile    string
+	watchMaxProfiles int
+	watchWebhookURL  string
+)
+
+// maxFeedEntries bounds the Atom feed to the most recent suspicious revisions
+const maxFeedEntries = 100
+
+// watchCmd represents the page watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch [page_title]",
+	Short: "Watch a page for new suspicious revisions",
+	Long: `Poll a Wikipedia page and analyze every new revision as it arrives,
+reporting those whose suspicion score reaches the threshold.
+
+Revisions existing when the watch starts are not analyzed. Stop with Ctrl+C.
+
+Configuration options:
+  --interval: Time between polls (default: 5m)
+  --threshold: Minimum suspicion score to report (0-100) (default: 40)
+  --feed: Atom feed file rewritten after each poll, for feed readers
+  --webhook-url: POST a JSON alert to this URL (Slack, Discord, or any webhook)
+    for each suspicious revision
+
+Examples:
+  wikiosint page watch "Page Title"
+  wikiosint page watch "Page Title" --interval 2m --feed page-watch.xml
+  wikiosint page watch "Page Title" --webhook-url https://hooks.slack.com/services/...`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPageWatch,
+}
+
+func init() {
+	pageCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().StringVarP(&watchLanguage, "lang", "l", "en", "Wikipedia language (en, fr, de, etc.)")
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", 5*time.Minute, "time between polls")
+	watchCmd.Flags().IntVar(&watchThreshold, "threshold", 40, "minimum suspicion score threshold (0-100)")
+	watchCmd.Flags().StringVar(&watchFeedFile, "feed", "", "write an Atom feed of suspicious revisions to this file after each poll")
+	watchCmd.Flags().StringVar(&watchWebhookURL, "webhook-url", "", "POST a JSON alert to this webhook URL for each suspicious revision")
+	watchCmd.Flags().IntVar(&watchMaxProfiles, "max-author-profiles", 25, "maximum number of full author profiles to analyze")
+}
+
+func runPageWatch(cmd *cobra.Command, args []string) error {
+	pageTitle := args[0]
+
+	if watchThreshold < 0 || watchThreshold > 100 {
+		return fmt.Errorf("suspicion threshold must be between 0 and 100")
+	}
+	if watchInterval < 30*time.Second {
+		return fmt.Errorf("interval must be at least 30s")
+	}
+
+	var webhook *client.WebhookClient
+	if watchWebhookURL != "" {
+		parsed, err := url.Parse(watchWebhookURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid webhook URL: %s", watchWebhookURL)
+		}
+		webhook = client.NewWebhookClient(watchWebhookURL)
+	}
+
+	analysisClient, err := newAnalysisClient(cmd, watchLanguage)
+	if err != nil {
+		return err
+	}
+
+	// Start from the current revision: only edits made during the watch are analyzed
+	latest, err := analysisClient.RecentRevisions(pageTitle, 1)
+	if err != nil {
+		return fmt.Errorf("error retrieving page revisions: %w", err)
+	}
+	if len(latest) == 0 {
+		return fmt.Errorf("page not found: %s", pageTitle)
+	}
+	lastSeen := latest[0].RevID
+
+	fmt.Printf("👁️  Watching %s on %s.wikipedia.org (every %s, threshold %d/100)\n", pageTitle, watchLanguage, watchInterval, watchThreshold)
+	fmt.Printf("📌 Current revision: %d\n", lastSeen)
+
+	var suspicious []*models.ContributionProfile
+	if watchFeedFile != "" {
+		if err := writeWatchFeed(pageTitle, suspicious); err != nil {
+			return err
+		}
+	}
+
+	for {
+		time.Sleep(watchInterval)
+
+		newRevisions, err := analysisClient.RevisionsSince(pageTitle, lastSeen)
+		if errors.Is(err, client.ErrBudgetExhausted) {
+			return err
+		}
+		if err != nil {
+			fmt.Printf("⚠️  Poll failed: %v\n", err)
+			continue
+		}
+		if len(newRevisions) == 0 {
+			// Refresh the feed's updated time: its readers see the watch is alive
+			if watchFeedFile != "" {
+				if err := writeWatchFeed(pageTitle, suspicious); err != nil {
+					fmt.Printf("⚠️  %v\n", err)
+				}
+			}
+			continue
+		}
+		lastSeen = newRevisions[len(newRevisions)-1].RevID
+
+		revisionIDs := make([]int, len(newRevisions))
+		for i, revision := range newRevisions {
+			revisionIDs[i] = revision.RevID
+		}
+
+		// The author profile budget applies to each poll's revisions
+		profiles, err := analysisClient.AnalyzeContributions(revisionIDs, pageTitle, wikiosint.ContributionsOptions{
+			ContributionOptions: wikiosint.ContributionOptions{
+				AnalysisDepth:     "basic",
+				MaxAuthorProfiles: watchMaxProfiles,
+			},
+			OnRevisionAnalyzed: func(index, total, revisionID int, profile *models.ContributionProfile, err error) {
+				if err != nil {
+					fmt.Printf("⚠️  Unable to analyze revision %d: %v\n", revisionID, err)
+				}
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, profile := range profiles {
+			if profile.SuspicionScore < watchThreshold {
+				continue
+			}
+
+			fmt.Printf("🚨 [%s] revision %d by %s: %d/100\n   %s\n",
+				profile.Timestamp.Format("2006-01-02 15:04"), profile.RevisionID, profile.Author.Username,
+				profile.SuspicionScore, client.DiffURL(watchLanguage, profile.RevisionID))
+			suspicious = append(suspicious, profile)
+
+			if webhook != nil {
+				if err := webhook.Post(newWatchAlert(profile)); err != nil {
+					fmt.Printf("⚠️  Unable to send alert for revision %d: %v\n", profile.RevisionID, err)
+				}
+			}
+		}
+
+		if len(suspicious) > maxFeedEntries {
+			suspicious = suspicious[len(suspicious)-maxFeedEntries:]
+		}
+
+		if watchFeedFile != "" {
+			if err := writeWatchFeed(pageTitle, suspicious); err != nil {
+				fmt.Printf("⚠️  %v\n", err)
+			}
+		}
+	}
+}
+
+// newWatchAlert builds the webhook payload of a suspicious revision
+func newWatchAlert(profile *models.ContributionProfile) models.WatchAlert {
+	diffURL := client.DiffURL(watchLanguage, profile.RevisionID)
+	summary := fmt.Sprintf("🚨 Suspicious edit to %s by %s (%d/100): %s",
+		profile.PageTitle, profile.Author.Username, profile.SuspicionScore, diffURL)
+
+	flags := profile.SuspicionFlags
+	if flags == nil {
+		flags = []string{}
+	}
+
+	return models.WatchAlert{
+		SchemaVersion:  models.WatchAlertSchemaVersion,
+		Event:          "suspicious_revision",
+		Text:           summary,
+		Content:        summary,
+		Language:       watchLanguage,
+		PageTitle:      profile.PageTitle,
+		RevisionID:     profile.RevisionID,
+		Timestamp:      profile.Timestamp.UTC(),
+		Author:         profile.Author.Username,
+		IsAnonymous:    profile.Author.IsAnonymous,
+		Comment:        profile.Comment,
+		SuspicionScore: profile.SuspicionScore,
+		Threshold:      watchThreshold,
+		SuspicionFlags: flags,
+		DiffURL:        diffURL,
+	}
+}
+
+// writeWatchFeed rewrites the Atom feed file with the suspicious revisions found so far
+func writeWatchFeed(pageTitle string, profiles []*models.ContributionProfile) error {
+	feed, err := formatter.FormatAtomFeed(pageTitle, watchLanguage, profiles, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(watchFeedFile, []byte(feed), 0644); err != nil {
+		return fmt.Errorf("error saving feed file: %w", err)
+	}
+	return nil
+}
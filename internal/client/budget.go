@@ -0,0 +1,57 @@
+// internal/client/budget.go
+package client
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ErrBudgetExhausted is returned, without contacting the API, by the requests
+// made once the call budget set by SetMaxAPICalls is spent
+var ErrBudgetExhausted = errors.New("API call budget exhausted")
+
+// ErrDryRun is returned, without contacting the API, by every request of a
+// client set to a dry run by SetDryRun
+var ErrDryRun = errors.New("dry run: no API request is sent")
+
+// SetDryRun makes the client refuse every request with ErrDryRun, so that a run
+// only meant to estimate its requests never contacts the API
+func (w *WikipediaClient) SetDryRun(dryRun bool) {
+	w.dryRun.Store(dryRun)
+}
+
+// SetMaxAPICalls caps the number of HTTP requests the client sends, retries
+// included. Past the cap, requests fail with ErrBudgetExhausted, so analyzers
+// return what they gathered so far. Zero removes the cap.
+func (w *WikipediaClient) SetMaxAPICalls(max int) {
+	atomic.StoreInt64(&w.maxAPICalls, int64(max))
+}
+
+// APICalls returns the number of HTTP requests sent so far
+func (w *WikipediaClient) APICalls() int {
+	return int(atomic.LoadInt64(&w.apiCalls))
+}
+
+// BudgetExhausted reports whether requests were refused for lack of budget, i.e.
+// whether results built with this client may be partial
+func (w *WikipediaClient) BudgetExhausted() bool {
+	return atomic.LoadInt64(&w.refusedCalls) > 0
+}
+
+// chargeAPICall counts a request against the budget, refusing it when spent.
+// The count is taken first and given back on refusal, so that concurrent
+// requests never overrun the budget.
+func (w *WikipediaClient) chargeAPICall(c *resty.Client, req *resty.Request) error {
+	if w.dryRun.Load() {
+		return ErrDryRun
+	}
+	calls := atomic.AddInt64(&w.apiCalls, 1)
+	if maxCalls := atomic.LoadInt64(&w.maxAPICalls); maxCalls > 0 && calls > maxCalls {
+		atomic.AddInt64(&w.apiCalls, -1)
+		atomic.AddInt64(&w.refusedCalls, 1)
+		return ErrBudgetExhausted
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// internal/client/capabilities.go
+package client
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// Versions of MediaWiki from which the API supports a feature
+var (
+	changeTagsVersion     = [2]int{1, 16} // rvprop=tags, ucprop=tags
+	revisionSlotsVersion  = [2]int{1, 32} // rvslots, rvprop=slotsize
+	coreRenameuserVersion = [2]int{1, 40} // Renameuser merged into core
+)
+
+var generatorVersionPattern = regexp.MustCompile(`MediaWiki (\d+)\.(\d+)`)
+
+// SiteCapabilities describes what the MediaWiki install of a wiki supports, from
+// its siteinfo. When the probe failed (Probed false) every feature is assumed
+// supported, as on the Wikimedia wikis.
+type SiteCapabilities struct {
+	Generator  string          // "MediaWiki 1.43.0-wmf.8"
+	Version    [2]int          // Major and minor version, zero when unknown
+	Extensions map[string]bool // Names of the installed extensions
+	Probed     bool
+}
+
+// atLeast reports whether the wiki runs version or later. An unknown version
+// counts as recent.
+func (c *SiteCapabilities) atLeast(version [2]int) bool {
+	if !c.Probed || c.Version == [2]int{} {
+		return true
+	}
+	return c.Version[0] > version[0] || (c.Version[0] == version[0] && c.Version[1] >= version[1])
+}
+
+// HasExtension reports whether an extension is installed
+func (c *SiteCapabilities) HasExtension(name string) bool {
+	return !c.Probed || c.Extensions[name]
+}
+
+// ChangeTags reports whether revisions and contributions carry change tags
+func (c *SiteCapabilities) ChangeTags() bool {
+	return c.atLeast(changeTagsVersion)
+}
+
+// RevisionSlots reports whether revisions have content slots (multi-content
+// revisions)
+func (c *SiteCapabilities) RevisionSlots() bool {
+	return c.atLeast(revisionSlotsVersion)
+}
+
+// UserRenames reports whether the wiki logs account renames (renameuser log)
+func (c *SiteCapabilities) UserRenames() bool {
+	return c.HasExtension("Renameuser") || (c.Probed && c.atLeast(coreRenameuserVersion))
+}
+
+// PageViews reports whether the wiki publishes pageview statistics (through the
+// Wikimedia pageviews API)
+func (c *SiteCapabilities) PageViews() bool {
+	return c.HasExtension("PageViewInfo")
+}
+
+// Capabilities probes the wiki's siteinfo for its version and extensions, once
+// per client. A failed probe is not retried: the client then assumes every
+// feature is supported and requests as it would on Wikipedia.
+func (w *WikipediaClient) Capabilities() *SiteCapabilities {
+	w.capabilitiesMu.Lock()
+	defer w.capabilitiesMu.Unlock()
+
+	if w.capabilities != nil {
+		return w.capabilities
+	}
+
+	params := map[string]string{
+		"action": "query",
+		"meta":   "siteinfo",
+		"siprop": "general|extensions",
+		"format": "json",
+	}
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil || resp.StatusCode() != 200 {
+		w.capabilities = &SiteCapabilities{}
+		return w.capabilities
+	}
+
+	w.capabilities = parseSiteCapabilities(resp.Body())
+	return w.capabilities
+}
+
+// parseSiteCapabilities reads a siteinfo response (siprop=general|extensions).
+// A response without general information counts as a failed probe.
+func parseSiteCapabilities(body []byte) *SiteCapabilities {
+	general := gjson.GetBytes(body, "query.general")
+	if !general.Exists() {
+		return &SiteCapabilities{}
+	}
+
+	capabilities := &SiteCapabilities{
+		Generator:  general.Get("generator").String(),
+		Extensions: make(map[string]bool),
+		Probed:     true,
+	}
+	if match := generatorVersionPattern.FindStringSubmatch(capabilities.Generator); match != nil {
+		capabilities.Version[0], _ = strconv.Atoi(match[1])
+		capabilities.Version[1], _ = strconv.Atoi(match[2])
+	}
+	for _, extension := range gjson.GetBytes(body, "query.extensions").Array() {
+		if name := strings.TrimSpace(extension.Get("name").String()); name != "" {
+			capabilities.Extensions[name] = true
+		}
+	}
+
+	return capabilities
+}
+
+// applyCapabilities adapts the props of a revision or contribution query to the
+// wiki: change tags are dropped where the wiki predates them, and where revisions
+// have slots the main slot is requested, with its size
+func (w *WikipediaClient) applyCapabilities(params map[string]string) {
+	capabilities := w.Capabilities()
+
+	for _, key := range []string{"rvprop", "ucprop", "adrprop"} {
+		props, exists := params[key]
+		if !exists {
+			continue
+		}
+		list := strings.Split(props, "|")
+		var kept []string
+		for _, prop := range list {
+			if prop == "tags" && !capabilities.ChangeTags() {
+				continue
+			}
+			kept = append(kept, prop)
+			if key == "rvprop" && prop == "size" && capabilities.RevisionSlots() {
+				kept = append(kept, "slotsize")
+			}
+		}
+		params[key] = strings.Join(kept, "|")
+	}
+
+	if _, exists := params["rvprop"]; exists && capabilities.RevisionSlots() {
+		params["rvslots"] = "main"
+	}
+}
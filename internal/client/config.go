@@ -0,0 +1,70 @@
+// internal/client/config.go
+package client
+
+import (
+	"fmt"
+)
+
+// Config gathers the settings of a client created by New. The zero value is an
+// anonymous English Wikipedia client with the default maxlag.
+type Config struct {
+	Language      string // Wikipedia language edition (default "en")
+	APIURL        string // api.php endpoint of another MediaWiki wiki, instead of the Wikipedia one
+	UserAgent     string // User-Agent header (default: the WikiOSINT one)
+	MaxLag        int    // maxlag seconds of API requests (default DefaultMaxLag)
+	DisableMaxLag bool   // Send no maxlag parameter
+	MaxAPICalls   int    // Request budget, 0 for unlimited
+	SessionCookie string // Session cookie of a logged-in account
+	Username      string // Bot password username (Account@BotName), logged in with Password
+	Password      string
+	RawDumpDir    string // Directory receiving each raw API response
+	DryRun        bool   // Nothing is sent, not even a login: requests fail with ErrDryRun
+}
+
+// New creates a client configured from config, logging in when credentials are
+// given
+func New(config Config) (*WikipediaClient, error) {
+	language := config.Language
+	if language == "" {
+		language = "en"
+	}
+
+	wikiClient := NewWikipediaClient(language)
+	if config.APIURL != "" {
+		wikiClient.SetAPIURL(config.APIURL)
+	}
+	if config.UserAgent != "" {
+		wikiClient.SetUserAgent(config.UserAgent)
+	}
+	if config.DisableMaxLag {
+		wikiClient.SetMaxLag(0)
+	} else if config.MaxLag > 0 {
+		wikiClient.SetMaxLag(config.MaxLag)
+	}
+	wikiClient.SetMaxAPICalls(config.MaxAPICalls)
+
+	if config.SessionCookie != "" {
+		wikiClient.SetSessionCookie(config.SessionCookie)
+	}
+
+	// A dry run sends nothing, not even a login
+	if config.DryRun {
+		wikiClient.SetDryRun(true)
+		return wikiClient, nil
+	}
+
+	// Log in when credentials are given, anonymous access otherwise
+	if config.Username != "" && config.Password != "" {
+		if err := wikiClient.Login(config.Username, config.Password); err != nil {
+			return nil, fmt.Errorf("unable to log in as %s: %w", config.Username, err)
+		}
+	}
+
+	if config.RawDumpDir != "" {
+		if err := wikiClient.SetRawDumpDir(config.RawDumpDir); err != nil {
+			return nil, fmt.Errorf("unable to enable raw response dump: %w", err)
+		}
+	}
+
+	return wikiClient, nil
+}
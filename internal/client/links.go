@@ -0,0 +1,112 @@
+// internal/client/links.go
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RevisionLink is what a pasted Wikipedia diff or permalink URL points to
+type RevisionLink struct {
+	Language   string // Edition, from the host (en.wikipedia.org, en.m.wikipedia.org)
+	Title      string // Page title when the URL names it, empty otherwise
+	RevisionID int    // Revision to analyze: the newer side of a diff, or the permalinked one
+	OldID      int    // Older side of a diff, 0 for permalinks
+}
+
+// ParseRevisionURL extracts the edition, title and revision from the URL shapes
+// investigators paste:
+//
+//	https://en.wikipedia.org/w/index.php?title=Foo&diff=12345&oldid=12000
+//	https://en.wikipedia.org/w/index.php?diff=prev&oldid=12345
+//	https://en.wikipedia.org/w/index.php?title=Foo&oldid=12345
+//	https://en.wikipedia.org/wiki/Foo?oldid=12345
+//	https://en.wikipedia.org/wiki/Special:Diff/12000/12345
+//	https://en.m.wikipedia.org/wiki/Special:PermanentLink/12345
+func ParseRevisionURL(rawURL string) (*RevisionLink, error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if !strings.HasSuffix(host, ".wikipedia.org") {
+		return nil, fmt.Errorf("not a Wikipedia URL: %s", rawURL)
+	}
+	link := &RevisionLink{Language: strings.Split(host, ".")[0]}
+
+	query := parsed.Query()
+	if title := query.Get("title"); title != "" {
+		link.Title = normalizeLinkTitle(title)
+	}
+
+	// Special:Diff/<rev>, Special:Diff/<old>/<rev> and Special:PermanentLink/<rev>
+	if pagePath, isArticle := strings.CutPrefix(parsed.Path, "/wiki/"); isArticle {
+		parts := strings.Split(pagePath, "/")
+		switch parts[0] {
+		case "Special:Diff", "Special:PermanentLink", "Special:Permalink":
+			ids := make([]int, 0, 2)
+			for _, part := range parts[1:] {
+				if part == "" {
+					continue
+				}
+				id, err := strconv.Atoi(part)
+				if err != nil {
+					return nil, fmt.Errorf("invalid revision ID in URL: %s", part)
+				}
+				ids = append(ids, id)
+			}
+			if len(ids) == 0 {
+				return nil, fmt.Errorf("no revision ID in URL: %s", rawURL)
+			}
+			link.RevisionID = ids[len(ids)-1]
+			if len(ids) > 1 {
+				link.OldID = ids[0]
+			}
+			return link, nil
+		default:
+			if link.Title == "" {
+				link.Title = normalizeLinkTitle(pagePath)
+			}
+		}
+	}
+
+	oldID := 0
+	if value := query.Get("oldid"); value != "" {
+		if oldID, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf("invalid oldid in URL: %s", value)
+		}
+	}
+
+	switch diff := query.Get("diff"); diff {
+	case "":
+		// Permalink to a single revision
+		link.RevisionID = oldID
+	case "prev":
+		// The change made by oldid itself
+		link.RevisionID = oldID
+	case "next", "cur":
+		// The newer side is not in the URL
+		return nil, fmt.Errorf("diff=%s links do not name the newer revision; open the diff and use its revision ID", diff)
+	default:
+		revisionID, err := strconv.Atoi(diff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid diff in URL: %s", diff)
+		}
+		link.RevisionID = revisionID
+		link.OldID = oldID
+	}
+
+	if link.RevisionID == 0 {
+		return nil, fmt.Errorf("no revision ID in URL: %s", rawURL)
+	}
+
+	return link, nil
+}
+
+// normalizeLinkTitle turns a decoded URL title into its display form
+func normalizeLinkTitle(title string) string {
+	return strings.ReplaceAll(title, "_", " ")
+}
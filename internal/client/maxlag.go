@@ -0,0 +1,53 @@
+// internal/client/maxlag.go
+package client
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/tidwall/gjson"
+)
+
+// DefaultMaxLag is the replication lag, in seconds, above which the API is asked
+// to refuse our requests, as Wikimedia recommends for automated clients
+const DefaultMaxLag = 5
+
+// SetMaxLag sets the maxlag parameter sent with every API request. When the
+// servers lag more, the request is retried after the wait the API suggests.
+// Zero disables the parameter.
+func (w *WikipediaClient) SetMaxLag(seconds int) {
+	w.maxLag = seconds
+}
+
+// applyMaxLag adds the maxlag parameter to action API requests (not the REST
+// endpoints, which do not support it)
+func (w *WikipediaClient) applyMaxLag(c *resty.Client, req *resty.Request) error {
+	if w.maxLag > 0 && strings.HasPrefix(req.URL, w.baseURL) {
+		req.SetQueryParam("maxlag", strconv.Itoa(w.maxLag))
+	}
+	return nil
+}
+
+// isMaxLagResponse reports whether the API refused a request for replication lag
+func isMaxLagResponse(resp *resty.Response, err error) bool {
+	if err != nil || resp == nil {
+		return false
+	}
+	return gjson.GetBytes(resp.Body(), "error.code").String() == "maxlag"
+}
+
+// maxLagRetryAfter waits the Retry-After delay of maxlag errors. Zero falls back
+// to resty's backoff for other retried failures.
+func maxLagRetryAfter(c *resty.Client, resp *resty.Response) (time.Duration, error) {
+	if !isMaxLagResponse(resp, nil) {
+		return 0, nil
+	}
+
+	seconds, err := strconv.Atoi(resp.Header().Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		seconds = DefaultMaxLag
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
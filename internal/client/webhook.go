@@ -0,0 +1,59 @@
+// internal/client/webhook.go
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// WebhookClient posts JSON payloads to a webhook (Slack, Discord or any endpoint
+// accepting JSON), retrying transient failures
+type WebhookClient struct {
+	client *resty.Client
+	url    string
+}
+
+// NewWebhookClient creates a client posting to url
+func NewWebhookClient(url string) *WebhookClient {
+	client := resty.New()
+	client.SetTimeout(defaultTimeout)
+	client.SetRetryCount(maxRetries)
+	client.SetRetryWaitTime(1 * time.Second)
+	client.SetRetryMaxWaitTime(5 * time.Second)
+	client.SetHeader("User-Agent", defaultUserAgent)
+
+	// Network errors are retried by default; also retry rate limiting and server errors
+	client.AddRetryCondition(isTransientWebhookFailure)
+
+	return &WebhookClient{client: client, url: url}
+}
+
+// isTransientWebhookFailure reports whether a webhook response is worth retrying
+func isTransientWebhookFailure(resp *resty.Response, err error) bool {
+	if err != nil || resp == nil {
+		return false
+	}
+	return resp.StatusCode() == http.StatusTooManyRequests || resp.StatusCode() >= 500
+}
+
+// Post sends payload as a JSON body
+func (wc *WebhookClient) Post(payload interface{}) error {
+	resp, err := wc.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(payload).
+		Post(wc.url)
+
+	if err != nil {
+		return fmt.Errorf("webhook request error: %w", err)
+	}
+
+	// Slack replies 200, Discord 204
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return fmt.Errorf("non-2xx webhook response: %d", resp.StatusCode())
+	}
+
+	return nil
+}
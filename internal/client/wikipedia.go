@@ -2,7 +2,15 @@
 package client
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -11,16 +19,38 @@ import (
 )
 
 const (
+	pageviewsBaseURL = "https://wikimedia.org/api/rest_v1/metrics/pageviews/per-article"
 	defaultUserAgent = "WikiOSINT/1.0 (https://github.com/votre-username/wikiosint)"
 	defaultTimeout   = 30 * time.Second
 	maxRetries       = 3
+
+	defaultQueryLimit = 500  // Max items per list request for anonymous and regular accounts
+	highQueryLimit    = 5000 // Max items per list request with the apihighlimits right (bots, admins)
 )
 
+// ErrPermissionDenied is returned when the API refuses a query that requires
+// rights the current session does not have (e.g. deleted revisions)
+var ErrPermissionDenied = errors.New("permission denied by the API")
+
 // WikipediaClient encapsulates interactions with the MediaWiki API
 type WikipediaClient struct {
-	client   *resty.Client
-	baseURL  string
-	language string
+	client     *resty.Client
+	baseURL    string
+	language   string
+	dumpCount  int64 // Sequence number of raw responses written by SetRawDumpDir
+	highLimits bool  // Session has the apihighlimits right (set by Login)
+	maxLag     int   // maxlag parameter of API requests, 0 to disable
+
+	apiCalls     int64       // HTTP requests sent, retries included
+	maxAPICalls  int64       // Request budget set by SetMaxAPICalls, 0 for unlimited
+	refusedCalls int64       // Requests refused once the budget was spent
+	dryRun       atomic.Bool // Every request is refused (set by SetDryRun)
+
+	namespacesMu sync.Mutex
+	namespaces   map[int]string // Localized namespace names, fetched once by GetNamespaceNames
+
+	capabilitiesMu sync.Mutex
+	capabilities   *SiteCapabilities // Probed once by Capabilities
 }
 
 // NewWikipediaClient creates a new client for the Wikipedia API
@@ -36,11 +66,88 @@ func NewWikipediaClient(language string) *WikipediaClient {
 
 	baseURL := fmt.Sprintf("https://%s.wikipedia.org/w/api.php", language)
 
-	return &WikipediaClient{
+	w := &WikipediaClient{
 		client:   client,
 		baseURL:  baseURL,
 		language: language,
+		maxLag:   DefaultMaxLag,
+	}
+
+	// Back off while the database replicas lag (maxlag errors come back as HTTP 200)
+	client.OnBeforeRequest(w.applyMaxLag)
+	client.AddRetryCondition(isMaxLagResponse)
+	client.SetRetryAfter(maxLagRetryAfter)
+
+	// Count requests (retries included) against the call budget
+	client.OnBeforeRequest(w.chargeAPICall)
+
+	return w
+}
+
+// applyHiddenMarkers records revision-deleted fields. Hidden users and comments come
+// back as "userhidden"/"commenthidden" markers without the field itself.
+func applyHiddenMarkers(rev gjson.Result, revision *models.WikiRevision) {
+	if rev.Get("userhidden").Exists() {
+		revision.UserHidden = true
+		revision.User = models.HiddenUser
+		revision.UserID = 0
+	}
+	if rev.Get("commenthidden").Exists() {
+		revision.CommentHidden = true
+		revision.Comment = models.HiddenComment
+	}
+	if rev.Get("suppressed").Exists() {
+		revision.Suppressed = true
+	}
+}
+
+// DiffURL returns the link to the diff introduced by a revision on a Wikipedia edition
+func DiffURL(language string, revisionID int) string {
+	return fmt.Sprintf("https://%s.wikipedia.org/w/index.php?diff=%d", language, revisionID)
+}
+
+// GetNamespaceNames retrieves the localized namespace names of the wiki (siteinfo),
+// keyed by namespace ID. The main namespace has an empty name. The result is
+// fetched once per client and cached.
+func (w *WikipediaClient) GetNamespaceNames() (map[int]string, error) {
+	w.namespacesMu.Lock()
+	defer w.namespacesMu.Unlock()
+
+	if w.namespaces != nil {
+		return w.namespaces, nil
+	}
+
+	params := map[string]string{
+		"action": "query",
+		"meta":   "siteinfo",
+		"siprop": "namespaces",
+		"format": "json",
+	}
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	namespaces := make(map[int]string)
+	gjson.Get(string(resp.Body()), "query.namespaces").ForEach(func(key, value gjson.Result) bool {
+		namespaces[int(value.Get("id").Int())] = value.Get("\\*").String()
+		return true
+	})
+
+	if len(namespaces) == 0 {
+		return nil, fmt.Errorf("no namespaces returned by the API")
 	}
+
+	w.namespaces = namespaces
+	return namespaces, nil
 }
 
 // GetUserInfo retrieves basic user information
@@ -178,6 +285,12 @@ func (w *WikipediaClient) GetUserContributions(username string, limit int) ([]mo
 
 // GetUserContributionsWithTags retrieves user contributions with tags information
 func (w *WikipediaClient) GetUserContributionsWithTags(username string, limit int) ([]models.WikiContribution, error) {
+	return w.GetUserContributionsInNamespaces(username, limit, nil)
+}
+
+// GetUserContributionsInNamespaces retrieves user contributions with tags, limited
+// by the API to the given namespace IDs (all namespaces when none are given)
+func (w *WikipediaClient) GetUserContributionsInNamespaces(username string, limit int, namespaces []int) ([]models.WikiContribution, error) {
 	params := map[string]string{
 		"action":  "query",
 		"list":    "usercontribs",
@@ -186,6 +299,14 @@ func (w *WikipediaClient) GetUserContributionsWithTags(username string, limit in
 		"ucprop":  "ids|title|timestamp|comment|size|sizediff|flags|tags", // Added tags
 		"format":  "json",
 	}
+	if len(namespaces) > 0 {
+		ids := make([]string, len(namespaces))
+		for i, namespace := range namespaces {
+			ids[i] = fmt.Sprintf("%d", namespace)
+		}
+		params["ucnamespace"] = strings.Join(ids, "|")
+	}
+	w.applyCapabilities(params)
 
 	resp, err := w.client.R().
 		SetQueryParams(params).
@@ -246,6 +367,94 @@ func (w *WikipediaClient) GetUserContributionsWithTags(username string, limit in
 	return contributions, nil
 }
 
+// GetUserFirstContribution retrieves the oldest contribution of a user (nil if none)
+func (w *WikipediaClient) GetUserFirstContribution(username string) (*models.WikiContribution, error) {
+	params := map[string]string{
+		"action":  "query",
+		"list":    "usercontribs",
+		"ucuser":  username,
+		"uclimit": "1",
+		"ucdir":   "newer",
+		"ucprop":  "ids|title|timestamp",
+		"format":  "json",
+	}
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	body := string(resp.Body())
+	contribs := gjson.Get(body, "query.usercontribs").Array()
+
+	if len(contribs) == 0 {
+		return nil, nil
+	}
+
+	contrib := contribs[0]
+	return &models.WikiContribution{
+		UserID:    int(gjson.Get(contrib.String(), "userid").Int()),
+		User:      gjson.Get(contrib.String(), "user").String(),
+		PageID:    int(gjson.Get(contrib.String(), "pageid").Int()),
+		RevID:     int(gjson.Get(contrib.String(), "revid").Int()),
+		ParentID:  int(gjson.Get(contrib.String(), "parentid").Int()),
+		NS:        int(gjson.Get(contrib.String(), "ns").Int()),
+		Title:     gjson.Get(contrib.String(), "title").String(),
+		Timestamp: gjson.Get(contrib.String(), "timestamp").String(),
+	}, nil
+}
+
+// GetUserFirstPageEdit retrieves the oldest revision of a user on a page (nil if none)
+func (w *WikipediaClient) GetUserFirstPageEdit(title, username string) (*models.WikiRevision, error) {
+	params := map[string]string{
+		"action":  "query",
+		"titles":  title,
+		"prop":    "revisions",
+		"rvuser":  username,
+		"rvdir":   "newer",
+		"rvlimit": "1",
+		"rvprop":  "ids|timestamp|user|userid",
+		"format":  "json",
+	}
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	var firstEdit *models.WikiRevision
+	gjson.Get(string(resp.Body()), "query.pages").ForEach(func(key, page gjson.Result) bool {
+		revisions := page.Get("revisions").Array()
+		if len(revisions) == 0 {
+			return false
+		}
+		firstEdit = &models.WikiRevision{
+			RevID:     int(revisions[0].Get("revid").Int()),
+			ParentID:  int(revisions[0].Get("parentid").Int()),
+			User:      revisions[0].Get("user").String(),
+			UserID:    int(revisions[0].Get("userid").Int()),
+			Timestamp: revisions[0].Get("timestamp").String(),
+		}
+		return false
+	})
+
+	return firstEdit, nil
+}
+
 // GetUserEditsByNamespace retrieves edit statistics by namespace
 func (w *WikipediaClient) GetUserEditsByNamespace(username string) (map[int]int, error) {
 	// This query requires special privileges or extensions
@@ -265,16 +474,204 @@ func (w *WikipediaClient) GetUserEditsByNamespace(username string) (map[int]int,
 	return namespaceStats, nil
 }
 
+// GetUserRenames retrieves the renames of an account away from username, from
+// the renameuser log
+func (w *WikipediaClient) GetUserRenames(username string) ([]models.WikiRenameEvent, error) {
+	params := map[string]string{
+		"action":  "query",
+		"list":    "logevents",
+		"letype":  "renameuser",
+		"letitle": "User:" + username,
+		"leprop":  "title|user|timestamp|details",
+		"lelimit": "50",
+		"format":  "json",
+	}
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	renames := []models.WikiRenameEvent{}
+	for _, event := range gjson.Get(string(resp.Body()), "query.logevents").Array() {
+		newUser := event.Get("params.newuser").String()
+		if newUser == "" {
+			continue
+		}
+		oldUser := event.Get("params.olduser").String()
+		if oldUser == "" {
+			oldUser = username
+		}
+		renames = append(renames, models.WikiRenameEvent{
+			OldUser:   oldUser,
+			NewUser:   newUser,
+			User:      event.Get("user").String(),
+			Timestamp: event.Get("timestamp").String(),
+		})
+	}
+
+	return renames, nil
+}
+
 // SetUserAgent allows customizing the User-Agent
 func (w *WikipediaClient) SetUserAgent(userAgent string) {
 	w.client.SetHeader("User-Agent", userAgent)
 }
 
+// SetAPIURL points the client at the api.php endpoint of another MediaWiki wiki
+// (or a test server). Pageviews are still looked up for the configured language.
+func (w *WikipediaClient) SetAPIURL(apiURL string) {
+	w.baseURL = apiURL
+}
+
 // SetTimeout allows customizing the timeout
 func (w *WikipediaClient) SetTimeout(timeout time.Duration) {
 	w.client.SetTimeout(timeout)
 }
 
+// SetSessionCookie attaches a session cookie (e.g. copied from a logged-in browser,
+// "enwikiSession=...; enwikiUserName=...") to every request
+func (w *WikipediaClient) SetSessionCookie(cookie string) {
+	w.client.SetHeader("Cookie", cookie)
+}
+
+// Login authenticates the client with a bot password (Special:BotPasswords,
+// username "Account@BotName"). The session cookies are kept in the client cookie
+// jar and sent with every subsequent request, unlocking logged-in API limits and
+// rights-restricted data. Anonymous access remains the default.
+func (w *WikipediaClient) Login(username, password string) error {
+	// 1. Fetch a login token (this also starts the session)
+	resp, err := w.client.R().
+		SetQueryParams(map[string]string{
+			"action": "query",
+			"meta":   "tokens",
+			"type":   "login",
+			"format": "json",
+		}).
+		Get(w.baseURL)
+
+	if err != nil {
+		return fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	loginToken := gjson.Get(string(resp.Body()), "query.tokens.logintoken").String()
+	if loginToken == "" {
+		return fmt.Errorf("no login token returned by the API")
+	}
+
+	// 2. Log in with the token; credentials are sent in the POST body
+	resp, err = w.client.R().
+		SetQueryParam("format", "json").
+		SetFormData(map[string]string{
+			"action":     "login",
+			"lgname":     username,
+			"lgpassword": password,
+			"lgtoken":    loginToken,
+		}).
+		Post(w.baseURL)
+
+	if err != nil {
+		return fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	body := string(resp.Body())
+	if result := gjson.Get(body, "login.result").String(); result != "Success" {
+		reason := gjson.Get(body, "login.reason").String()
+		if reason == "" {
+			reason = gjson.Get(body, "error.info").String()
+		}
+		return fmt.Errorf("login failed (%s): %s", result, reason)
+	}
+
+	w.highLimits = w.hasRight("apihighlimits")
+
+	return nil
+}
+
+// hasRight reports whether the current session holds a user right. Failures
+// count as not holding it.
+func (w *WikipediaClient) hasRight(right string) bool {
+	resp, err := w.client.R().
+		SetQueryParams(map[string]string{
+			"action": "query",
+			"meta":   "userinfo",
+			"uiprop": "rights",
+			"format": "json",
+		}).
+		Get(w.baseURL)
+
+	if err != nil || resp.StatusCode() != 200 {
+		return false
+	}
+
+	for _, r := range gjson.Get(string(resp.Body()), "query.userinfo.rights").Array() {
+		if r.String() == right {
+			return true
+		}
+	}
+	return false
+}
+
+// QueryLimit returns the largest list size a single request may ask for: 500,
+// or 5000 for sessions with the apihighlimits right
+func (w *WikipediaClient) QueryLimit() int {
+	return w.queryLimit()
+}
+
+// queryLimit returns the largest list size a single request may ask for
+func (w *WikipediaClient) queryLimit() int {
+	if w.highLimits {
+		return highQueryLimit
+	}
+	return defaultQueryLimit
+}
+
+// SetRawDumpDir writes every raw API response body into dir, one timestamped
+// file per call, for later inspection. The directory is created if needed.
+func (w *WikipediaClient) SetRawDumpDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("unable to create dump directory: %w", err)
+	}
+
+	w.client.OnAfterResponse(func(c *resty.Client, resp *resty.Response) error {
+		endpoint := "rest"
+		if resp.Request != nil {
+			query := resp.Request.QueryParam
+			for _, key := range []string{"list", "prop", "meta", "action"} {
+				if value := query.Get(key); value != "" {
+					endpoint = strings.ReplaceAll(value, "|", "+")
+					break
+				}
+			}
+		}
+
+		sequence := atomic.AddInt64(&w.dumpCount, 1)
+		filename := fmt.Sprintf("%s_%s_%04d_%s.json",
+			time.Now().Format("20060102T150405.000"), w.language, sequence, endpoint)
+
+		// Dumping is a debugging aid: never fail the request because of it
+		_ = os.WriteFile(filepath.Join(dir, filename), resp.Body(), 0644)
+		return nil
+	})
+
+	return nil
+}
+
 // Language returns the configured language of the client
 func (w *WikipediaClient) Language() string {
 	return w.language
@@ -301,48 +698,75 @@ func (w *WikipediaClient) GetPageInfo(title string) (*models.WikiPageInfo, error
 		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
 	}
 
-	// Parse with gjson for fast extraction
-	body := string(resp.Body())
-	pages := gjson.Get(body, "query.pages")
+	return parsePageInfo(string(resp.Body()), title)
+}
 
+// parsePageInfo extracts the page requested as title from a prop=info response.
+// The API normalizes titles (first letter case, underscores) and reports it in
+// query.normalized: the page is matched on the canonical title, and the input
+// kept in NormalizedFrom when it differed.
+func parsePageInfo(body string, title string) (*models.WikiPageInfo, error) {
+	pages := gjson.Get(body, "query.pages")
 	if !pages.Exists() {
 		return nil, fmt.Errorf("page not found: %s", title)
 	}
 
-	// Get the first (and only) page from the response
-	var pageInfo models.WikiPageInfo
-	pages.ForEach(func(key, value gjson.Result) bool {
-		// Check if page exists
-		if gjson.Get(value.String(), "missing").Exists() {
-			return false // Page doesn't exist
+	canonicalTitle := title
+	gjson.Get(body, "query.normalized").ForEach(func(_, normalization gjson.Result) bool {
+		if normalization.Get("from").String() == canonicalTitle {
+			canonicalTitle = normalization.Get("to").String()
 		}
+		return true
+	})
 
-		pageInfo = models.WikiPageInfo{
-			PageID:    int(gjson.Get(value.String(), "pageid").Int()),
-			NS:        int(gjson.Get(value.String(), "ns").Int()),
-			Title:     gjson.Get(value.String(), "title").String(),
-			Touched:   gjson.Get(value.String(), "touched").String(),
-			LastRevID: int(gjson.Get(value.String(), "lastrevid").Int()),
-			Length:    int(gjson.Get(value.String(), "length").Int()),
+	var page gjson.Result
+	pages.ForEach(func(_, value gjson.Result) bool {
+		if value.Get("title").String() == canonicalTitle {
+			page = value
+			return false
 		}
-		return false // Break after first iteration
+		return true
 	})
+	// Single-title responses without a matching title (unexpected normalization)
+	if !page.Exists() && len(pages.Map()) == 1 {
+		pages.ForEach(func(_, value gjson.Result) bool {
+			page = value
+			return false
+		})
+	}
 
-	if pageInfo.PageID == 0 {
+	if !page.Exists() || page.Get("missing").Exists() || page.Get("pageid").Int() == 0 {
 		return nil, fmt.Errorf("page not found: %s", title)
 	}
 
-	return &pageInfo, nil
+	pageInfo := &models.WikiPageInfo{
+		PageID:    int(page.Get("pageid").Int()),
+		NS:        int(page.Get("ns").Int()),
+		Title:     page.Get("title").String(),
+		Touched:   page.Get("touched").String(),
+		LastRevID: int(page.Get("lastrevid").Int()),
+		Length:    int(page.Get("length").Int()),
+	}
+	if pageInfo.Title != title {
+		pageInfo.NormalizedFrom = title
+	}
+
+	return pageInfo, nil
 }
 
-// GetPageRevisions retrieves recent page revisions
-func (w *WikipediaClient) GetPageRevisions(title string, limit int) ([]models.WikiRevision, error) {
+// maxMoveLookups bounds the former titles checked for moves by GetPageMoves
+const maxMoveLookups = 20
+
+// GetPageMoves retrieves the moves that renamed other titles to this one. The move
+// log is indexed by the title before the move, so the redirects left behind at the
+// former titles are looked up first.
+func (w *WikipediaClient) GetPageMoves(title string) ([]models.WikiMoveEvent, error) {
 	params := map[string]string{
 		"action":  "query",
 		"titles":  title,
-		"prop":    "revisions",
-		"rvlimit": fmt.Sprintf("%d", limit),
-		"rvprop":  "ids|timestamp|user|userid|size|comment|flags",
+		"prop":    "redirects",
+		"rdprop":  "title",
+		"rdlimit": "max",
 		"format":  "json",
 	}
 
@@ -358,13 +782,228 @@ func (w *WikipediaClient) GetPageRevisions(title string, limit int) ([]models.Wi
 		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
 	}
 
-	body := string(resp.Body())
-	pages := gjson.Get(body, "query.pages")
+	var redirects []string
+	gjson.Get(string(resp.Body()), "query.pages").ForEach(func(key, value gjson.Result) bool {
+		for _, redirect := range value.Get("redirects").Array() {
+			redirects = append(redirects, redirect.Get("title").String())
+		}
+		return false // Single page
+	})
 
-	if !pages.Exists() {
-		return []models.WikiRevision{}, nil
+	if len(redirects) > maxMoveLookups {
+		redirects = redirects[:maxMoveLookups]
+	}
+
+	moves := []models.WikiMoveEvent{}
+	for _, redirect := range redirects {
+		resp, err := w.client.R().
+			SetQueryParams(map[string]string{
+				"action":  "query",
+				"list":    "logevents",
+				"letype":  "move",
+				"letitle": redirect,
+				"leprop":  "title|user|timestamp|comment|details",
+				"lelimit": "50",
+				"format":  "json",
+			}).
+			Get(w.baseURL)
+
+		if err != nil {
+			return nil, fmt.Errorf("API request error: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+		}
+
+		for _, event := range gjson.Get(string(resp.Body()), "query.logevents").Array() {
+			// Redirects may also come from pages that were moved elsewhere first
+			if event.Get("params.target_title").String() != title {
+				continue
+			}
+			moves = append(moves, models.WikiMoveEvent{
+				Title:       event.Get("title").String(),
+				TargetTitle: event.Get("params.target_title").String(),
+				User:        event.Get("user").String(),
+				Timestamp:   event.Get("timestamp").String(),
+				Comment:     event.Get("comment").String(),
+			})
+		}
+	}
+
+	return moves, nil
+}
+
+// GetProtectionLog retrieves the protection log of a page, newest entry first
+func (w *WikipediaClient) GetProtectionLog(title string) ([]models.WikiProtectionEvent, error) {
+	params := map[string]string{
+		"action":  "query",
+		"list":    "logevents",
+		"letype":  "protect",
+		"letitle": title,
+		"leprop":  "type|user|timestamp|comment|details",
+		"lelimit": "100",
+		"format":  "json",
+	}
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	events := []models.WikiProtectionEvent{}
+	for _, entry := range gjson.Get(string(resp.Body()), "query.logevents").Array() {
+		event := models.WikiProtectionEvent{
+			Action:    entry.Get("action").String(),
+			User:      entry.Get("user").String(),
+			Timestamp: entry.Get("timestamp").String(),
+			Comment:   entry.Get("comment").String(),
+		}
+		for _, detail := range entry.Get("params.details").Array() {
+			event.Details = append(event.Details, models.PageProtection{
+				Type:   detail.Get("type").String(),
+				Level:  detail.Get("level").String(),
+				Expiry: detail.Get("expiry").String(),
+			})
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// GetPageRevisions retrieves recent page revisions. Limits above what the API
+// allows per request are split into several requests following the continuation.
+func (w *WikipediaClient) GetPageRevisions(title string, limit int) ([]models.WikiRevision, error) {
+	params := map[string]string{
+		"action": "query",
+		"titles": title,
+		"prop":   "revisions",
+		"rvprop": "ids|timestamp|user|userid|size|sha1|comment|flags|tags",
+		"format": "json",
+	}
+	w.applyCapabilities(params)
+
+	return w.queryPageRevisions(params, limit)
+}
+
+// GetPageRevisionsSince retrieves every revision of a page newer than
+// revisionID, oldest first, following the continuation however many there are
+func (w *WikipediaClient) GetPageRevisionsSince(title string, revisionID int) ([]models.WikiRevision, error) {
+	params := map[string]string{
+		"action":    "query",
+		"titles":    title,
+		"prop":      "revisions",
+		"rvprop":    "ids|timestamp|user|userid|size|sha1|comment|flags|tags",
+		"rvdir":     "newer",
+		"rvstartid": fmt.Sprintf("%d", revisionID),
+		"format":    "json",
+	}
+	w.applyCapabilities(params)
+
+	revisions, err := w.queryPageRevisions(params, math.MaxInt)
+	if err != nil {
+		return nil, err
+	}
+
+	// The listing starts at revisionID itself
+	newer := revisions[:0]
+	for _, revision := range revisions {
+		if revision.RevID != revisionID {
+			newer = append(newer, revision)
+		}
+	}
+	return newer, nil
+}
+
+// queryPageRevisions sends a revisions query, following the continuation until
+// limit revisions are read or the listing ends
+func (w *WikipediaClient) queryPageRevisions(params map[string]string, limit int) ([]models.WikiRevision, error) {
+	revisions := []models.WikiRevision{}
+	batchLimit := w.queryLimit()
+	retried := false
+
+	for len(revisions) < limit {
+		params["rvlimit"] = fmt.Sprintf("%d", min(limit-len(revisions), batchLimit))
+
+		resp, err := w.client.R().
+			SetQueryParams(params).
+			Get(w.baseURL)
+
+		if err != nil {
+			return nil, fmt.Errorf("API request error: %w", err)
+		}
+
+		if resp.StatusCode() != 200 {
+			return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+		}
+
+		body := string(resp.Body())
+
+		// The session may not have the limits we assumed: retry once at the anonymous cap
+		if apiError := gjson.Get(body, "error"); apiError.Exists() {
+			if !retried && batchLimit > defaultQueryLimit {
+				batchLimit = defaultQueryLimit
+				retried = true
+				continue
+			}
+			return nil, fmt.Errorf("API error (%s): %s", apiError.Get("code").String(), apiError.Get("info").String())
+		}
+
+		pages := gjson.Get(body, "query.pages")
+		if !pages.Exists() {
+			break
+		}
+
+		batch := parsePageRevisions(pages)
+		if len(batch) == 0 {
+			break
+		}
+		revisions = append(revisions, batch...)
+
+		rvcontinue := gjson.Get(body, "continue.rvcontinue").String()
+		if rvcontinue == "" {
+			break
+		}
+		params["rvcontinue"] = rvcontinue
+	}
+
+	if len(revisions) > limit {
+		revisions = revisions[:limit]
 	}
 
+	return revisions, nil
+}
+
+// revisionSize returns the size of a revision's main slot when the request asked
+// for slot sizes (rvslots=main, rvprop=slotsize), or else of the whole revision.
+// On multi-slot wikis (structured data on Commons) the two differ.
+func revisionSize(rev gjson.Result) int {
+	if size := rev.Get("slots.main.size"); size.Exists() {
+		return int(size.Int())
+	}
+	return int(rev.Get("size").Int())
+}
+
+// revisionContent returns the content of a revision's main slot: under
+// slots.main with rvslots=main, or in the revision itself from wikis predating
+// slots
+func revisionContent(rev gjson.Result) gjson.Result {
+	if content := rev.Get(`slots.main.\*`); content.Exists() {
+		return content
+	}
+	return rev.Get(`\*`)
+}
+
+// parsePageRevisions reads the revisions of the first page of a query.pages result
+func parsePageRevisions(pages gjson.Result) []models.WikiRevision {
 	var revisions []models.WikiRevision
 	pages.ForEach(func(key, value gjson.Result) bool {
 		// Check if page exists
@@ -380,11 +1019,13 @@ func (w *WikipediaClient) GetPageRevisions(title string, limit int) ([]models.Wi
 				ParentID:  int(gjson.Get(rev.String(), "parentid").Int()),
 				User:      gjson.Get(rev.String(), "user").String(),
 				Timestamp: gjson.Get(rev.String(), "timestamp").String(),
-				Size:      int(gjson.Get(rev.String(), "size").Int()),
+				Size:      revisionSize(rev),
+				SHA1:      gjson.Get(rev.String(), "sha1").String(),
 				Comment:   gjson.Get(rev.String(), "comment").String(),
 			}
 
 			// Optional fields
+			applyHiddenMarkers(rev, &revision)
 			if gjson.Get(rev.String(), "userid").Exists() {
 				revision.UserID = int(gjson.Get(rev.String(), "userid").Int())
 			}
@@ -394,13 +1035,16 @@ func (w *WikipediaClient) GetPageRevisions(title string, limit int) ([]models.Wi
 			if gjson.Get(rev.String(), "anon").Exists() {
 				revision.Anon = "true"
 			}
+			for _, tag := range gjson.Get(rev.String(), "tags").Array() {
+				revision.Tags = append(revision.Tags, tag.String())
+			}
 
 			revisions = append(revisions, revision)
 		}
 		return false // Break after first page
 	})
 
-	return revisions, nil
+	return revisions
 }
 
 // GetRevisionInfo retrieves detailed information about a specific revision
@@ -413,7 +1057,7 @@ func (w *WikipediaClient) GetRevisionInfo(revisionID int, pageTitle string) (*mo
 			"action":  "query",
 			"revids":  fmt.Sprintf("%d", revisionID),
 			"prop":    "revisions",
-			"rvprop":  "ids|timestamp|user|userid|size|comment|flags",
+			"rvprop":  "ids|timestamp|user|userid|size|comment|flags|tags",
 			"format":  "json",
 		}
 	} else if pageTitle != "" {
@@ -423,12 +1067,13 @@ func (w *WikipediaClient) GetRevisionInfo(revisionID int, pageTitle string) (*mo
 			"titles":  pageTitle,
 			"prop":    "revisions",
 			"rvlimit": "1",
-			"rvprop":  "ids|timestamp|user|userid|size|comment|flags",
+			"rvprop":  "ids|timestamp|user|userid|size|comment|flags|tags",
 			"format":  "json",
 		}
 	} else {
 		return nil, fmt.Errorf("either revision ID or page title must be provided")
 	}
+	w.applyCapabilities(params)
 
 	resp, err := w.client.R().
 		SetQueryParams(params).
@@ -465,11 +1110,12 @@ func (w *WikipediaClient) GetRevisionInfo(revisionID int, pageTitle string) (*mo
 				ParentID:  int(gjson.Get(rev.String(), "parentid").Int()),
 				User:      gjson.Get(rev.String(), "user").String(),
 				Timestamp: gjson.Get(rev.String(), "timestamp").String(),
-				Size:      int(gjson.Get(rev.String(), "size").Int()),
+				Size:      revisionSize(rev),
 				Comment:   gjson.Get(rev.String(), "comment").String(),
 			}
 
 			// Optional fields
+			applyHiddenMarkers(rev, revision)
 			if gjson.Get(rev.String(), "userid").Exists() {
 				revision.UserID = int(gjson.Get(rev.String(), "userid").Int())
 			}
@@ -479,6 +1125,9 @@ func (w *WikipediaClient) GetRevisionInfo(revisionID int, pageTitle string) (*mo
 			if gjson.Get(rev.String(), "anon").Exists() {
 				revision.Anon = "true"
 			}
+			for _, tag := range gjson.Get(rev.String(), "tags").Array() {
+				revision.Tags = append(revision.Tags, tag.String())
+			}
 		}
 		return false // Break after first page
 	})
@@ -490,6 +1139,43 @@ func (w *WikipediaClient) GetRevisionInfo(revisionID int, pageTitle string) (*mo
 	return revision, nil
 }
 
+// GetRevisionPageTitle retrieves the title of the page a revision belongs to,
+// for revision links that carry no title (?oldid=N, Special:Diff/N)
+func (w *WikipediaClient) GetRevisionPageTitle(revisionID int) (string, error) {
+	params := map[string]string{
+		"action": "query",
+		"revids": fmt.Sprintf("%d", revisionID),
+		"prop":   "revisions",
+		"rvprop": "ids",
+		"format": "json",
+	}
+	w.applyCapabilities(params)
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil {
+		return "", fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return "", fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	var title string
+	gjson.Get(string(resp.Body()), "query.pages").ForEach(func(key, value gjson.Result) bool {
+		title = gjson.Get(value.String(), "title").String()
+		return title == ""
+	})
+
+	if title == "" {
+		return "", fmt.Errorf("revision %d not found", revisionID)
+	}
+
+	return title, nil
+}
+
 // GetRevisionDiff retrieves the diff between two revisions
 func (w *WikipediaClient) GetRevisionDiff(fromRevision, toRevision int) (string, error) {
 	params := map[string]string{
@@ -520,92 +1206,142 @@ func (w *WikipediaClient) GetRevisionDiff(fromRevision, toRevision int) (string,
 
 // GetPageCategories retrieves categories for a page
 func (w *WikipediaClient) GetPageCategories(title string) ([]string, error) {
-	params := map[string]string{
-		"action":  "query",
-		"titles":  title,
-		"prop":    "categories",
-		"cllimit": "500",
-		"format":  "json",
+	categories, err := w.GetPagesCategories([]string{title})
+	if err != nil {
+		return nil, err
 	}
+	for _, pageCategories := range categories {
+		return pageCategories, nil
+	}
+	return []string{}, nil
+}
 
-	resp, err := w.client.R().
-		SetQueryParams(params).
-		Get(w.baseURL)
-
-	if err != nil {
-		return nil, fmt.Errorf("API request error: %w", err)
+// GetPagesCategories retrieves the categories of several pages in one request
+// (maximum 50 titles), following the continuation when they do not fit in one
+// response. Missing pages are omitted from the result.
+func (w *WikipediaClient) GetPagesCategories(titles []string) (map[string][]string, error) {
+	categories := make(map[string][]string)
+	if len(titles) == 0 {
+		return categories, nil
 	}
 
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	params := map[string]string{
+		"action":  "query",
+		"titles":  strings.Join(titles, "|"),
+		"prop":    "categories",
+		"cllimit": "max",
+		"format":  "json",
 	}
 
-	body := string(resp.Body())
-	pages := gjson.Get(body, "query.pages")
+	for {
+		resp, err := w.client.R().
+			SetQueryParams(params).
+			Get(w.baseURL)
 
-	if !pages.Exists() {
-		return []string{}, nil
-	}
+		if err != nil {
+			return nil, fmt.Errorf("API request error: %w", err)
+		}
 
-	var categories []string
-	pages.ForEach(func(key, value gjson.Result) bool {
-		// Check if page exists
-		if gjson.Get(value.String(), "missing").Exists() {
-			return false
+		if resp.StatusCode() != 200 {
+			return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
 		}
 
-		// Get categories array
-		categoriesArray := gjson.Get(value.String(), "categories")
-		for _, cat := range categoriesArray.Array() {
-			title := gjson.Get(cat.String(), "title").String()
-			// Remove "Category:" prefix
-			if len(title) > 9 && title[:9] == "Category:" {
-				title = title[9:]
+		body := string(resp.Body())
+		gjson.Get(body, "query.pages").ForEach(func(key, value gjson.Result) bool {
+			if value.Get("missing").Exists() {
+				return true
+			}
+
+			title := value.Get("title").String()
+			if _, exists := categories[title]; !exists {
+				categories[title] = []string{}
 			}
-			categories = append(categories, title)
+			for _, cat := range value.Get("categories").Array() {
+				category := cat.Get("title").String()
+				// Remove "Category:" prefix
+				if len(category) > 9 && category[:9] == "Category:" {
+					category = category[9:]
+				}
+				categories[title] = append(categories[title], category)
+			}
+			return true
+		})
+
+		clcontinue := gjson.Get(body, "continue.clcontinue").String()
+		if clcontinue == "" {
+			break
 		}
-		return false // Break after first page
-	})
+		params["clcontinue"] = clcontinue
+	}
 
 	return categories, nil
 }
 
-// GetPageContributors retrieves top contributors to a page
-func (w *WikipediaClient) GetPageContributors(title string, limit int) ([]models.WikiContributor, error) {
-	// First get the page ID
-	pageInfo, err := w.GetPageInfo(title)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get page info: %w", err)
+// GetPageContributors retrieves top contributors to a page, following
+// continuation until limit contributors are collected. A pageID of 0 is
+// resolved from the title.
+func (w *WikipediaClient) GetPageContributors(title string, pageID, limit int) ([]models.WikiContributor, error) {
+	if pageID == 0 {
+		pageInfo, err := w.GetPageInfo(title)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get page info: %w", err)
+		}
+		pageID = pageInfo.PageID
 	}
 
 	params := map[string]string{
 		"action":         "query",
-		"pageids":        fmt.Sprintf("%d", pageInfo.PageID),
+		"pageids":        fmt.Sprintf("%d", pageID),
 		"prop":           "contributors",
-		"pclimit":        fmt.Sprintf("%d", limit),
 		"pcexcludegroup": "bot",
 		"format":         "json",
 	}
 
-	resp, err := w.client.R().
-		SetQueryParams(params).
-		Get(w.baseURL)
+	contributors := []models.WikiContributor{}
 
-	if err != nil {
-		return nil, fmt.Errorf("API request error: %w", err)
-	}
+	for len(contributors) < limit {
+		params["pclimit"] = fmt.Sprintf("%d", min(limit-len(contributors), w.queryLimit()))
 
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
-	}
+		resp, err := w.client.R().
+			SetQueryParams(params).
+			Get(w.baseURL)
 
-	body := string(resp.Body())
-	pages := gjson.Get(body, "query.pages")
+		if err != nil {
+			return nil, fmt.Errorf("API request error: %w", err)
+		}
 
-	if !pages.Exists() {
-		return []models.WikiContributor{}, nil
+		if resp.StatusCode() != 200 {
+			return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+		}
+
+		body := string(resp.Body())
+		pages := gjson.Get(body, "query.pages")
+		if !pages.Exists() {
+			break
+		}
+
+		batch := parsePageContributors(pages)
+		if len(batch) == 0 {
+			break
+		}
+		contributors = append(contributors, batch...)
+
+		pccontinue := gjson.Get(body, "continue.pccontinue").String()
+		if pccontinue == "" {
+			break
+		}
+		params["pccontinue"] = pccontinue
+	}
+
+	if len(contributors) > limit {
+		contributors = contributors[:limit]
 	}
 
+	return contributors, nil
+}
+
+// parsePageContributors reads the contributors of the first page of a query.pages result
+func parsePageContributors(pages gjson.Result) []models.WikiContributor {
 	var contributors []models.WikiContributor
 	pages.ForEach(func(key, value gjson.Result) bool {
 		// Get contributors array
@@ -628,7 +1364,7 @@ func (w *WikipediaClient) GetPageContributors(title string, limit int) ([]models
 		return false // Break after first page
 	})
 
-	return contributors, nil
+	return contributors
 }
 
 // GetPageHistory retrieves detailed edit history for analysis
@@ -641,11 +1377,12 @@ func (w *WikipediaClient) GetPageHistory(title string, days int) ([]models.WikiR
 		"titles":  title,
 		"prop":    "revisions",
 		"rvlimit": "500", // Maximum allowed
-		"rvprop":  "ids|timestamp|user|userid|size|comment|flags",
+		"rvprop":  "ids|timestamp|user|userid|size|sha1|comment|flags|tags",
 		"rvstart": startDate,
 		"rvdir":   "newer",
 		"format":  "json",
 	}
+	w.applyCapabilities(params)
 
 	resp, err := w.client.R().
 		SetQueryParams(params).
@@ -666,42 +1403,50 @@ func (w *WikipediaClient) GetPageHistory(title string, days int) ([]models.WikiR
 		return []models.WikiRevision{}, nil
 	}
 
-	var revisions []models.WikiRevision
-	pages.ForEach(func(key, value gjson.Result) bool {
-		// Check if page exists
-		if gjson.Get(value.String(), "missing").Exists() {
-			return false
-		}
+	return parsePageRevisions(pages), nil
+}
 
-		// Get revisions array
-		revisionsArray := gjson.Get(value.String(), "revisions")
-		for _, rev := range revisionsArray.Array() {
-			revision := models.WikiRevision{
-				RevID:     int(gjson.Get(rev.String(), "revid").Int()),
-				ParentID:  int(gjson.Get(rev.String(), "parentid").Int()),
-				User:      gjson.Get(rev.String(), "user").String(),
-				Timestamp: gjson.Get(rev.String(), "timestamp").String(),
-				Size:      int(gjson.Get(rev.String(), "size").Int()),
-				Comment:   gjson.Get(rev.String(), "comment").String(),
-			}
+// GetPageViews retrieves daily user pageviews of a page for the last days from the Wikimedia REST API
+func (w *WikipediaClient) GetPageViews(title string, days int) ([]models.DailyPageViews, error) {
+	end := time.Now().UTC().AddDate(0, 0, -1) // Today's data is not complete yet
+	start := end.AddDate(0, 0, -(days - 1))
 
-			// Optional fields
-			if gjson.Get(rev.String(), "userid").Exists() {
-				revision.UserID = int(gjson.Get(rev.String(), "userid").Int())
-			}
-			if gjson.Get(rev.String(), "minor").Exists() {
-				revision.Minor = "true"
-			}
-			if gjson.Get(rev.String(), "anon").Exists() {
-				revision.Anon = "true"
-			}
+	article := url.PathEscape(strings.ReplaceAll(title, " ", "_"))
+	requestURL := fmt.Sprintf("%s/%s.wikipedia/all-access/user/%s/daily/%s00/%s00",
+		pageviewsBaseURL, w.language, article, start.Format("20060102"), end.Format("20060102"))
 
-			revisions = append(revisions, revision)
+	resp, err := w.client.R().Get(requestURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() == 404 {
+		// No pageview data recorded for this article in the period
+		return []models.DailyPageViews{}, nil
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	body := string(resp.Body())
+	items := gjson.Get(body, "items")
+
+	var views []models.DailyPageViews
+	for _, item := range items.Array() {
+		date, err := time.Parse("2006010215", gjson.Get(item.String(), "timestamp").String())
+		if err != nil {
+			continue
 		}
-		return false // Break after first page
-	})
 
-	return revisions, nil
+		views = append(views, models.DailyPageViews{
+			Date:  date,
+			Views: int(gjson.Get(item.String(), "views").Int()),
+		})
+	}
+
+	return views, nil
 }
 
 // GetPageWikitext retrieves the raw wikitext content of a page
@@ -713,6 +1458,7 @@ func (w *WikipediaClient) GetPageWikitext(title string) (string, error) {
 		"rvprop": "content",
 		"format": "json",
 	}
+	w.applyCapabilities(params)
 
 	resp, err := w.client.R().
 		SetQueryParams(params).
@@ -742,7 +1488,7 @@ func (w *WikipediaClient) GetPageWikitext(title string) (string, error) {
 		revisionsArray := gjson.Get(value.String(), "revisions")
 		if len(revisionsArray.Array()) > 0 {
 			rev := revisionsArray.Array()[0]
-			wikitext = gjson.Get(rev.String(), "*").String()
+			wikitext = revisionContent(rev).String()
 		}
 		return false
 	})
@@ -753,3 +1499,118 @@ func (w *WikipediaClient) GetPageWikitext(title string) (string, error) {
 
 	return wikitext, nil
 }
+
+// GetRevisionsContent retrieves the raw wikitext of several revisions in one request
+// (maximum 50 revision IDs). Revisions whose content is hidden or missing are omitted.
+func (w *WikipediaClient) GetRevisionsContent(revisionIDs []int) (map[int]string, error) {
+	contents := make(map[int]string)
+	if len(revisionIDs) == 0 {
+		return contents, nil
+	}
+
+	ids := make([]string, 0, len(revisionIDs))
+	for _, id := range revisionIDs {
+		ids = append(ids, fmt.Sprintf("%d", id))
+	}
+
+	params := map[string]string{
+		"action": "query",
+		"revids": strings.Join(ids, "|"),
+		"prop":   "revisions",
+		"rvprop": "ids|content",
+		"format": "json",
+	}
+	w.applyCapabilities(params)
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	body := string(resp.Body())
+	pages := gjson.Get(body, "query.pages")
+
+	pages.ForEach(func(key, value gjson.Result) bool {
+		for _, rev := range gjson.Get(value.String(), "revisions").Array() {
+			content := revisionContent(rev)
+			if !content.Exists() {
+				continue
+			}
+			contents[int(gjson.Get(rev.String(), "revid").Int())] = content.String()
+		}
+		return true
+	})
+
+	return contents, nil
+}
+
+// GetUserDeletedContributions retrieves the deleted contributions of a user. It
+// requires the deletedhistory right (administrators): ErrPermissionDenied is
+// returned for anonymous or unprivileged sessions.
+func (w *WikipediaClient) GetUserDeletedContributions(username string, limit int) ([]models.WikiContribution, error) {
+	params := map[string]string{
+		"action":   "query",
+		"list":     "alldeletedrevisions",
+		"adruser":  username,
+		"adrlimit": fmt.Sprintf("%d", limit),
+		"adrprop":  "ids|timestamp|comment|size|flags|tags",
+		"format":   "json",
+	}
+	w.applyCapabilities(params)
+
+	resp, err := w.client.R().
+		SetQueryParams(params).
+		Get(w.baseURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("API request error: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("non-200 API response: %d", resp.StatusCode())
+	}
+
+	body := string(resp.Body())
+	if code := gjson.Get(body, "error.code").String(); code != "" {
+		if strings.HasPrefix(code, "permissiondenied") {
+			return nil, ErrPermissionDenied
+		}
+		return nil, fmt.Errorf("API error: %s", gjson.Get(body, "error.info").String())
+	}
+
+	var contributions []models.WikiContribution
+	for _, page := range gjson.Get(body, "query.alldeletedrevisions").Array() {
+		for _, rev := range page.Get("revisions").Array() {
+			contribution := models.WikiContribution{
+				User:      username,
+				PageID:    int(page.Get("pageid").Int()),
+				RevID:     int(rev.Get("revid").Int()),
+				ParentID:  int(rev.Get("parentid").Int()),
+				NS:        int(page.Get("ns").Int()),
+				Title:     page.Get("title").String(),
+				Timestamp: rev.Get("timestamp").String(),
+				Comment:   rev.Get("comment").String(),
+				Size:      int(rev.Get("size").Int()),
+				Deleted:   true,
+			}
+
+			if rev.Get("minor").Exists() {
+				contribution.Minor = "true"
+			}
+			for _, tag := range rev.Get("tags").Array() {
+				contribution.Tags = append(contribution.Tags, tag.String())
+			}
+
+			contributions = append(contributions, contribution)
+		}
+	}
+
+	return contributions, nil
+}
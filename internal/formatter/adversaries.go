@@ -0,0 +1,101 @@
+// internal/formatter/adversaries.go
+package formatter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// FormatAdversaryReport formats a user's adversary ranking according to the specified format
+func FormatAdversaryReport(report *models.AdversaryReport, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		data, err := marshalJSON(report)
+		if err != nil {
+			return "", fmt.Errorf("JSON formatting error: %w", err)
+		}
+		return string(data), nil
+	case "yaml", "yml":
+		data, err := marshalYAML(report)
+		if err != nil {
+			return "", fmt.Errorf("YAML formatting error: %w", err)
+		}
+		return string(data), nil
+	case "table", "":
+		return formatAdversariesAsTable(report), nil
+	case "plain":
+		return plainText(formatAdversariesAsTable(report)), nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
+	}
+}
+
+// formatAdversariesAsTable formats the adversary ranking as a readable table
+func formatAdversariesAsTable(report *models.AdversaryReport) string {
+	var output strings.Builder
+
+	output.WriteString(boxHeader("⚔️ USER ADVERSARIES: ", report.Username, 32))
+
+	output.WriteString(headerColor.Sprint("📊 " + tr("SUMMARY") + "\n"))
+	output.WriteString(separator(50) + "\n")
+	output.WriteString("🔬 " + label("Analyzed Sample:", 20) + pluralf(report.SampleSize, "%d most recent contribution", "%d most recent contributions") + "\n")
+	output.WriteString("🚫 " + label("Total Revoked:", 20) + strconv.Itoa(report.RevokedCount) + "\n")
+	output.WriteString("👥 " + label("Adversaries:", 20) + strconv.Itoa(len(report.Adversaries)) + "\n")
+	reciprocalText := strconv.Itoa(report.ReciprocalCount)
+	if report.ReciprocalCount > 0 {
+		reciprocalText = dangerColor.Sprint(reciprocalText)
+	}
+	output.WriteString("⇄  " + label("Reciprocal:", 20) + reciprocalText + secondaryColor.Sprint(" (reverted back by the user)") + "\n\n")
+
+	if len(report.Adversaries) == 0 {
+		output.WriteString(successColor.Sprint("✅ No user reverted this user's analyzed contributions\n"))
+		return output.String()
+	}
+
+	output.WriteString(headerColor.Sprint("⚔️ " + tr("RANKED ADVERSARIES") + "\n"))
+	output.WriteString(separator(90) + "\n")
+	output.WriteString(fmt.Sprintf("%-4s %-*s %-8s %-7s %-6s %-12s %s\n",
+		"#", scaleWidth(25), "User", "Reverts", "Share", "Back", "Last", "Pages"))
+	output.WriteString(separator(90) + "\n")
+
+	for i, adversary := range report.Adversaries {
+		back := strconv.Itoa(adversary.RevertedBack)
+		if adversary.Reciprocal {
+			back = dangerColor.Sprintf("%-6s", back)
+		} else {
+			back = fmt.Sprintf("%-6s", back)
+		}
+
+		output.WriteString(fmt.Sprintf("%-4d %-*s %-8d %-7s %s %-12s %s\n",
+			i+1,
+			scaleWidth(25), truncateString(adversary.Username, scaleWidth(25)),
+			adversary.RevertCount,
+			fmt.Sprintf("%.0f%%", adversary.RevertRatio*100),
+			back,
+			formatTimestamp(adversary.LastRevert, "2006-01-02"),
+			truncateString(strings.Join(adversary.Pages, ", "), scaleWidth(40))))
+	}
+	output.WriteString("\n")
+
+	// Reciprocal relationships point at a feud, or at a patroller and the
+	// account it keeps cleaning up after
+	if report.ReciprocalCount > 0 {
+		output.WriteString(warningColor.Sprint("⚠️  " + tr("RECIPROCAL REVERTS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		for _, adversary := range report.Adversaries {
+			if !adversary.Reciprocal {
+				continue
+			}
+			output.WriteString(fmt.Sprintf("   • %s ⇄ %s: %s, %s back\n",
+				report.Username, adversary.Username,
+				pluralf(adversary.RevertCount, "%d revert", "%d reverts"),
+				strconv.Itoa(adversary.RevertedBack)))
+		}
+		output.WriteString("\n")
+	}
+
+	return output.String()
+}
@@ -0,0 +1,93 @@
+// internal/formatter/calendar.go
+package formatter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// FormatActivityCalendar serializes a daily activity calendar (csv or ics). CSV
+// has one date,count row per day, the input of GitHub-style heatmaps. iCalendar
+// has one all-day event per busy period, a run of consecutive active days.
+func FormatActivityCalendar(username string, days []models.DailyActivity, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "csv", "":
+		var buffer strings.Builder
+		writer := csv.NewWriter(&buffer)
+		writer.Write([]string{"date", "count"})
+		for _, day := range days {
+			writer.Write([]string{day.Date.Format("2006-01-02"), strconv.Itoa(day.EditCount)})
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return "", fmt.Errorf("CSV formatting error: %w", err)
+		}
+		return buffer.String(), nil
+	case "ics":
+		return formatActivityICal(username, days, time.Now()), nil
+	default:
+		return "", fmt.Errorf("unsupported calendar format: %s (supported: csv, ics)", format)
+	}
+}
+
+// formatActivityICal renders the busy periods of a calendar as iCalendar all-day
+// events (RFC 5545: CRLF line endings, exclusive end dates)
+func formatActivityICal(username string, days []models.DailyActivity, stamp time.Time) string {
+	var output strings.Builder
+	line := func(text string) {
+		output.WriteString(text + "\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//wikiosint//activity calendar//EN")
+	line("CALSCALE:GREGORIAN")
+
+	for start := 0; start < len(days); {
+		if days[start].EditCount == 0 {
+			start++
+			continue
+		}
+		end, edits := start, 0
+		for end < len(days) && days[end].EditCount > 0 {
+			edits += days[end].EditCount
+			end++
+		}
+
+		first, last := days[start].Date, days[end-1].Date
+		line("BEGIN:VEVENT")
+		line(fmt.Sprintf("UID:%s-%s@wikiosint", icalUID(username), first.Format("20060102")))
+		line("DTSTAMP:" + stamp.UTC().Format("20060102T150405Z"))
+		line("DTSTART;VALUE=DATE:" + first.Format("20060102"))
+		line("DTEND;VALUE=DATE:" + last.AddDate(0, 0, 1).Format("20060102"))
+		line("SUMMARY:" + icalText(fmt.Sprintf("%s: %s", username, pluralf(edits, "%d edit", "%d edits"))))
+		line("TRANSP:OPAQUE")
+		line("END:VEVENT")
+		start = end
+	}
+
+	line("END:VCALENDAR")
+	return output.String()
+}
+
+// icalText escapes the characters iCalendar text values reserve
+func icalText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(text)
+}
+
+// icalUID turns a username into the local part of an event UID, replacing the
+// characters iCalendar text or email-like UIDs reserve
+func icalUID(username string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '.' {
+			return r
+		}
+		return '_'
+	}, username)
+}
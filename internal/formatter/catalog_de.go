@@ -0,0 +1,297 @@
+// internal/formatter/catalog_de.go
+package formatter
+
+// germanMessages is the German catalog of report labels
+var germanMessages = map[string]string{
+	// Report titles
+	"CONTRIBUTION ANALYSIS: Revision ": "BEITRAGSANALYSE: Version ",
+	"DRY RUN: ":                        "PROBELAUF: ",
+	"INVESTIGATION REPORT: Revision ":  "ERMITTLUNGSBERICHT: Version ",
+	"EDIT HISTORY ANALYSIS: ":          "VERSIONSGESCHICHTE: ",
+	"CONFLICT ANALYSIS: ":              "KONFLIKTANALYSE: ",
+	"WIKIPEDIA PAGE ANALYSIS: ":        "WIKIPEDIA-SEITENANALYSE: ",
+	"CROSS-PAGE COORDINATION ANALYSIS": "SEITENÜBERGREIFENDE KOORDINATIONSANALYSE",
+	"USER ADVERSARIES: ":               "GEGNER DES BENUTZERS: ",
+	"USER FOOTPRINT: ":                 "SPUREN DES BENUTZERS: ",
+	"WIKIPEDIA USER PROFILE: ":         "WIKIPEDIA-BENUTZERPROFIL: ",
+
+	// Section headings
+	"ACTIVITY STATISTICS":                 "AKTIVITÄTSSTATISTIK",
+	"ACCOUNT HANDOFFS":                    "KONTOÜBERGABEN",
+	"ADOPTED EDIT SUMMARIES":              "ÜBERNOMMENE ZUSAMMENFASSUNGEN",
+	"ANALYSIS COVERAGE":                   "ANALYSEABDECKUNG",
+	"ANALYSIS OVERVIEW":                   "ANALYSEÜBERSICHT",
+	"ANALYSIS RECOMMENDATIONS":            "ANALYSEEMPFEHLUNGEN",
+	"AUTHOR ANALYSIS":                     "AUTORENANALYSE",
+	"BASIC INFORMATION":                   "GRUNDINFORMATIONEN",
+	"CONFLICT ANALYSIS":                   "KONFLIKTANALYSE",
+	"CONFLICT INVOLVEMENT":                "BETEILIGUNG AN KONFLIKTEN",
+	"CONFLICT MANAGEMENT RECOMMENDATIONS": "EMPFEHLUNGEN ZUR KONFLIKTBEWÄLTIGUNG",
+	"CONFLICT OVERVIEW":                   "KONFLIKTÜBERSICHT",
+	"CONFLICT SEVERITY ASSESSMENT":        "BEWERTUNG DER KONFLIKTSCHWERE",
+	"CONTENT ANALYSIS":                    "INHALTSANALYSE",
+	"CONTEXT ANALYSIS":                    "KONTEXTANALYSE",
+	"CONTRIBUTION INFORMATION":            "BEITRAGSINFORMATIONEN",
+	"CONTRIBUTOR ACTIVITY PATTERNS":       "AKTIVITÄTSMUSTER DER BEITRAGENDEN",
+	"CONTRIBUTORS ACROSS MULTIPLE PAGES":  "BEITRAGENDE AUF MEHREREN SEITEN",
+	"COORDINATION INDICATORS":             "KOORDINATIONSINDIKATOREN",
+	"COORDINATION METRICS":                "KOORDINATIONSMETRIKEN",
+	"DAILY ACTIVITY BREAKDOWN":            "TÄGLICHE AKTIVITÄT",
+	"DETAILED REVISION HISTORY":           "DETAILLIERTE VERSIONSGESCHICHTE",
+	"DELETION DISCUSSION":                 "LÖSCHDISKUSSION",
+	"DETAILED REVOKED CONTRIBUTIONS":      "DETAILS DER ZURÜCKGESETZTEN BEITRÄGE",
+	"DETECTED EDIT WAR PERIODS":           "ERKANNTE EDIT-WARS",
+	"EDIT FREQUENCY":                      "BEARBEITUNGSHÄUFIGKEIT",
+	"EDITING ACTIVITY TIMELINE":           "ZEITLEISTE DER BEARBEITUNGEN",
+	"EXPECTED API REQUESTS":               "ERWARTETE API-ANFRAGEN",
+	"GROUPS AND RIGHTS":                   "GRUPPEN UND RECHTE",
+	"HIGH PRIORITY ACTIONS NEEDED:":       "DRINGENDE MASSNAHMEN ERFORDERLICH:",
+	"INCOMPLETE ANALYSIS":                 "UNVOLLSTÄNDIGE ANALYSE",
+	"LOGGED-OUT EDITING":                  "BEARBEITUNGEN OHNE ANMELDUNG",
+	"MATCHING EDIT CADENCE":               "ÜBEREINSTIMMENDER BEARBEITUNGSRHYTHMUS",
+	"MONITORING RECOMMENDED:":             "BEOBACHTUNG EMPFOHLEN:",
+	"MOST EDITED PAGES":                   "AM HÄUFIGSTEN BEARBEITETE SEITEN",
+	"MUTUAL SUPPORT PATTERNS":             "MUSTER GEGENSEITIGER UNTERSTÜTZUNG",
+	"NAMESPACE DISTRIBUTION":              "VERTEILUNG NACH NAMENSRAUM",
+	"PAGE CONFLICT STATE":                 "KONFLIKTSTATUS DER SEITE",
+	"PAGE INFORMATION":                    "SEITENINFORMATIONEN",
+	"PAGE INVOLVEMENT":                    "BETEILIGUNG JE SEITE",
+	"PAGE OVERVIEW":                       "SEITENÜBERSICHT",
+	"PAGE-BY-PAGE SUMMARY":                "ZUSAMMENFASSUNG JE SEITE",
+	"QUALITY METRICS":                     "QUALITÄTSMETRIKEN",
+	"RECENT ACTIVITY":                     "LETZTE AKTIVITÄT",
+	"RECENT CONTRIBUTIONS (last 5)":       "LETZTE BEITRÄGE (letzte 5)",
+	"RANKED ADVERSARIES":                  "RANGLISTE DER GEGNER",
+	"RECIPROCAL REVERTS":                  "GEGENSEITIGE REVERTS",
+	"RECENT REVERT ANALYSIS":              "ANALYSE DER LETZTEN ZURÜCKSETZUNGEN",
+	"RECENT REVISIONS (last 10)":          "LETZTE VERSIONEN (letzte 10)",
+	"RECOMMENDATIONS":                     "EMPFEHLUNGEN",
+	"REVOKED CONTRIBUTIONS ANALYSIS":      "ANALYSE ZURÜCKGESETZTER BEITRÄGE",
+	"SCORE BREAKDOWN":                     "PUNKTEAUFSCHLÜSSELUNG",
+	"SHARED PAGE FOOTPRINTS":              "GEMEINSAME SEITENSPUREN",
+	"SIGNALS":                             "SIGNALE",
+	"SOURCE RELIABILITY ANALYSIS":         "ANALYSE DER QUELLENZUVERLÄSSIGKEIT",
+	"SUMMARY":                             "ZUSAMMENFASSUNG",
+	"SUSPICION INDICATORS":                "VERDACHTSINDIKATOREN",
+	"SUSPICIOUS CONTRIBUTORS DETECTED":    "VERDÄCHTIGE BEITRAGENDE ERKANNT",
+	"TOP CONTRIBUTORS ANALYSIS":           "ANALYSE DER HAUPTBEITRAGENDEN",
+	"TOPIC CLUSTERS":                      "THEMENGRUPPEN",
+	"USER BLOCKED":                        "BENUTZER GESPERRT",
+	"USERS INVOLVED IN CONFLICTS":         "AN KONFLIKTEN BETEILIGTE BENUTZER",
+
+	// Field labels
+	"Account Age:":                "Kontoalter:",
+	"Activity Level:":             "Aktivitätsniveau:",
+	"Activity Pattern:":           "Aktivitätsmuster:",
+	"Analysis Performed:":         "Analyse erstellt:",
+	"Analysis Timestamp:":         "Analysezeitpunkt:",
+	"Adversaries:":                "Gegner:",
+	"Analyzed Sample:":            "Stichprobe:",
+	"Anonymous Ratio:":            "Anteil anonym:",
+	"Author Suspicion:":           "Autorverdacht:",
+	"Author:":                     "Autor:",
+	"Average Edit Size:":          "Ø Bearbeitungsgröße:",
+	"Bias Score:":                 "Voreingenommenheit:",
+	"Block expires:":              "Sperre endet:",
+	"Blocked by:":                 "Gesperrt von:",
+	"Burst Context:":              "Burst-Kontext:",
+	"Cadence Groups:":             "Rhythmusgruppen:",
+	"Change Type:":                "Änderungsart:",
+	"Characters Added:":           "Zeichen hinzugefügt:",
+	"Characters Removed:":         "Zeichen entfernt:",
+	"Comment:":                    "Kommentar:",
+	"Common Contributors:":        "Gemeinsame Beitragende:",
+	"Conflict Level:":             "Konfliktniveau:",
+	"Conflict Severity:":          "Konfliktschwere:",
+	"Conflict Status:":            "Konfliktstatus:",
+	"Combined Score:":             "Kombinierter Wert:",
+	"Conflicting Users:":          "Beteiligte Benutzer:",
+	"Content Quality:":            "Inhaltsqualität:",
+	"Content Type:":               "Inhaltstyp:",
+	"Contribution:":               "Beitrag:",
+	"Contributor Diversity:":      "Beitragendenvielfalt:",
+	"Controversy Score:":          "Kontroverswert:",
+	"Controversy:":                "Kontroverse:",
+	"Coordinated Reverts:":        "Koordinierte Reverts:",
+	"Coordinated arrival:":        "Koordinierte Ankunft:",
+	"Coordination Score:":         "Koordinationswert:",
+	"Cosmetic Edits:":             "Kosmetische Edits:",
+	"Created:":                    "Erstellt:",
+	"Current Size:":               "Aktuelle Größe:",
+	"Days Active:":                "Aktive Tage:",
+	"Deleted Edits:":              "Gelöschte Edits:",
+	"Edit Count:":                 "Anzahl Bearbeitungen:",
+	"Edit Day:":                   "Bearbeitungstag:",
+	"Edit Hour:":                  "Bearbeitungsstunde:",
+	"Edit Type:":                  "Bearbeitungsart:",
+	"Edit war periods:":           "Edit-Wars:",
+	"Edits/day (average):":        "Edits/Tag (Mittel):",
+	"Editor Turnover:":            "Fluktuation:",
+	"Entity Focus:":               "Fokus auf Entität:",
+	"Explicit Groups:":            "Explizite Gruppen:",
+	"First Protected Edit:":       "Erster geschützter Edit:",
+	"Footprint Clusters:":         "Spurengruppen:",
+	"Groups:":                     "Gruppen:",
+	"Implicit Groups:":            "Implizite Gruppen:",
+	"Language:":                   "Sprache:",
+	"Last 24h:":                   "Letzte 24 Std.:",
+	"Last %d days:":               "Letzte %d Tage:",
+	"Last 30 days:":               "Letzte 30 Tage:",
+	"Last 7 days:":                "Letzte 7 Tage:",
+	"Last day:":                   "Letzter Tag:",
+	"Last Edit:":                  "Letzter Edit:",
+	"Last Modified:":              "Zuletzt geändert:",
+	"Logged-out editing:":         "Abgemeldet:",
+	"Low-Profile Edits:":          "Unauffällige Edits:",
+	"Overall Investigation Risk:": "Gesamtrisiko:",
+	"Page Creations:":             "Angelegte Seiten:",
+	"Longest Gap:":                "Längste Pause:",
+	"Most Active Day:":            "Aktivster Tag:",
+	"Most Active Hour:":           "Aktivste Stunde:",
+	"Mutual Support Pairs:":       "Gegenseitige Unterstützer:",
+	"New Editor Ratio:":           "Anteil Neulinge:",
+	"Overall Quality:":            "Gesamtqualität:",
+	"POV Words Found:":            "Wertende Begriffe:",
+	"Page Controversy:":           "Seitenkontroverse:",
+	"Page ID:":                    "Seiten-ID:",
+	"Page Ownership:":             "Seitenbesitz:",
+	"Page Title:":                 "Seitentitel:",
+	"Page:":                       "Seite:",
+	"Pages Analyzed:":             "Analysierte Seiten:",
+	"Pages edited:":               "Bearbeitete Seiten:",
+	"Peak Hours:":                 "Spitzenzeiten:",
+	"Policy Compliance:":          "Richtlinientreue:",
+	"Policy Violations:":          "Richtlinienverstöße:",
+	"Promotional Terms:":          "Werbebegriffe:",
+	"Protection Expiry:":          "Schutzende:",
+	"Ran:":                        "Durchgeführt:",
+	"Reader Traffic:":             "Leserzugriffe:",
+	"Reason:":                     "Grund:",
+	"Recent Activity:":            "Letzte Aktivität:",
+	"Reciprocal:":                 "Gegenseitig:",
+	"Recent Conflicts:":           "Letzte Konflikte:",
+	"Recent conflicts:":           "Letzte Konflikte:",
+	"Reference Churn:":            "Referenzwechsel:",
+	"Registration Date:":          "Registriert am:",
+	"Registration:":               "Registrierung:",
+	"Related Edits:":              "Verwandte Edits:",
+	"Relative scoring:":           "Relative Bewertung:",
+	"Reliability Score:":          "Zuverlässigkeit:",
+	"Renamed Accounts:":           "Umbenannte Konten:",
+	"Renamed:":                    "Umbenannt:",
+	"Reversion Rate:":             "Revert-Quote:",
+	"Reversions:":                 "Reverts:",
+	"Revert Chain:":               "Revert-Kette:",
+	"Revert Status:":              "Revert-Status:",
+	"Revision ID:":                "Versions-ID:",
+	"Revoked Ratio:":              "Anteil zurückgesetzt:",
+	"Risk Level:":                 "Risikostufe:",
+	"Sections Affected:":          "Betroffene Abschnitte:",
+	"Skipped:":                    "Übersprungen:",
+	"Summary Adoptions:":          "Übernommene Kommentare:",
+	"Account Handoffs:":           "Kontoübergaben:",
+	"Tags Removed:":               "Entfernte Wartungsbausteine:",
+	"Template Stubs:":             "Vorlagen-Stubs:",
+	"Tool-assisted:":              "Werkzeuggestützt:",
+	"Self-Reverts:":               "Selbst-Reverts:",
+	"Size:":                       "Größe:",
+	"Sockpuppet Networks:":        "Sockenpuppen-Netze:",
+	"Source Quality:":             "Quellenqualität:",
+	"Stability Score:":            "Stabilitätswert:",
+	"Status:":                     "Status:",
+	"Structure Quality:":          "Strukturqualität:",
+	"Support Networks:":           "Unterstützernetze:",
+	"Tag Team Patterns:":          "Tag-Team-Muster:",
+	"Tags:":                       "Markierungen:",
+	"Time Since Last:":            "Seit dem letzten:",
+	"Timestamp:":                  "Zeitstempel:",
+	"Tone Analysis:":              "Tonanalyse:",
+	"Total Contributors:":         "Beitragende gesamt:",
+	"Total Edits:":                "Edits gesamt:",
+	"Total References:":           "Referenzen gesamt:",
+	"Talk Page:":                  "Diskussionsseite:",
+	"Total Reversions:":           "Reverts gesamt:",
+	"Total Revisions:":            "Versionen gesamt:",
+	"Total Revoked:":              "Zurückgesetzt gesamt:",
+	"Total recent reverts shown:": "Angezeigte letzte Reverts:",
+	"Total:":                      "Gesamt:",
+	"Unavailable:":                "Nicht verfügbar:",
+	"Unique References:":          "Eindeutige Referenzen:",
+	"User ID:":                    "Benutzer-ID:",
+	"User Type:":                  "Benutzertyp:",
+	"Username:":                   "Benutzername:",
+	"Vandalism Restored:":         "Vandalismus zurück:",
+	"Vandalism Risk:":             "Vandalismusrisiko:",
+	"Views last 7 days:":          "Aufrufe 7 Tage:",
+	"Vote Cluster:":               "Stimmenhäufung:",
+	"Votes:":                      "Stimmen:",
+	"Wikipedia Language:":         "Wikipedia-Sprache:",
+	"Words Added:":                "Wörter hinzugefügt:",
+	"Words Removed:":              "Wörter entfernt:",
+	"Stealth Changes:":            "Verdeckte Änderungen:",
+	"Suspicion Score:":            "Verdachtswert:",
+
+	// Severity levels
+	"VERY HIGH":                    "SEHR HOCH",
+	"HIGH":                         "HOCH",
+	"MODERATE":                     "MITTEL",
+	"LOW":                          "NIEDRIG",
+	"MINIMAL":                      "MINIMAL",
+	"HIGH CONTROVERSY":             "STARK UMSTRITTEN",
+	"SOME CONTROVERSY":             "TEILWEISE UMSTRITTEN",
+	"LOW CONTROVERSY":              "KAUM UMSTRITTEN",
+	"UNSTABLE":                     "INSTABIL",
+	"STABLE":                       "STABIL",
+	"VERY HIGH - Potential vandal": "SEHR HOCH - Möglicher Vandale",
+	"HIGH - Suspicious activity":   "HOCH - Verdächtige Aktivität",
+	"MODERATE - Needs monitoring":  "MITTEL - Beobachten",
+	"LOW - Some issues":            "NIEDRIG - Einige Probleme",
+	"MINIMAL - Normal conflicts":   "MINIMAL - Normale Konflikte",
+
+	// Counts
+	"%d day ago":      "vor %d Tag",
+	"%d days ago":     "vor %d Tagen",
+	"%d edit":         "%d Bearbeitung",
+	"%d edits":        "%d Bearbeitungen",
+	"%d hour ago":     "vor %d Stunde",
+	"%d hours ago":    "vor %d Stunden",
+	"%d minute ago":   "vor %d Minute",
+	"%d minutes ago":  "vor %d Minuten",
+	"%d page":         "%d Seite",
+	"%d pages":        "%d Seiten",
+	"%d participant":  "%d Teilnehmer",
+	"%d participants": "%d Teilnehmer",
+	"%d request":      "%d Anfrage",
+	"%d requests":     "%d Anfragen",
+	"%d revert":       "%d Revert",
+	"%d reverts":      "%d Reverts",
+	"%d use":          "%d Verwendung",
+	"%d uses":         "%d Verwendungen",
+	"(lifetime)":      "(insgesamt)",
+	"at most %s":      "höchstens %s",
+	"in %d day":       "in %d Tag",
+	"in %d days":      "in %d Tagen",
+	"in %d hour":      "in %d Stunde",
+	"in %d hours":     "in %d Stunden",
+	"in %d minute":    "in %d Minute",
+	"in %d minutes":   "in %d Minuten",
+	"just now":        "gerade eben",
+	"last %d days":    "letzte %d Tage",
+	"last day":        "letzter Tag",
+	"unsummarized":    "ohne Zusammenfassung",
+
+	// Notes
+	"%.1fx baseline (%d views last 7 days)":   "%.1fx des Normalwerts (%d Aufrufe in 7 Tagen)",
+	"%d accounts voted %s within %d min (%s)": "%d Konten stimmten %s innerhalb von %d min (%s)",
+	"%d most recent contribution":             "%d jüngster Beitrag",
+	"%d most recent contributions":            "%d jüngste Beiträge",
+	"(%.0f/day vs %.0f/day baseline)":         "(%.0f/Tag gegenüber %.0f/Tag im Normalfall)",
+	"(counted as conflicts)":                  "(als Konflikte gezählt)",
+	"(excluded unless --count-self-reverts)":  "(ausgenommen ohne --count-self-reverts)",
+	"Computed over the %d most recent contributions in namespace(s) %s, not the lifetime edit count": "Berechnet über die %d jüngsten Beiträge in den Namensräumen %s, nicht über die gesamte Bearbeitungszahl",
+	"Computed over the %d most recent contributions, not the lifetime edit count":                    "Berechnet über die %d jüngsten Beiträge, nicht über die gesamte Bearbeitungszahl",
+	"Nothing was sent.": "Es wurde nichts gesendet.",
+	"Retries (maxlag) come on top: lower the analysis limits, or set --max-api-calls, to reduce the load.": "Wiederholungen (maxlag) kommen hinzu: senken Sie die Analysegrenzen oder setzen Sie --max-api-calls, um die Last zu verringern.",
+	"not computed (author profile budget reached)":                                                         "nicht berechnet (Budget für Autorprofile erschöpft)",
+	"not computed (author profile failed)":                                                                 "nicht berechnet (Autorprofil fehlgeschlagen)",
+}
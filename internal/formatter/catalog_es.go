@@ -0,0 +1,297 @@
+// internal/formatter/catalog_es.go
+package formatter
+
+// spanishMessages is the Spanish catalog of report labels
+var spanishMessages = map[string]string{
+	// Report titles
+	"CONTRIBUTION ANALYSIS: Revision ": "ANÁLISIS DE CONTRIBUCIÓN: Revisión ",
+	"DRY RUN: ":                        "SIMULACIÓN: ",
+	"INVESTIGATION REPORT: Revision ":  "INFORME DE INVESTIGACIÓN: Revisión ",
+	"EDIT HISTORY ANALYSIS: ":          "ANÁLISIS DEL HISTORIAL: ",
+	"CONFLICT ANALYSIS: ":              "ANÁLISIS DE CONFLICTOS: ",
+	"WIKIPEDIA PAGE ANALYSIS: ":        "ANÁLISIS DE PÁGINA DE WIKIPEDIA: ",
+	"CROSS-PAGE COORDINATION ANALYSIS": "ANÁLISIS DE COORDINACIÓN ENTRE PÁGINAS",
+	"USER ADVERSARIES: ":               "ADVERSARIOS DEL USUARIO: ",
+	"USER FOOTPRINT: ":                 "HUELLA DEL USUARIO: ",
+	"WIKIPEDIA USER PROFILE: ":         "PERFIL DE USUARIO DE WIKIPEDIA: ",
+
+	// Section headings
+	"ACTIVITY STATISTICS":                 "ESTADÍSTICAS DE ACTIVIDAD",
+	"ACCOUNT HANDOFFS":                    "RELEVOS ENTRE CUENTAS",
+	"ADOPTED EDIT SUMMARIES":              "RESÚMENES DE EDICIÓN ADOPTADOS",
+	"ANALYSIS COVERAGE":                   "COBERTURA DEL ANÁLISIS",
+	"ANALYSIS OVERVIEW":                   "RESUMEN DEL ANÁLISIS",
+	"ANALYSIS RECOMMENDATIONS":            "RECOMENDACIONES DEL ANÁLISIS",
+	"AUTHOR ANALYSIS":                     "ANÁLISIS DEL AUTOR",
+	"BASIC INFORMATION":                   "INFORMACIÓN BÁSICA",
+	"CONFLICT ANALYSIS":                   "ANÁLISIS DE CONFLICTOS",
+	"CONFLICT INVOLVEMENT":                "IMPLICACIÓN EN CONFLICTOS",
+	"CONFLICT MANAGEMENT RECOMMENDATIONS": "RECOMENDACIONES DE GESTIÓN DE CONFLICTOS",
+	"CONFLICT OVERVIEW":                   "RESUMEN DE CONFLICTOS",
+	"CONFLICT SEVERITY ASSESSMENT":        "EVALUACIÓN DE LA GRAVEDAD DEL CONFLICTO",
+	"CONTENT ANALYSIS":                    "ANÁLISIS DEL CONTENIDO",
+	"CONTEXT ANALYSIS":                    "ANÁLISIS DEL CONTEXTO",
+	"CONTRIBUTION INFORMATION":            "INFORMACIÓN DE LA CONTRIBUCIÓN",
+	"CONTRIBUTOR ACTIVITY PATTERNS":       "PATRONES DE ACTIVIDAD DE LOS COLABORADORES",
+	"CONTRIBUTORS ACROSS MULTIPLE PAGES":  "COLABORADORES EN VARIAS PÁGINAS",
+	"COORDINATION INDICATORS":             "INDICADORES DE COORDINACIÓN",
+	"COORDINATION METRICS":                "MÉTRICAS DE COORDINACIÓN",
+	"DAILY ACTIVITY BREAKDOWN":            "DESGLOSE DE ACTIVIDAD DIARIA",
+	"DETAILED REVISION HISTORY":           "HISTORIAL DETALLADO DE REVISIONES",
+	"DELETION DISCUSSION":                 "CONSULTA DE BORRADO",
+	"DETAILED REVOKED CONTRIBUTIONS":      "DETALLE DE CONTRIBUCIONES REVERTIDAS",
+	"DETECTED EDIT WAR PERIODS":           "GUERRAS DE EDICIÓN DETECTADAS",
+	"EDIT FREQUENCY":                      "FRECUENCIA DE EDICIÓN",
+	"EDITING ACTIVITY TIMELINE":           "CRONOLOGÍA DE LA ACTIVIDAD",
+	"EXPECTED API REQUESTS":               "SOLICITUDES API PREVISTAS",
+	"GROUPS AND RIGHTS":                   "GRUPOS Y PERMISOS",
+	"HIGH PRIORITY ACTIONS NEEDED:":       "ACCIONES PRIORITARIAS NECESARIAS:",
+	"INCOMPLETE ANALYSIS":                 "ANÁLISIS INCOMPLETO",
+	"LOGGED-OUT EDITING":                  "EDICIONES SIN SESIÓN",
+	"MATCHING EDIT CADENCE":               "RITMO DE EDICIÓN COINCIDENTE",
+	"MONITORING RECOMMENDED:":             "SE RECOMIENDA SUPERVISIÓN:",
+	"MOST EDITED PAGES":                   "PÁGINAS MÁS EDITADAS",
+	"MUTUAL SUPPORT PATTERNS":             "PATRONES DE APOYO MUTUO",
+	"NAMESPACE DISTRIBUTION":              "DISTRIBUCIÓN POR ESPACIO DE NOMBRES",
+	"PAGE CONFLICT STATE":                 "ESTADO DE CONFLICTO DE LA PÁGINA",
+	"PAGE INFORMATION":                    "INFORMACIÓN DE LA PÁGINA",
+	"PAGE INVOLVEMENT":                    "IMPLICACIÓN POR PÁGINA",
+	"PAGE OVERVIEW":                       "RESUMEN DE LA PÁGINA",
+	"PAGE-BY-PAGE SUMMARY":                "RESUMEN POR PÁGINA",
+	"QUALITY METRICS":                     "MÉTRICAS DE CALIDAD",
+	"RECENT ACTIVITY":                     "ACTIVIDAD RECIENTE",
+	"RECENT CONTRIBUTIONS (last 5)":       "CONTRIBUCIONES RECIENTES (últimas 5)",
+	"RANKED ADVERSARIES":                  "CLASIFICACIÓN DE ADVERSARIOS",
+	"RECIPROCAL REVERTS":                  "REVERSIONES RECÍPROCAS",
+	"RECENT REVERT ANALYSIS":              "ANÁLISIS DE REVERSIONES RECIENTES",
+	"RECENT REVISIONS (last 10)":          "REVISIONES RECIENTES (últimas 10)",
+	"RECOMMENDATIONS":                     "RECOMENDACIONES",
+	"REVOKED CONTRIBUTIONS ANALYSIS":      "ANÁLISIS DE CONTRIBUCIONES REVERTIDAS",
+	"SCORE BREAKDOWN":                     "DESGLOSE DE LA PUNTUACIÓN",
+	"SHARED PAGE FOOTPRINTS":              "HUELLAS DE PÁGINAS COMPARTIDAS",
+	"SIGNALS":                             "SEÑALES",
+	"SOURCE RELIABILITY ANALYSIS":         "ANÁLISIS DE FIABILIDAD DE LAS FUENTES",
+	"SUMMARY":                             "RESUMEN",
+	"SUSPICION INDICATORS":                "INDICADORES DE SOSPECHA",
+	"SUSPICIOUS CONTRIBUTORS DETECTED":    "COLABORADORES SOSPECHOSOS DETECTADOS",
+	"TOP CONTRIBUTORS ANALYSIS":           "ANÁLISIS DE LOS PRINCIPALES COLABORADORES",
+	"TOPIC CLUSTERS":                      "GRUPOS TEMÁTICOS",
+	"USER BLOCKED":                        "USUARIO BLOQUEADO",
+	"USERS INVOLVED IN CONFLICTS":         "USUARIOS IMPLICADOS EN CONFLICTOS",
+
+	// Field labels
+	"Account Age:":                "Antigüedad:",
+	"Activity Level:":             "Nivel de actividad:",
+	"Activity Pattern:":           "Patrón de actividad:",
+	"Analysis Performed:":         "Análisis realizado:",
+	"Analysis Timestamp:":         "Fecha del análisis:",
+	"Adversaries:":                "Adversarios:",
+	"Analyzed Sample:":            "Muestra analizada:",
+	"Anonymous Ratio:":            "Proporción anónima:",
+	"Author Suspicion:":           "Sospecha del autor:",
+	"Author:":                     "Autor:",
+	"Average Edit Size:":          "Tamaño medio:",
+	"Bias Score:":                 "Puntuación de sesgo:",
+	"Block expires:":              "El bloqueo expira:",
+	"Blocked by:":                 "Bloqueado por:",
+	"Burst Context:":              "Contexto de ráfaga:",
+	"Cadence Groups:":             "Grupos de ritmo:",
+	"Change Type:":                "Tipo de cambio:",
+	"Characters Added:":           "Caracteres añadidos:",
+	"Characters Removed:":         "Caracteres eliminados:",
+	"Comment:":                    "Comentario:",
+	"Common Contributors:":        "Colaboradores comunes:",
+	"Conflict Level:":             "Nivel de conflicto:",
+	"Conflict Severity:":          "Gravedad del conflicto:",
+	"Conflict Status:":            "Estado del conflicto:",
+	"Combined Score:":             "Puntuación combinada:",
+	"Conflicting Users:":          "Usuarios en conflicto:",
+	"Content Quality:":            "Calidad del contenido:",
+	"Content Type:":               "Tipo de contenido:",
+	"Contribution:":               "Contribución:",
+	"Contributor Diversity:":      "Diversidad de colaboradores:",
+	"Controversy Score:":          "Puntuación de controversia:",
+	"Controversy:":                "Controversia:",
+	"Coordinated Reverts:":        "Reversiones coordinadas:",
+	"Coordinated arrival:":        "Llegada coordinada:",
+	"Coordination Score:":         "Puntuación de coordinación:",
+	"Cosmetic Edits:":             "Ediciones cosméticas:",
+	"Created:":                    "Creada:",
+	"Current Size:":               "Tamaño actual:",
+	"Days Active:":                "Días activos:",
+	"Deleted Edits:":              "Ediciones borradas:",
+	"Edit Count:":                 "Número de ediciones:",
+	"Edit Day:":                   "Día de la edición:",
+	"Edit Hour:":                  "Hora de la edición:",
+	"Edit Type:":                  "Tipo de edición:",
+	"Edit war periods:":           "Guerras de edición:",
+	"Edits/day (average):":        "Ediciones/día (media):",
+	"Editor Turnover:":            "Rotación de editores:",
+	"Entity Focus:":               "Entidad central:",
+	"Explicit Groups:":            "Grupos explícitos:",
+	"First Protected Edit:":       "Primera edición protegida:",
+	"Footprint Clusters:":         "Grupos de huellas:",
+	"Groups:":                     "Grupos:",
+	"Implicit Groups:":            "Grupos implícitos:",
+	"Language:":                   "Idioma:",
+	"Last 24h:":                   "Últimas 24h:",
+	"Last %d days:":               "Últimos %d días:",
+	"Last 30 days:":               "Últimos 30 días:",
+	"Last 7 days:":                "Últimos 7 días:",
+	"Last day:":                   "Último día:",
+	"Last Edit:":                  "Última edición:",
+	"Last Modified:":              "Última modificación:",
+	"Logged-out editing:":         "Sin sesión:",
+	"Low-Profile Edits:":          "Ediciones discretas:",
+	"Overall Investigation Risk:": "Riesgo global:",
+	"Page Creations:":             "Páginas creadas:",
+	"Longest Gap:":                "Pausa más larga:",
+	"Most Active Day:":            "Día más activo:",
+	"Most Active Hour:":           "Hora más activa:",
+	"Mutual Support Pairs:":       "Parejas de apoyo mutuo:",
+	"New Editor Ratio:":           "Proporción de nuevos:",
+	"Overall Quality:":            "Calidad global:",
+	"POV Words Found:":            "Términos parciales:",
+	"Page Controversy:":           "Controversia de la página:",
+	"Page ID:":                    "ID de página:",
+	"Page Ownership:":             "Apropiación de la página:",
+	"Page Title:":                 "Título de la página:",
+	"Page:":                       "Página:",
+	"Pages Analyzed:":             "Páginas analizadas:",
+	"Pages edited:":               "Páginas editadas:",
+	"Peak Hours:":                 "Horas punta:",
+	"Policy Compliance:":          "Cumplimiento de normas:",
+	"Policy Violations:":          "Infracciones de normas:",
+	"Promotional Terms:":          "Términos promocionales:",
+	"Protection Expiry:":          "Fin de protección:",
+	"Ran:":                        "Realizadas:",
+	"Reader Traffic:":             "Tráfico de lectores:",
+	"Reason:":                     "Motivo:",
+	"Recent Activity:":            "Actividad reciente:",
+	"Reciprocal:":                 "Recíprocos:",
+	"Recent Conflicts:":           "Conflictos recientes:",
+	"Recent conflicts:":           "Conflictos recientes:",
+	"Reference Churn:":            "Rotación de referencias:",
+	"Registration Date:":          "Fecha de registro:",
+	"Registration:":               "Registro:",
+	"Related Edits:":              "Ediciones relacionadas:",
+	"Relative scoring:":           "Puntuación relativa:",
+	"Reliability Score:":          "Puntuación de fiabilidad:",
+	"Renamed Accounts:":           "Cuentas renombradas:",
+	"Renamed:":                    "Renombrada:",
+	"Reversion Rate:":             "Tasa de reversión:",
+	"Reversions:":                 "Reversiones:",
+	"Revert Chain:":               "Cadena de reversiones:",
+	"Revert Status:":              "Estado de reversión:",
+	"Revision ID:":                "ID de revisión:",
+	"Revoked Ratio:":              "Proporción revertida:",
+	"Risk Level:":                 "Nivel de riesgo:",
+	"Sections Affected:":          "Secciones afectadas:",
+	"Skipped:":                    "Omitida:",
+	"Summary Adoptions:":          "Resúmenes adoptados:",
+	"Account Handoffs:":           "Relevos de cuentas:",
+	"Tags Removed:":               "Plantillas retiradas:",
+	"Template Stubs:":             "Esbozos calcados:",
+	"Tool-assisted:":              "Con herramienta:",
+	"Self-Reverts:":               "Autorreversiones:",
+	"Size:":                       "Tamaño:",
+	"Sockpuppet Networks:":        "Redes de títeres:",
+	"Source Quality:":             "Calidad de las fuentes:",
+	"Stability Score:":            "Puntuación de estabilidad:",
+	"Status:":                     "Estado:",
+	"Structure Quality:":          "Calidad de la estructura:",
+	"Support Networks:":           "Redes de apoyo:",
+	"Tag Team Patterns:":          "Edición en equipo:",
+	"Tags:":                       "Etiquetas:",
+	"Time Since Last:":            "Desde la anterior:",
+	"Timestamp:":                  "Fecha:",
+	"Tone Analysis:":              "Análisis del tono:",
+	"Total Contributors:":         "Colaboradores totales:",
+	"Total Edits:":                "Ediciones totales:",
+	"Total References:":           "Referencias totales:",
+	"Talk Page:":                  "Página de discusión:",
+	"Total Reversions:":           "Reversiones totales:",
+	"Total Revisions:":            "Revisiones totales:",
+	"Total Revoked:":              "Total revertido:",
+	"Total recent reverts shown:": "Reversiones recientes mostradas:",
+	"Total:":                      "Total:",
+	"Unavailable:":                "No disponible:",
+	"Unique References:":          "Referencias únicas:",
+	"User ID:":                    "ID de usuario:",
+	"User Type:":                  "Tipo de usuario:",
+	"Username:":                   "Nombre de usuario:",
+	"Vandalism Restored:":         "Vandalismo restaurado:",
+	"Vandalism Risk:":             "Riesgo de vandalismo:",
+	"Views last 7 days:":          "Visitas en 7 días:",
+	"Vote Cluster:":               "Grupo de votos:",
+	"Votes:":                      "Votos:",
+	"Wikipedia Language:":         "Idioma de Wikipedia:",
+	"Words Added:":                "Palabras añadidas:",
+	"Words Removed:":              "Palabras eliminadas:",
+	"Stealth Changes:":            "Cambios sigilosos:",
+	"Suspicion Score:":            "Puntuación de sospecha:",
+
+	// Severity levels
+	"VERY HIGH":                    "MUY ALTO",
+	"HIGH":                         "ALTO",
+	"MODERATE":                     "MODERADO",
+	"LOW":                          "BAJO",
+	"MINIMAL":                      "MÍNIMO",
+	"HIGH CONTROVERSY":             "ALTA CONTROVERSIA",
+	"SOME CONTROVERSY":             "CIERTA CONTROVERSIA",
+	"LOW CONTROVERSY":              "BAJA CONTROVERSIA",
+	"UNSTABLE":                     "INESTABLE",
+	"STABLE":                       "ESTABLE",
+	"VERY HIGH - Potential vandal": "MUY ALTO - Posible vándalo",
+	"HIGH - Suspicious activity":   "ALTO - Actividad sospechosa",
+	"MODERATE - Needs monitoring":  "MODERADO - Requiere seguimiento",
+	"LOW - Some issues":            "BAJO - Algunos problemas",
+	"MINIMAL - Normal conflicts":   "MÍNIMO - Conflictos normales",
+
+	// Counts
+	"%d day ago":      "hace %d día",
+	"%d days ago":     "hace %d días",
+	"%d edit":         "%d edición",
+	"%d edits":        "%d ediciones",
+	"%d hour ago":     "hace %d hora",
+	"%d hours ago":    "hace %d horas",
+	"%d minute ago":   "hace %d minuto",
+	"%d minutes ago":  "hace %d minutos",
+	"%d page":         "%d página",
+	"%d pages":        "%d páginas",
+	"%d participant":  "%d participante",
+	"%d participants": "%d participantes",
+	"%d request":      "%d solicitud",
+	"%d requests":     "%d solicitudes",
+	"%d revert":       "%d reversión",
+	"%d reverts":      "%d reversiones",
+	"%d use":          "%d uso",
+	"%d uses":         "%d usos",
+	"(lifetime)":      "(desde el registro)",
+	"at most %s":      "como máximo %s",
+	"in %d day":       "en %d día",
+	"in %d days":      "en %d días",
+	"in %d hour":      "en %d hora",
+	"in %d hours":     "en %d horas",
+	"in %d minute":    "en %d minuto",
+	"in %d minutes":   "en %d minutos",
+	"just now":        "ahora mismo",
+	"last %d days":    "últimos %d días",
+	"last day":        "último día",
+	"unsummarized":    "sin resumen",
+
+	// Notes
+	"%.1fx baseline (%d views last 7 days)":   "%.1fx lo habitual (%d visitas en 7 días)",
+	"%d accounts voted %s within %d min (%s)": "%d cuentas votaron %s en %d min (%s)",
+	"%d most recent contribution":             "%d contribución más reciente",
+	"%d most recent contributions":            "%d contribuciones más recientes",
+	"(%.0f/day vs %.0f/day baseline)":         "(%.0f/día frente a %.0f/día de referencia)",
+	"(counted as conflicts)":                  "(contadas como conflictos)",
+	"(excluded unless --count-self-reverts)":  "(excluidas salvo con --count-self-reverts)",
+	"Computed over the %d most recent contributions in namespace(s) %s, not the lifetime edit count": "Calculado sobre las %d contribuciones más recientes en los espacios de nombres %s, no sobre el total de ediciones",
+	"Computed over the %d most recent contributions, not the lifetime edit count":                    "Calculado sobre las %d contribuciones más recientes, no sobre el total de ediciones",
+	"Nothing was sent.": "No se ha enviado nada.",
+	"Retries (maxlag) come on top: lower the analysis limits, or set --max-api-calls, to reduce the load.": "Los reintentos (maxlag) se suman: reduzca los límites del análisis, o fije --max-api-calls, para reducir la carga.",
+	"not computed (author profile budget reached)":                                                         "no calculada (presupuesto de perfiles de autor agotado)",
+	"not computed (author profile failed)":                                                                 "no calculada (falló el perfil del autor)",
+}
@@ -0,0 +1,297 @@
+// internal/formatter/catalog_fr.go
+package formatter
+
+// frenchMessages is the French catalog of report labels
+var frenchMessages = map[string]string{
+	// Report titles
+	"CONTRIBUTION ANALYSIS: Revision ": "ANALYSE DE CONTRIBUTION : Révision ",
+	"DRY RUN: ":                        "SIMULATION : ",
+	"INVESTIGATION REPORT: Revision ":  "RAPPORT D'ENQUÊTE : Révision ",
+	"EDIT HISTORY ANALYSIS: ":          "ANALYSE DE L'HISTORIQUE : ",
+	"CONFLICT ANALYSIS: ":              "ANALYSE DES CONFLITS : ",
+	"WIKIPEDIA PAGE ANALYSIS: ":        "ANALYSE DE PAGE WIKIPÉDIA : ",
+	"CROSS-PAGE COORDINATION ANALYSIS": "ANALYSE DE COORDINATION MULTI-PAGES",
+	"USER ADVERSARIES: ":               "ADVERSAIRES DE L'UTILISATEUR : ",
+	"USER FOOTPRINT: ":                 "EMPREINTE DE L'UTILISATEUR : ",
+	"WIKIPEDIA USER PROFILE: ":         "PROFIL D'UTILISATEUR WIKIPÉDIA : ",
+
+	// Section headings
+	"ACTIVITY STATISTICS":                 "STATISTIQUES D'ACTIVITÉ",
+	"ACCOUNT HANDOFFS":                    "PASSAGES DE RELAIS ENTRE COMPTES",
+	"ADOPTED EDIT SUMMARIES":              "RÉSUMÉS DE MODIFICATION REPRIS",
+	"ANALYSIS COVERAGE":                   "COUVERTURE DE L'ANALYSE",
+	"ANALYSIS OVERVIEW":                   "APERÇU DE L'ANALYSE",
+	"ANALYSIS RECOMMENDATIONS":            "RECOMMANDATIONS D'ANALYSE",
+	"AUTHOR ANALYSIS":                     "ANALYSE DE L'AUTEUR",
+	"BASIC INFORMATION":                   "INFORMATIONS GÉNÉRALES",
+	"CONFLICT ANALYSIS":                   "ANALYSE DES CONFLITS",
+	"CONFLICT INVOLVEMENT":                "IMPLICATION DANS LES CONFLITS",
+	"CONFLICT MANAGEMENT RECOMMENDATIONS": "RECOMMANDATIONS DE GESTION DES CONFLITS",
+	"CONFLICT OVERVIEW":                   "APERÇU DES CONFLITS",
+	"CONFLICT SEVERITY ASSESSMENT":        "ÉVALUATION DE LA GRAVITÉ DES CONFLITS",
+	"CONTENT ANALYSIS":                    "ANALYSE DU CONTENU",
+	"CONTEXT ANALYSIS":                    "ANALYSE DU CONTEXTE",
+	"CONTRIBUTION INFORMATION":            "INFORMATIONS SUR LA CONTRIBUTION",
+	"CONTRIBUTOR ACTIVITY PATTERNS":       "SCHÉMAS D'ACTIVITÉ DES CONTRIBUTEURS",
+	"CONTRIBUTORS ACROSS MULTIPLE PAGES":  "CONTRIBUTEURS PRÉSENTS SUR PLUSIEURS PAGES",
+	"COORDINATION INDICATORS":             "INDICATEURS DE COORDINATION",
+	"COORDINATION METRICS":                "MESURES DE COORDINATION",
+	"DAILY ACTIVITY BREAKDOWN":            "ACTIVITÉ JOUR PAR JOUR",
+	"DETAILED REVISION HISTORY":           "HISTORIQUE DÉTAILLÉ DES RÉVISIONS",
+	"DELETION DISCUSSION":                 "DISCUSSION DE SUPPRESSION",
+	"DETAILED REVOKED CONTRIBUTIONS":      "DÉTAIL DES CONTRIBUTIONS ANNULÉES",
+	"DETECTED EDIT WAR PERIODS":           "GUERRES D'ÉDITION DÉTECTÉES",
+	"EDIT FREQUENCY":                      "FRÉQUENCE DES MODIFICATIONS",
+	"EDITING ACTIVITY TIMELINE":           "CHRONOLOGIE DE L'ACTIVITÉ",
+	"EXPECTED API REQUESTS":               "REQUÊTES API PRÉVUES",
+	"GROUPS AND RIGHTS":                   "GROUPES ET DROITS",
+	"HIGH PRIORITY ACTIONS NEEDED:":       "ACTIONS PRIORITAIRES REQUISES :",
+	"INCOMPLETE ANALYSIS":                 "ANALYSE INCOMPLÈTE",
+	"LOGGED-OUT EDITING":                  "MODIFICATIONS HORS CONNEXION",
+	"MATCHING EDIT CADENCE":               "RYTHMES D'ÉDITION SIMILAIRES",
+	"MONITORING RECOMMENDED:":             "SURVEILLANCE RECOMMANDÉE :",
+	"MOST EDITED PAGES":                   "PAGES LES PLUS MODIFIÉES",
+	"MUTUAL SUPPORT PATTERNS":             "SCHÉMAS DE SOUTIEN MUTUEL",
+	"NAMESPACE DISTRIBUTION":              "RÉPARTITION PAR ESPACE DE NOMS",
+	"PAGE CONFLICT STATE":                 "ÉTAT DES CONFLITS DE LA PAGE",
+	"PAGE INFORMATION":                    "INFORMATIONS SUR LA PAGE",
+	"PAGE INVOLVEMENT":                    "IMPLICATION PAR PAGE",
+	"PAGE OVERVIEW":                       "APERÇU DE LA PAGE",
+	"PAGE-BY-PAGE SUMMARY":                "RÉSUMÉ PAGE PAR PAGE",
+	"QUALITY METRICS":                     "MESURES DE QUALITÉ",
+	"RECENT ACTIVITY":                     "ACTIVITÉ RÉCENTE",
+	"RECENT CONTRIBUTIONS (last 5)":       "CONTRIBUTIONS RÉCENTES (5 dernières)",
+	"RANKED ADVERSARIES":                  "CLASSEMENT DES ADVERSAIRES",
+	"RECIPROCAL REVERTS":                  "ANNULATIONS RÉCIPROQUES",
+	"RECENT REVERT ANALYSIS":              "ANALYSE DES ANNULATIONS RÉCENTES",
+	"RECENT REVISIONS (last 10)":          "RÉVISIONS RÉCENTES (10 dernières)",
+	"RECOMMENDATIONS":                     "RECOMMANDATIONS",
+	"REVOKED CONTRIBUTIONS ANALYSIS":      "ANALYSE DES CONTRIBUTIONS ANNULÉES",
+	"SCORE BREAKDOWN":                     "DÉTAIL DU SCORE",
+	"SHARED PAGE FOOTPRINTS":              "EMPREINTES DE PAGES COMMUNES",
+	"SIGNALS":                             "SIGNAUX",
+	"SOURCE RELIABILITY ANALYSIS":         "ANALYSE DE FIABILITÉ DES SOURCES",
+	"SUMMARY":                             "RÉSUMÉ",
+	"SUSPICION INDICATORS":                "INDICATEURS DE SUSPICION",
+	"SUSPICIOUS CONTRIBUTORS DETECTED":    "CONTRIBUTEURS SUSPECTS DÉTECTÉS",
+	"TOP CONTRIBUTORS ANALYSIS":           "ANALYSE DES PRINCIPAUX CONTRIBUTEURS",
+	"TOPIC CLUSTERS":                      "GROUPES THÉMATIQUES",
+	"USER BLOCKED":                        "UTILISATEUR BLOQUÉ",
+	"USERS INVOLVED IN CONFLICTS":         "UTILISATEURS IMPLIQUÉS DANS DES CONFLITS",
+
+	// Field labels
+	"Account Age:":                "Ancienneté :",
+	"Activity Level:":             "Niveau d'activité :",
+	"Activity Pattern:":           "Profil d'activité :",
+	"Analysis Performed:":         "Analyse effectuée :",
+	"Analysis Timestamp:":         "Date de l'analyse :",
+	"Adversaries:":                "Adversaires :",
+	"Analyzed Sample:":            "Échantillon :",
+	"Anonymous Ratio:":            "Part d'anonymes :",
+	"Author Suspicion:":           "Suspicion auteur :",
+	"Author:":                     "Auteur :",
+	"Average Edit Size:":          "Taille moyenne :",
+	"Bias Score:":                 "Score de biais :",
+	"Block expires:":              "Fin du blocage :",
+	"Blocked by:":                 "Bloqué par :",
+	"Burst Context:":              "Contexte de rafale :",
+	"Cadence Groups:":             "Groupes de rythme :",
+	"Change Type:":                "Type de changement :",
+	"Characters Added:":           "Caractères ajoutés :",
+	"Characters Removed:":         "Caractères retirés :",
+	"Comment:":                    "Commentaire :",
+	"Common Contributors:":        "Contributeurs communs :",
+	"Conflict Level:":             "Niveau de conflit :",
+	"Conflict Severity:":          "Gravité du conflit :",
+	"Conflict Status:":            "État du conflit :",
+	"Combined Score:":             "Score combiné :",
+	"Conflicting Users:":          "Utilisateurs en conflit :",
+	"Content Quality:":            "Qualité du contenu :",
+	"Content Type:":               "Type de contenu :",
+	"Contribution:":               "Contribution :",
+	"Contributor Diversity:":      "Diversité des contributeurs :",
+	"Controversy Score:":          "Score de controverse :",
+	"Controversy:":                "Controverse :",
+	"Coordinated Reverts:":        "Annulations coordonnées :",
+	"Coordinated arrival:":        "Arrivée coordonnée :",
+	"Coordination Score:":         "Score de coordination :",
+	"Cosmetic Edits:":             "Modifications cosmétiques :",
+	"Created:":                    "Créée le :",
+	"Current Size:":               "Taille actuelle :",
+	"Days Active:":                "Jours actifs :",
+	"Deleted Edits:":              "Modifications supprimées :",
+	"Edit Count:":                 "Nombre de modifications :",
+	"Edit Day:":                   "Jour de l'édition :",
+	"Edit Hour:":                  "Heure de l'édition :",
+	"Edit Type:":                  "Type d'édition :",
+	"Edit war periods:":           "Guerres d'édition :",
+	"Edits/day (average):":        "Modifications/jour (moy.) :",
+	"Editor Turnover:":            "Renouvellement :",
+	"Entity Focus:":               "Entité ciblée :",
+	"Explicit Groups:":            "Groupes explicites :",
+	"First Protected Edit:":       "1re édition protégée :",
+	"Footprint Clusters:":         "Groupes d'empreintes :",
+	"Groups:":                     "Groupes :",
+	"Implicit Groups:":            "Groupes implicites :",
+	"Language:":                   "Langue :",
+	"Last 24h:":                   "Dernières 24 h :",
+	"Last %d days:":               "%d derniers jours :",
+	"Last 30 days:":               "30 derniers jours :",
+	"Last 7 days:":                "7 derniers jours :",
+	"Last day:":                   "Dernier jour :",
+	"Last Edit:":                  "Dernière édition :",
+	"Last Modified:":              "Dernière modification :",
+	"Logged-out editing:":         "Hors connexion :",
+	"Low-Profile Edits:":          "Modifications discrètes :",
+	"Overall Investigation Risk:": "Risque global :",
+	"Page Creations:":             "Pages créées :",
+	"Longest Gap:":                "Plus longue pause :",
+	"Most Active Day:":            "Jour le plus actif :",
+	"Most Active Hour:":           "Heure la plus active :",
+	"Mutual Support Pairs:":       "Paires de soutien mutuel :",
+	"New Editor Ratio:":           "Part de nouveaux :",
+	"Overall Quality:":            "Qualité globale :",
+	"POV Words Found:":            "Termes partiaux :",
+	"Page Controversy:":           "Controverse de la page :",
+	"Page ID:":                    "ID de page :",
+	"Page Ownership:":             "Appropriation de page :",
+	"Page Title:":                 "Titre de la page :",
+	"Page:":                       "Page :",
+	"Pages Analyzed:":             "Pages analysées :",
+	"Pages edited:":               "Pages modifiées :",
+	"Peak Hours:":                 "Heures de pointe :",
+	"Policy Compliance:":          "Respect des règles :",
+	"Policy Violations:":          "Infractions aux règles :",
+	"Promotional Terms:":          "Termes promotionnels :",
+	"Protection Expiry:":          "Fin de protection :",
+	"Ran:":                        "Effectuées :",
+	"Reader Traffic:":             "Trafic lecteurs :",
+	"Reason:":                     "Motif :",
+	"Recent Activity:":            "Activité récente :",
+	"Reciprocal:":                 "Réciproques :",
+	"Recent Conflicts:":           "Conflits récents :",
+	"Recent conflicts:":           "Conflits récents :",
+	"Reference Churn:":            "Rotation des références :",
+	"Registration Date:":          "Date d'inscription :",
+	"Registration:":               "Inscription :",
+	"Related Edits:":              "Modifications liées :",
+	"Relative scoring:":           "Score relatif :",
+	"Reliability Score:":          "Score de fiabilité :",
+	"Renamed Accounts:":           "Comptes renommés :",
+	"Renamed:":                    "Renommée :",
+	"Reversion Rate:":             "Taux d'annulation :",
+	"Reversions:":                 "Annulations :",
+	"Revert Chain:":               "Chaîne d'annulations :",
+	"Revert Status:":              "Statut d'annulation :",
+	"Revision ID:":                "ID de révision :",
+	"Revoked Ratio:":              "Taux d'annulation :",
+	"Risk Level:":                 "Niveau de risque :",
+	"Sections Affected:":          "Sections touchées :",
+	"Skipped:":                    "Ignorée :",
+	"Summary Adoptions:":          "Résumés repris :",
+	"Account Handoffs:":           "Relais de comptes :",
+	"Tags Removed:":               "Bandeaux retirés :",
+	"Template Stubs:":             "Ébauches calquées :",
+	"Tool-assisted:":              "Via un outil :",
+	"Self-Reverts:":               "Auto-annulations :",
+	"Size:":                       "Taille :",
+	"Sockpuppet Networks:":        "Réseaux de faux-nez :",
+	"Source Quality:":             "Qualité des sources :",
+	"Stability Score:":            "Score de stabilité :",
+	"Status:":                     "Statut :",
+	"Structure Quality:":          "Qualité de structure :",
+	"Support Networks:":           "Réseaux de soutien :",
+	"Tag Team Patterns:":          "Édition en équipe :",
+	"Tags:":                       "Balises :",
+	"Time Since Last:":            "Depuis la précédente :",
+	"Timestamp:":                  "Horodatage :",
+	"Tone Analysis:":              "Analyse du ton :",
+	"Total Contributors:":         "Contributeurs :",
+	"Total Edits:":                "Modifications totales :",
+	"Total References:":           "Références totales :",
+	"Talk Page:":                  "Page de discussion :",
+	"Total Reversions:":           "Annulations totales :",
+	"Total Revisions:":            "Révisions totales :",
+	"Total Revoked:":              "Total annulé :",
+	"Total recent reverts shown:": "Annulations récentes affichées :",
+	"Total:":                      "Total :",
+	"Unavailable:":                "Indisponible :",
+	"Unique References:":          "Références uniques :",
+	"User ID:":                    "ID utilisateur :",
+	"User Type:":                  "Type d'utilisateur :",
+	"Username:":                   "Nom d'utilisateur :",
+	"Vandalism Restored:":         "Vandalisme rétabli :",
+	"Vandalism Risk:":             "Risque de vandalisme :",
+	"Views last 7 days:":          "Vues sur 7 jours :",
+	"Vote Cluster:":               "Grappe de votes :",
+	"Votes:":                      "Votes :",
+	"Wikipedia Language:":         "Langue Wikipédia :",
+	"Words Added:":                "Mots ajoutés :",
+	"Words Removed:":              "Mots retirés :",
+	"Stealth Changes:":            "Modifs furtives :",
+	"Suspicion Score:":            "Score de suspicion :",
+
+	// Severity levels
+	"VERY HIGH":                    "TRÈS ÉLEVÉ",
+	"HIGH":                         "ÉLEVÉ",
+	"MODERATE":                     "MODÉRÉ",
+	"LOW":                          "FAIBLE",
+	"MINIMAL":                      "MINIMAL",
+	"HIGH CONTROVERSY":             "FORTE CONTROVERSE",
+	"SOME CONTROVERSY":             "CONTROVERSE MODÉRÉE",
+	"LOW CONTROVERSY":              "FAIBLE CONTROVERSE",
+	"UNSTABLE":                     "INSTABLE",
+	"STABLE":                       "STABLE",
+	"VERY HIGH - Potential vandal": "TRÈS ÉLEVÉ - Vandale potentiel",
+	"HIGH - Suspicious activity":   "ÉLEVÉ - Activité suspecte",
+	"MODERATE - Needs monitoring":  "MODÉRÉ - À surveiller",
+	"LOW - Some issues":            "FAIBLE - Quelques problèmes",
+	"MINIMAL - Normal conflicts":   "MINIMAL - Conflits normaux",
+
+	// Counts
+	"%d day ago":      "il y a %d jour",
+	"%d days ago":     "il y a %d jours",
+	"%d edit":         "%d modification",
+	"%d edits":        "%d modifications",
+	"%d hour ago":     "il y a %d heure",
+	"%d hours ago":    "il y a %d heures",
+	"%d minute ago":   "il y a %d minute",
+	"%d minutes ago":  "il y a %d minutes",
+	"%d page":         "%d page",
+	"%d pages":        "%d pages",
+	"%d participant":  "%d participant",
+	"%d participants": "%d participants",
+	"%d request":      "%d requête",
+	"%d requests":     "%d requêtes",
+	"%d revert":       "%d annulation",
+	"%d reverts":      "%d annulations",
+	"%d use":          "%d utilisation",
+	"%d uses":         "%d utilisations",
+	"(lifetime)":      "(depuis l'inscription)",
+	"at most %s":      "au plus %s",
+	"in %d day":       "dans %d jour",
+	"in %d days":      "dans %d jours",
+	"in %d hour":      "dans %d heure",
+	"in %d hours":     "dans %d heures",
+	"in %d minute":    "dans %d minute",
+	"in %d minutes":   "dans %d minutes",
+	"just now":        "à l'instant",
+	"last %d days":    "%d derniers jours",
+	"last day":        "dernier jour",
+	"unsummarized":    "sans résumé",
+
+	// Notes
+	"%.1fx baseline (%d views last 7 days)":   "%.1fx la normale (%d vues sur 7 jours)",
+	"%d accounts voted %s within %d min (%s)": "%d comptes ont voté %s en %d min (%s)",
+	"%d most recent contribution":             "%d contribution la plus récente",
+	"%d most recent contributions":            "%d contributions les plus récentes",
+	"(%.0f/day vs %.0f/day baseline)":         "(%.0f/jour contre %.0f/jour en temps normal)",
+	"(counted as conflicts)":                  "(comptées comme conflits)",
+	"(excluded unless --count-self-reverts)":  "(exclues sauf avec --count-self-reverts)",
+	"Computed over the %d most recent contributions in namespace(s) %s, not the lifetime edit count": "Calculé sur les %d contributions les plus récentes dans le ou les espaces de noms %s, pas sur le nombre total de modifications",
+	"Computed over the %d most recent contributions, not the lifetime edit count":                    "Calculé sur les %d contributions les plus récentes, pas sur le nombre total de modifications",
+	"Nothing was sent.": "Rien n'a été envoyé.",
+	"Retries (maxlag) come on top: lower the analysis limits, or set --max-api-calls, to reduce the load.": "Les nouvelles tentatives (maxlag) s'y ajoutent : baissez les limites d'analyse, ou fixez --max-api-calls, pour réduire la charge.",
+	"not computed (author profile budget reached)":                                                         "non calculée (budget de profils d'auteur atteint)",
+	"not computed (author profile failed)":                                                                 "non calculée (échec du profil d'auteur)",
+}
@@ -2,7 +2,13 @@
 package formatter
 
 import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/fatih/color"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
 )
 
 var (
@@ -15,60 +21,366 @@ var (
 	secondaryColor = color.New(color.FgHiBlack)
 )
 
-// getSuspicionText returns descriptive text for suspicion score
-func getSuspicionText(score int) string {
+// severity describes how a bucketed score is rendered: level key, label, icon and color
+type severity struct {
+	Level string
+	Label string
+	Icon  string
+	Color *color.Color
+}
+
+// Suspicion severities, from most to least severe
+var (
+	severityVeryHigh = severity{Level: "VERY_HIGH", Label: "VERY HIGH", Icon: "🔴", Color: dangerColor}
+	severityHigh     = severity{Level: "HIGH", Label: "HIGH", Icon: "🟠", Color: color.New(color.FgRed)}
+	severityModerate = severity{Level: "MODERATE", Label: "MODERATE", Icon: "🟡", Color: warningColor}
+	severityLow      = severity{Level: "LOW", Label: "LOW", Icon: "🟢", Color: color.New(color.FgYellow)}
+	severityMinimal  = severity{Level: "NONE", Label: "MINIMAL", Icon: "✅", Color: successColor}
+)
+
+// Thresholds shared by all formatters - defined once to avoid drift
+const (
+	// Suspicion score (0-100) buckets
+	suspicionVeryHighThreshold = 80
+	suspicionHighThreshold     = 60
+	suspicionModerateThreshold = 40
+	suspicionLowThreshold      = 20
+
+	// Risk buckets used for recommendations
+	riskHighThreshold     = 70
+	riskModerateThreshold = 40
+
+	// Controversy score (0-1) and recent conflict buckets
+	controversyHighThreshold         = 0.3
+	controversyModerateThreshold     = 0.1
+	recentConflictsHighThreshold     = 10
+	recentConflictsModerateThreshold = 5
+
+	// Stability score (0-1) buckets
+	stabilityUnstableThreshold = 0.7
+	stabilityModerateThreshold = 0.9
+)
+
+// getSuspicionSeverity maps a suspicion score to its severity
+func getSuspicionSeverity(score int) severity {
 	switch {
-	case score >= 80:
-		return "VERY HIGH"
-	case score >= 60:
-		return "HIGH"
-	case score >= 40:
-		return "MODERATE"
-	case score >= 20:
-		return "LOW"
+	case score >= suspicionVeryHighThreshold:
+		return severityVeryHigh
+	case score >= suspicionHighThreshold:
+		return severityHigh
+	case score >= suspicionModerateThreshold:
+		return severityModerate
+	case score >= suspicionLowThreshold:
+		return severityLow
 	default:
-		return "MINIMAL"
+		return severityMinimal
 	}
 }
 
+// getSuspicionText returns descriptive text for suspicion score
+func getSuspicionText(score int) string {
+	return tr(getSuspicionSeverity(score).Label)
+}
+
 // getSuspicionColor returns appropriate color for the score
 func getSuspicionColor(score int) *color.Color {
+	return getSuspicionSeverity(score).Color
+}
+
+// getSuspicionLevelColor returns appropriate color for suspicion level
+func getSuspicionLevelColor(level string) *color.Color {
+	for _, sev := range []severity{severityVeryHigh, severityHigh, severityModerate, severityLow, severityMinimal} {
+		if sev.Level == level {
+			return sev.Color
+		}
+	}
+	return secondaryColor
+}
+
+// getRiskSeverity maps a suspicion score to the high/moderate/low risk buckets used for recommendations
+func getRiskSeverity(score int) severity {
 	switch {
-	case score >= 80:
-		return dangerColor
-	case score >= 60:
-		return color.New(color.FgRed)
-	case score >= 40:
-		return warningColor
-	case score >= 20:
-		return color.New(color.FgYellow)
+	case score >= riskHighThreshold:
+		return severity{Level: "HIGH", Label: "HIGH", Icon: "🚨", Color: dangerColor}
+	case score >= riskModerateThreshold:
+		return severity{Level: "MODERATE", Label: "MODERATE", Icon: "⚠️", Color: warningColor}
 	default:
-		return successColor
+		return severity{Level: "LOW", Label: "LOW", Icon: "✅", Color: successColor}
 	}
 }
 
-// getSuspicionLevelColor returns appropriate color for suspicion level
-func getSuspicionLevelColor(level string) *color.Color {
-	switch level {
-	case "VERY_HIGH":
-		return dangerColor
-	case "HIGH":
-		return color.New(color.FgRed)
-	case "MODERATE":
-		return warningColor
-	case "LOW":
-		return color.New(color.FgYellow)
-	case "NONE":
-		return successColor
+// getControversySeverity maps a controversy score to its severity
+func getControversySeverity(controversyScore float64) severity {
+	switch {
+	case controversyScore > controversyHighThreshold:
+		return severity{Level: "HIGH", Label: "HIGH CONTROVERSY", Icon: "🔴", Color: dangerColor}
+	case controversyScore > controversyModerateThreshold:
+		return severity{Level: "MODERATE", Label: "SOME CONTROVERSY", Icon: "🟡", Color: warningColor}
+	default:
+		return severity{Level: "LOW", Label: "LOW CONTROVERSY", Icon: "🟢", Color: successColor}
+	}
+}
+
+// getConflictSeverity maps controversy and recent conflicts to a page conflict level
+func getConflictSeverity(controversyScore float64, recentConflicts int) severity {
+	switch {
+	case controversyScore > controversyHighThreshold || recentConflicts > recentConflictsHighThreshold:
+		return severity{Level: "HIGH", Label: "HIGH", Icon: "🔴", Color: dangerColor}
+	case controversyScore > controversyModerateThreshold || recentConflicts > recentConflictsModerateThreshold:
+		return severity{Level: "MODERATE", Label: "MODERATE", Icon: "🟡", Color: warningColor}
+	default:
+		return severity{Level: "LOW", Label: "LOW", Icon: "🟢", Color: successColor}
+	}
+}
+
+// getStabilitySeverity maps a stability score to its severity
+func getStabilitySeverity(stabilityScore float64) severity {
+	switch {
+	case stabilityScore < stabilityUnstableThreshold:
+		return severity{Level: "HIGH", Label: "UNSTABLE", Icon: "🔴", Color: dangerColor}
+	case stabilityScore < stabilityModerateThreshold:
+		return severity{Level: "MODERATE", Label: "MODERATE", Icon: "🟡", Color: warningColor}
+	default:
+		return severity{Level: "LOW", Label: "STABLE", Icon: "🟢", Color: successColor}
+	}
+}
+
+// getRevokedRatioSeverity maps a user's revoked contribution ratio to its severity
+func getRevokedRatioSeverity(ratio float64) severity {
+	switch {
+	case ratio > 0.5:
+		return severity{Level: "VERY_HIGH", Label: "VERY HIGH - Potential vandal", Icon: "🔴", Color: dangerColor}
+	case ratio > 0.3:
+		return severity{Level: "HIGH", Label: "HIGH - Suspicious activity", Icon: "🟠", Color: warningColor}
+	case ratio > 0.2:
+		return severity{Level: "MODERATE", Label: "MODERATE - Needs monitoring", Icon: "🟡", Color: warningColor}
+	case ratio > 0.1:
+		return severity{Level: "LOW", Label: "LOW - Some issues", Icon: "🟢", Color: infoColor}
+	default:
+		return severity{Level: "NONE", Label: "MINIMAL - Normal conflicts", Icon: "✅", Color: successColor}
+	}
+}
+
+// DescribeSuspicionFlag returns the readable text of a flag, worded for the analysis
+// that raised it ("user"/"author", "page", "contributor", "cross_page", "contribution")
+func DescribeSuspicionFlag(source, flag string) string {
+	switch source {
+	case "user", "author":
+		return formatUserSuspicionFlag(flag)
+	case "page":
+		return formatPageSuspicionFlag(flag)
+	case "contributor":
+		return formatContributorSuspicionFlag(flag)
+	case "cross_page":
+		return formatCrossPageSuspicionFlag(flag)
 	default:
-		return secondaryColor
+		return formatContributionSuspicionFlag(flag)
+	}
+}
+
+// Table layout widths. Layouts are designed for defaultTableWidth columns and
+// scaled to the terminal width set with SetTableWidth.
+const (
+	// defaultTableWidth is the design width, used when output is not a terminal
+	defaultTableWidth = 100
+	// minTableWidth is the narrowest width layouts are scaled down to
+	minTableWidth = 40
+	// minColumnWidth keeps scaled text columns readable on narrow terminals
+	minColumnWidth = 8
+	// boxWidth is the design width of the boxed report headers (inside the corners)
+	boxWidth = 61
+)
+
+// tableWidth is the width table output is currently sized to
+var tableWidth = defaultTableWidth
+
+// SetTableWidth sets the terminal width (in columns) table output is sized to.
+// Zero or negative values restore the default layout.
+func SetTableWidth(width int) {
+	if width <= 0 {
+		width = defaultTableWidth
 	}
+	tableWidth = utils.Max(width, minTableWidth)
 }
 
-// truncateString truncates a string to specified length
+// scaleWidth sizes a width from the default layout to the current table width
+func scaleWidth(width int) int {
+	return utils.Max(width*tableWidth/defaultTableWidth, utils.Min(width, minColumnWidth))
+}
+
+// separator returns a horizontal rule whose design width is scaled to the table width
+func separator(width int) string {
+	return strings.Repeat("─", scaleWidth(width))
+}
+
+// boxHeader renders the boxed title of a table report. The value column (username,
+// page title...) absorbs the difference between the design and the current width.
+func boxHeader(label string, value string, valueWidth int) string {
+	// A translated label takes its extra width from the value column
+	translated := translateHeading(label)
+	valueWidth -= utf8.RuneCountInString(translated) - utf8.RuneCountInString(label)
+
+	width := utils.Max(valueWidth+scaleWidth(boxWidth)-boxWidth, minColumnWidth)
+	border := strings.Repeat("─", boxWidth-valueWidth+width)
+
+	return headerColor.Sprint("╭"+border+"╮\n") +
+		headerColor.Sprintf("│  %s%-*s │\n", translated, width, truncateString(value, width)) +
+		headerColor.Sprint("╰"+border+"╯\n\n")
+}
+
+// explainScores enables the itemized score breakdown in table output
+var explainScores = false
+
+// SetExplainScores enables listing, in table output, each scoring rule that fired
+// with the points it added and its evidence
+func SetExplainScores(explain bool) {
+	explainScores = explain
+}
+
+// mergeFlags collapses identical suspicion indicators in table output
+var mergeFlags = false
+
+// SetMergeFlags enables showing each suspicion indicator once in table output,
+// with the number of times it was raised, instead of repeating identical lines
+func SetMergeFlags(merge bool) {
+	mergeFlags = merge
+}
+
+// formatFlagLines renders suspicion flags as indicator lines, describing each
+// with describe. With merging enabled, identical lines are shown once, where
+// they first appear, followed by their count.
+func formatFlagLines(flags []string, describe func(flag string) string) string {
+	var texts []string
+	counts := make(map[string]int)
+	for _, flag := range flags {
+		text := describe(flag)
+		counts[text]++
+		if !mergeFlags || counts[text] == 1 {
+			texts = append(texts, text)
+		}
+	}
+
+	var output strings.Builder
+	for _, text := range texts {
+		output.WriteString("🔸 " + warningColor.Sprint(text))
+		if mergeFlags && counts[text] > 1 {
+			output.WriteString(secondaryColor.Sprintf(" ×%d", counts[text]))
+		}
+		output.WriteString("\n")
+	}
+	return output.String()
+}
+
+// formatScoreBreakdown renders the rules that added up to a suspicion score, or
+// nothing when explanations are disabled
+func formatScoreBreakdown(breakdown *models.ScoreBreakdown) string {
+	if !explainScores || breakdown == nil {
+		return ""
+	}
+
+	var output strings.Builder
+	output.WriteString(headerColor.Sprint("🧮 " + tr("SCORE BREAKDOWN") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	if len(breakdown.Items) == 0 {
+		output.WriteString(secondaryColor.Sprint("No scoring rule fired\n\n"))
+		return output.String()
+	}
+
+	for _, item := range breakdown.Items {
+		output.WriteString(fmt.Sprintf("  %s %-*s %s\n",
+			warningColor.Sprintf("%+4d", item.Points),
+			scaleWidth(32), truncateString(item.Rule, scaleWidth(32)),
+			secondaryColor.Sprint(item.Evidence)))
+	}
+
+	output.WriteString(fmt.Sprintf("  %s = %d", strings.Repeat("─", 4), breakdown.RawScore))
+	if breakdown.RawScore != breakdown.FinalScore {
+		output.WriteString(fmt.Sprintf(", capped at %d", breakdown.FinalScore))
+	}
+	output.WriteString("\n\n")
+
+	return output.String()
+}
+
+// formatCoverage renders which optional analyses ran and which did not, with
+// the reason, so that a report without findings tells "clean" from "not checked"
+func formatCoverage(checks []models.CoverageCheck) string {
+	if len(checks) == 0 {
+		return ""
+	}
+
+	var output strings.Builder
+	output.WriteString(headerColor.Sprint("🧭 " + tr("ANALYSIS COVERAGE") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	var ran []string
+	for _, check := range checks {
+		if check.Status == models.CheckRan {
+			ran = append(ran, check.Check)
+		}
+	}
+	if len(ran) > 0 {
+		output.WriteString("✅ " + label("Ran:", 14) + successColor.Sprint(strings.Join(ran, ", ")) + "\n")
+	}
+
+	for _, check := range checks {
+		switch check.Status {
+		case models.CheckSkipped:
+			output.WriteString("⏭️  " + label("Skipped:", 14) + check.Check + secondaryColor.Sprintf(" (%s)", check.Reason) + "\n")
+		case models.CheckUnavailable:
+			output.WriteString("⚠️  " + label("Unavailable:", 14) + warningColor.Sprint(check.Check) + secondaryColor.Sprintf(" (%s)", check.Reason) + "\n")
+		}
+	}
+	output.WriteString("\n")
+
+	return output.String()
+}
+
+// Change tags worth highlighting: danger tags mark edits that were undone or flagged
+// by an edit filter, warning tags mark reverts and content removal
+var (
+	dangerRevisionTags  = []string{"mw-reverted", "possible vandalism", "possible libel or vandalism", "repeating characters"}
+	warningRevisionTags = []string{"mw-rollback", "mw-undo", "mw-manual-revert", "mw-blank", "mw-replace", "blanking", "section blanking"}
+)
+
+// formatRevisionTags renders the change tags of a revision as " [tag1, tag2]",
+// coloring notable tags, or nothing for untagged revisions
+func formatRevisionTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+
+	rendered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		switch {
+		case utils.Contains(dangerRevisionTags, tag):
+			rendered = append(rendered, dangerColor.Sprint(tag))
+		case utils.Contains(warningRevisionTags, tag):
+			rendered = append(rendered, warningColor.Sprint(tag))
+		default:
+			rendered = append(rendered, secondaryColor.Sprint(tag))
+		}
+	}
+
+	return " [" + strings.Join(rendered, ", ") + "]"
+}
+
+// truncateString truncates a string to the specified number of characters,
+// ellipsis included. It counts runes rather than bytes so multibyte titles and
+// usernames (Cyrillic, Arabic, CJK...) are never cut in the middle of a character.
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	if utf8.RuneCountInString(s) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	runes := []rune(s)
+	if maxLen <= 3 {
+		return string(runes[:utils.Max(0, maxLen)])
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
+
+// TruncateString truncates a string to maxLen characters like the reports do
+func TruncateString(s string, maxLen int) string {
+	return truncateString(s, maxLen)
 }
@@ -2,14 +2,12 @@
 package formatter
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/intMeric/wikipedia-analyser/internal/models"
-	"gopkg.in/yaml.v2"
 )
 
 // FormatContributionProfile formats the contribution profile according to the specified format
@@ -21,14 +19,16 @@ func FormatContributionProfile(profile *models.ContributionProfile, format strin
 		return formatContributionAsYAML(profile)
 	case "table", "":
 		return formatContributionAsTable(profile), nil
+	case "plain":
+		return plainText(formatContributionAsTable(profile)), nil
 	default:
-		return "", fmt.Errorf("unsupported format: %s (supported: table, json, yaml)", format)
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
 	}
 }
 
 // formatContributionAsJSON formats contribution profile as JSON
 func formatContributionAsJSON(profile *models.ContributionProfile) (string, error) {
-	data, err := json.MarshalIndent(profile, "", "  ")
+	data, err := marshalJSON(profile)
 	if err != nil {
 		return "", fmt.Errorf("JSON formatting error: %w", err)
 	}
@@ -37,7 +37,7 @@ func formatContributionAsJSON(profile *models.ContributionProfile) (string, erro
 
 // formatContributionAsYAML formats contribution profile as YAML
 func formatContributionAsYAML(profile *models.ContributionProfile) (string, error) {
-	data, err := yaml.Marshal(profile)
+	data, err := marshalYAML(profile)
 	if err != nil {
 		return "", fmt.Errorf("YAML formatting error: %w", err)
 	}
@@ -49,248 +49,275 @@ func formatContributionAsTable(profile *models.ContributionProfile) string {
 	var output strings.Builder
 
 	// Header with revision ID and suspicion score
-	output.WriteString(headerColor.Sprint("╭─────────────────────────────────────────────────────────────╮\n"))
-	output.WriteString(headerColor.Sprintf("│  📝 CONTRIBUTION ANALYSIS: Revision %-18d        │\n", profile.RevisionID))
-	output.WriteString(headerColor.Sprint("╰─────────────────────────────────────────────────────────────╯\n\n"))
+	output.WriteString(boxHeader("📝 CONTRIBUTION ANALYSIS: Revision ", fmt.Sprint(profile.RevisionID), 25))
 
 	// Suspicion score with color
 	suspicionText := getSuspicionText(profile.SuspicionScore)
 	suspicionColor := getSuspicionColor(profile.SuspicionScore)
 	output.WriteString(fmt.Sprintf("🚨 %s %s (%d/100)\n\n",
-		suspicionColor.Sprint("Suspicion Score:"),
+		suspicionColor.Sprint(tr("Suspicion Score:")),
 		suspicionColor.Sprint(suspicionText),
 		profile.SuspicionScore))
+	output.WriteString(formatCoverage(profile.Coverage))
+	output.WriteString(formatScoreBreakdown(profile.ScoreBreakdown))
 
 	// Basic information
-	output.WriteString(headerColor.Sprint("📋 CONTRIBUTION INFORMATION\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("📋 " + tr("CONTRIBUTION INFORMATION") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
-	output.WriteString("📝 Revision ID:        " + strconv.Itoa(profile.RevisionID) + "\n")
-	output.WriteString("📄 Page:               " + profile.PageTitle + "\n")
-	output.WriteString("🌍 Language:           " + profile.Language + "\n")
-	output.WriteString("⏰ Timestamp:          " + profile.Timestamp.Format("02/01/2006 15:04:05") + "\n")
-	output.WriteString("📏 Size:               " + strconv.Itoa(profile.Size) + " bytes\n")
+	output.WriteString("📝 " + label("Revision ID:", 20) + strconv.Itoa(profile.RevisionID) + "\n")
+	output.WriteString("📄 " + label("Page:", 20) + profile.PageTitle + "\n")
+	output.WriteString("🌍 " + label("Language:", 20) + profile.Language + "\n")
+	output.WriteString("⏰ " + label("Timestamp:", 20) + formatTimestamp(profile.Timestamp, "02/01/2006 15:04:05") + "\n")
+	output.WriteString("📏 " + label("Size:", 20) + strconv.Itoa(profile.Size) + " bytes\n")
 
 	if profile.IsMinor {
-		output.WriteString("🔍 Edit Type:          " + infoColor.Sprint("Minor edit") + "\n")
+		output.WriteString("🔍 " + label("Edit Type:", 20) + infoColor.Sprint("Minor edit") + "\n")
 	} else {
-		output.WriteString("🔍 Edit Type:          " + "Major edit" + "\n")
+		output.WriteString("🔍 " + label("Edit Type:", 20) + "Major edit" + "\n")
 	}
 
-	if profile.IsRevert {
-		output.WriteString("🔄 Revert Status:      " + warningColor.Sprint("This is a revert") + "\n")
+	if profile.ToolAssistedRevert {
+		output.WriteString("🔄 " + label("Revert Status:", 20) + warningColor.Sprint("This is a revert") + secondaryColor.Sprintf(" (tool-assisted, %ds after the edit)", profile.RevertDelaySeconds) + "\n")
+	} else if profile.IsRevert {
+		output.WriteString("🔄 " + label("Revert Status:", 20) + warningColor.Sprint("This is a revert") + "\n")
 	} else {
-		output.WriteString("🔄 Revert Status:      " + successColor.Sprint("Regular edit") + "\n")
+		output.WriteString("🔄 " + label("Revert Status:", 20) + successColor.Sprint("Regular edit") + "\n")
 	}
 
 	// Format comment
 	comment := profile.Comment
 	if comment == "" {
 		comment = secondaryColor.Sprint("(no comment)")
-	} else if len(comment) > 80 {
-		comment = comment[:80] + "..."
+	} else {
+		comment = truncateString(comment, scaleWidth(83))
+	}
+	output.WriteString("💬 " + label("Comment:", 20) + comment + "\n")
+	if len(profile.Tags) > 0 {
+		output.WriteString("🏷️  " + label("Tags:", 19) + strings.TrimPrefix(formatRevisionTags(profile.Tags), " ") + "\n")
 	}
-	output.WriteString("💬 Comment:            " + comment + "\n")
 	output.WriteString("\n")
 
 	// Suspicion flags
 	if len(profile.SuspicionFlags) > 0 {
-		output.WriteString(warningColor.Sprint("⚠️  SUSPICION INDICATORS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
-		for _, flag := range profile.SuspicionFlags {
-			flagText := formatContributionSuspicionFlag(flag)
-			output.WriteString(fmt.Sprintf("🔸 %s\n", warningColor.Sprint(flagText)))
-		}
+		output.WriteString(warningColor.Sprint("⚠️  " + tr("SUSPICION INDICATORS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		output.WriteString(formatFlagLines(profile.SuspicionFlags, formatContributionSuspicionFlag))
 		output.WriteString("\n")
 	}
 
 	// Author analysis
-	output.WriteString(headerColor.Sprint("👤 AUTHOR ANALYSIS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("👤 " + tr("AUTHOR ANALYSIS") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
 	author := profile.Author
-	output.WriteString("👤 Username:           " + author.Username + "\n")
+	output.WriteString("👤 " + label("Username:", 20) + author.Username + "\n")
 
-	if author.IsAnonymous {
-		output.WriteString("🌐 User Type:          " + secondaryColor.Sprint("Anonymous IP") + "\n")
+	if author.IsHidden {
+		output.WriteString("🌐 " + label("User Type:", 20) + secondaryColor.Sprint("Hidden (revision-deleted)") + "\n")
+	} else if author.IsAnonymous {
+		output.WriteString("🌐 " + label("User Type:", 20) + secondaryColor.Sprint("Anonymous IP") + "\n")
 	} else {
-		output.WriteString("🌐 User Type:          " + "Registered user\n")
-		output.WriteString("🆔 User ID:            " + strconv.Itoa(author.UserID) + "\n")
-		output.WriteString("✏️ Total Edits:        " + strconv.Itoa(author.EditCount) + "\n")
+		output.WriteString("🌐 " + label("User Type:", 20) + "Registered user\n")
+		output.WriteString("🆔 " + label("User ID:", 20) + strconv.Itoa(author.UserID) + "\n")
+		output.WriteString("✏️ " + label("Total Edits:", 20) + strconv.Itoa(author.EditCount) + "\n")
 
 		if author.RegistrationDate != nil {
-			regDate := author.RegistrationDate.Format("02/01/2006")
-			daysSince := int(time.Since(*author.RegistrationDate).Hours() / 24)
-			output.WriteString(fmt.Sprintf("📅 Registration:       %s (%d days ago)\n", regDate, daysSince))
+			output.WriteString("📅 " + label("Registration:", 20) + formatDatedAge(*author.RegistrationDate, "02/01/2006"))
+			if author.RegistrationEst {
+				output.WriteString(secondaryColor.Sprint(" (estimated from first edit)"))
+			}
+			output.WriteString("\n")
 
 			// New account warning
-			if daysSince < 30 {
-				output.WriteString("⚠️  Account Age:        " + warningColor.Sprint("Very new account") + "\n")
+			if time.Since(*author.RegistrationDate) < 30*24*time.Hour {
+				output.WriteString("⚠️  " + label("Account Age:", 20) + warningColor.Sprint("Very new account") + "\n")
 			}
 		}
 
 		if len(author.Groups) > 0 {
-			output.WriteString("👥 Groups:             " + strings.Join(author.Groups, ", ") + "\n")
+			output.WriteString("👥 " + label("Groups:", 20) + strings.Join(author.Groups, ", ") + "\n")
 		}
 
 		if author.IsBlocked {
-			output.WriteString("🚫 Status:             " + dangerColor.Sprint("BLOCKED") + "\n")
+			output.WriteString("🚫 " + label("Status:", 20) + dangerColor.Sprint("BLOCKED") + "\n")
 		}
 
 		// Author suspicion score
 		if author.SuspicionScore > 0 {
 			authorSuspicionText := getSuspicionText(author.SuspicionScore)
 			authorSuspicionColor := getSuspicionColor(author.SuspicionScore)
-			output.WriteString(fmt.Sprintf("🚨 Author Suspicion:   %s (%d/100)\n",
+			output.WriteString(fmt.Sprintf("🚨 %s%s (%d/100)\n", label("Author Suspicion:", 20),
 				authorSuspicionColor.Sprint(authorSuspicionText),
 				author.SuspicionScore))
+		} else if author.ProfileSkipped {
+			output.WriteString("🚨 " + label("Author Suspicion:", 20) + secondaryColor.Sprint(tr("not computed (author profile budget reached)")) + "\n")
+		} else if author.ProfileError != "" {
+			output.WriteString("🚨 " + label("Author Suspicion:", 20) + warningColor.Sprint(tr("not computed (author profile failed)")) + "\n")
 		}
 	}
 	output.WriteString("\n")
 
 	// Recent activity
-	if !author.IsAnonymous {
-		output.WriteString(headerColor.Sprint("📊 RECENT ACTIVITY\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
+	if !author.IsAnonymous && !author.IsHidden {
+		output.WriteString(headerColor.Sprint("📊 " + tr("RECENT ACTIVITY") + "\n"))
+		output.WriteString(separator(50) + "\n")
 
 		activity := author.RecentActivity
-		output.WriteString("📅 Last 24h:           " + strconv.Itoa(activity.EditsLast24h) + " edits\n")
-		output.WriteString("📅 Last 7 days:        " + strconv.Itoa(activity.EditsLast7d) + " edits\n")
-		output.WriteString("📅 Last 30 days:       " + strconv.Itoa(activity.EditsLast30d) + " edits\n")
-		output.WriteString("📄 Pages edited:       " + strconv.Itoa(activity.PagesEdited) + " pages\n")
+		output.WriteString("📅 " + label("Last 24h:", 20) + pluralf(activity.EditsLast24h, "%d edit", "%d edits") + "\n")
+		output.WriteString("📅 " + label("Last 7 days:", 20) + pluralf(activity.EditsLast7d, "%d edit", "%d edits") + "\n")
+		output.WriteString("📅 " + label("Last 30 days:", 20) + pluralf(activity.EditsLast30d, "%d edit", "%d edits") + "\n")
+		output.WriteString("📄 " + label("Pages edited:", 20) + pluralf(activity.PagesEdited, "%d page", "%d pages") + "\n")
 
 		// Activity intensity warnings
 		if activity.EditsLast24h > 50 {
-			output.WriteString("⚠️  Activity Level:     " + warningColor.Sprint("Very high (>50/day)") + "\n")
+			output.WriteString("⚠️  " + label("Activity Level:", 20) + warningColor.Sprint("Very high (>50/day)") + "\n")
 		} else if activity.EditsLast24h > 20 {
-			output.WriteString("⚠️  Activity Level:     " + infoColor.Sprint("High (>20/day)") + "\n")
+			output.WriteString("⚠️  " + label("Activity Level:", 20) + infoColor.Sprint("High (>20/day)") + "\n")
 		}
 
 		if activity.LastEditTime != nil {
 			timeSince := time.Since(*activity.LastEditTime)
+			lastEdit := formatTimeAgo(*activity.LastEditTime, "02/01/2006 15:04")
 			if timeSince < time.Hour {
-				output.WriteString("🕒 Last Edit:          " + warningColor.Sprintf("%d minutes ago", int(timeSince.Minutes())) + "\n")
+				lastEdit = warningColor.Sprint(lastEdit)
 			} else if timeSince < 24*time.Hour {
-				output.WriteString("🕒 Last Edit:          " + infoColor.Sprintf("%d hours ago", int(timeSince.Hours())) + "\n")
-			} else {
-				output.WriteString("🕒 Last Edit:          " + fmt.Sprintf("%d days ago", int(timeSince.Hours()/24)) + "\n")
+				lastEdit = infoColor.Sprint(lastEdit)
 			}
+			output.WriteString("🕒 " + label("Last Edit:", 20) + lastEdit + "\n")
 		}
 		output.WriteString("\n")
 	}
 
 	// Content analysis
-	output.WriteString(headerColor.Sprint("📝 CONTENT ANALYSIS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("📝 " + tr("CONTENT ANALYSIS") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
 	content := profile.ContentAnalysis
-	output.WriteString("📂 Content Type:       " + formatContentType(content.ContentType) + "\n")
+	output.WriteString("📂 " + label("Content Type:", 20) + formatContentType(content.ContentType) + "\n")
 
 	changes := content.TextChanges
 	if changes.CharsAdded > 0 {
-		output.WriteString("➕ Characters Added:   " + successColor.Sprint(strconv.Itoa(changes.CharsAdded)) + "\n")
+		output.WriteString("➕ " + label("Characters Added:", 20) + successColor.Sprint(strconv.Itoa(changes.CharsAdded)) + "\n")
 	}
 	if changes.CharsRemoved > 0 {
-		output.WriteString("➖ Characters Removed: " + warningColor.Sprint(strconv.Itoa(changes.CharsRemoved)) + "\n")
+		output.WriteString("➖ " + label("Characters Removed:", 20) + warningColor.Sprint(strconv.Itoa(changes.CharsRemoved)) + "\n")
 	}
 
 	if changes.WordsAdded > 0 {
-		output.WriteString("📝 Words Added:        " + strconv.Itoa(changes.WordsAdded) + "\n")
+		output.WriteString("📝 " + label("Words Added:", 20) + strconv.Itoa(changes.WordsAdded) + "\n")
 	}
 	if changes.WordsRemoved > 0 {
-		output.WriteString("📝 Words Removed:      " + strconv.Itoa(changes.WordsRemoved) + "\n")
+		output.WriteString("📝 " + label("Words Removed:", 20) + strconv.Itoa(changes.WordsRemoved) + "\n")
 	}
 
-	if changes.IsStructural {
-		output.WriteString("🏗️  Change Type:        " + infoColor.Sprint("Structural changes") + "\n")
+	if changes.MisleadingSummary {
+		output.WriteString("🏗️  " + label("Change Type:", 20) + dangerColor.Sprint("Content changes under a typo/format summary") + "\n")
+	} else if changes.IsCosmetic {
+		output.WriteString("🏗️  " + label("Change Type:", 20) + secondaryColor.Sprint("Cosmetic only (rendered text unchanged)") + "\n")
+	} else if changes.IsStructural {
+		output.WriteString("🏗️  " + label("Change Type:", 20) + infoColor.Sprint("Structural changes") + "\n")
 	} else if changes.IsTrivial {
-		output.WriteString("🏗️  Change Type:        " + secondaryColor.Sprint("Trivial changes") + "\n")
+		output.WriteString("🏗️  " + label("Change Type:", 20) + secondaryColor.Sprint("Trivial changes") + "\n")
 	} else {
-		output.WriteString("🏗️  Change Type:        " + "Content changes" + "\n")
+		output.WriteString("🏗️  " + label("Change Type:", 20) + "Content changes" + "\n")
+	}
+
+	if changes.StealthPOV {
+		output.WriteString("🕵️  " + label("Stealth Changes:", 20) + dangerColor.Sprint(strings.Join(changes.StealthChanges, "; ")) + "\n")
+	}
+
+	if len(changes.RemovedMaintenanceTags) > 0 {
+		tags := "{{" + strings.Join(changes.RemovedMaintenanceTags, "}}, {{") + "}}"
+		if changes.MaintenanceAddressed {
+			output.WriteString("🏷️  " + label("Tags Removed:", 20) + tags + secondaryColor.Sprint(" (issue addressed)") + "\n")
+		} else {
+			output.WriteString("🏷️  " + label("Tags Removed:", 20) + dangerColor.Sprint(tags+" (issue not addressed)") + "\n")
+		}
 	}
 
 	if len(changes.SectionsAffected) > 0 {
-		output.WriteString("📋 Sections Affected:  " + strings.Join(changes.SectionsAffected, ", ") + "\n")
+		output.WriteString("📋 " + label("Sections Affected:", 20) + strings.Join(changes.SectionsAffected, ", ") + "\n")
 	}
 
 	// Language analysis
 	language := content.LanguageAnalysis
 	if len(language.POVWords) > 0 {
-		output.WriteString("⚠️  POV Words Found:    " + warningColor.Sprint(strings.Join(language.POVWords, ", ")) + "\n")
-		output.WriteString(fmt.Sprintf("📊 Bias Score:         %.2f/1.00\n", language.BiasScore))
+		output.WriteString("⚠️  " + label("POV Words Found:", 20) + warningColor.Sprint(strings.Join(language.POVWords, ", ")) + "\n")
+		output.WriteString(fmt.Sprintf("📊 %s%.2f/1.00\n", label("Bias Score:", 20), language.BiasScore))
 	}
 
-	output.WriteString("🎭 Tone Analysis:      " + formatToneAnalysis(language.ToneAnalysis) + "\n")
+	output.WriteString("🎭 " + label("Tone Analysis:", 20) + formatToneAnalysis(language.ToneAnalysis) + "\n")
 	output.WriteString("\n")
 
 	// Quality metrics
-	output.WriteString(headerColor.Sprint("🏆 QUALITY METRICS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("🏆 " + tr("QUALITY METRICS") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
 	quality := profile.QualityMetrics
-	output.WriteString(fmt.Sprintf("📊 Overall Quality:    %.2f/1.00\n", quality.OverallQuality))
-	output.WriteString(fmt.Sprintf("📝 Content Quality:    %.2f/1.00\n", quality.ContentQuality.Accuracy))
-	output.WriteString(fmt.Sprintf("📚 Source Quality:     %.2f/1.00\n", quality.SourceQuality.ReliabilityScore))
-	output.WriteString(fmt.Sprintf("🏗️  Structure Quality:  %.2f/1.00\n", quality.StructureQuality.Formatting))
-	output.WriteString(fmt.Sprintf("📋 Policy Compliance:  %.2f/1.00\n", quality.ComplianceScore.PolicyCompliance))
+	output.WriteString(fmt.Sprintf("📊 %s%.2f/1.00\n", label("Overall Quality:", 20), quality.OverallQuality))
+	output.WriteString(fmt.Sprintf("📝 %s%.2f/1.00\n", label("Content Quality:", 20), quality.ContentQuality.Accuracy))
+	output.WriteString(fmt.Sprintf("📚 %s%.2f/1.00\n", label("Source Quality:", 20), quality.SourceQuality.ReliabilityScore))
+	output.WriteString(fmt.Sprintf("🏗️  %s%.2f/1.00\n", label("Structure Quality:", 20), quality.StructureQuality.Formatting))
+	output.WriteString(fmt.Sprintf("📋 %s%.2f/1.00\n", label("Policy Compliance:", 20), quality.ComplianceScore.PolicyCompliance))
 
 	// Risk assessment
 	compliance := quality.ComplianceScore
 	if compliance.VandalismRisk > 0.3 {
-		output.WriteString("⚠️  Vandalism Risk:     " + dangerColor.Sprintf("%.1f%% (HIGH)", compliance.VandalismRisk*100) + "\n")
+		output.WriteString("⚠️  " + label("Vandalism Risk:", 20) + dangerColor.Sprintf("%.1f%% (HIGH)", compliance.VandalismRisk*100) + "\n")
 	} else if compliance.VandalismRisk > 0.1 {
-		output.WriteString("⚠️  Vandalism Risk:     " + warningColor.Sprintf("%.1f%% (MODERATE)", compliance.VandalismRisk*100) + "\n")
+		output.WriteString("⚠️  " + label("Vandalism Risk:", 20) + warningColor.Sprintf("%.1f%% (MODERATE)", compliance.VandalismRisk*100) + "\n")
 	} else {
-		output.WriteString("⚠️  Vandalism Risk:     " + successColor.Sprintf("%.1f%% (LOW)", compliance.VandalismRisk*100) + "\n")
+		output.WriteString("⚠️  " + label("Vandalism Risk:", 20) + successColor.Sprintf("%.1f%% (LOW)", compliance.VandalismRisk*100) + "\n")
 	}
 
 	if len(compliance.ViolatedPolicies) > 0 {
-		output.WriteString("🚫 Policy Violations:  " + dangerColor.Sprint(strings.Join(compliance.ViolatedPolicies, ", ")) + "\n")
+		output.WriteString("🚫 " + label("Policy Violations:", 20) + dangerColor.Sprint(strings.Join(compliance.ViolatedPolicies, ", ")) + "\n")
 	}
 	output.WriteString("\n")
 
 	// Context analysis (if available)
 	if profile.ContextAnalysis.PageContext.Controversiality > 0 {
-		output.WriteString(headerColor.Sprint("🌍 CONTEXT ANALYSIS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
+		output.WriteString(headerColor.Sprint("🌍 " + tr("CONTEXT ANALYSIS") + "\n"))
+		output.WriteString(separator(50) + "\n")
 
 		context := profile.ContextAnalysis
 		pageContext := context.PageContext
 
 		if pageContext.Controversiality > 0.5 {
-			output.WriteString("📄 Page Controversy:   " + warningColor.Sprintf("%.1f%% (HIGH)", pageContext.Controversiality*100) + "\n")
+			output.WriteString("📄 " + label("Page Controversy:", 20) + warningColor.Sprintf("%.1f%% (HIGH)", pageContext.Controversiality*100) + "\n")
 		} else {
-			output.WriteString("📄 Page Controversy:   " + successColor.Sprintf("%.1f%% (LOW)", pageContext.Controversiality*100) + "\n")
+			output.WriteString("📄 " + label("Page Controversy:", 20) + successColor.Sprintf("%.1f%% (LOW)", pageContext.Controversiality*100) + "\n")
 		}
 
 		timing := context.TimingContext
-		output.WriteString(fmt.Sprintf("🕐 Edit Hour:          %02d:00\n", timing.EditHour))
+		output.WriteString(fmt.Sprintf("🕐 %s%02d:00\n", label("Edit Hour:", 20), timing.EditHour))
 
 		if timing.IsWeekend {
-			output.WriteString("📅 Edit Day:           " + infoColor.Sprint("Weekend") + "\n")
+			output.WriteString("📅 " + label("Edit Day:", 20) + infoColor.Sprint("Weekend") + "\n")
 		} else {
-			output.WriteString("📅 Edit Day:           " + "Weekday" + "\n")
+			output.WriteString("📅 " + label("Edit Day:", 20) + "Weekday" + "\n")
 		}
 
 		if timing.TimeSinceLastEdit > 0 {
 			if timing.TimeSinceLastEdit < 60 {
-				output.WriteString("⏱️  Time Since Last:    " + warningColor.Sprintf("%d minutes (RAPID)", timing.TimeSinceLastEdit) + "\n")
+				output.WriteString("⏱️  " + label("Time Since Last:", 20) + warningColor.Sprintf("%d minutes (RAPID)", timing.TimeSinceLastEdit) + "\n")
 			} else if timing.TimeSinceLastEdit < 1440 { // 24 hours
-				output.WriteString("⏱️  Time Since Last:    " + fmt.Sprintf("%d minutes", timing.TimeSinceLastEdit) + "\n")
+				output.WriteString("⏱️  " + label("Time Since Last:", 20) + fmt.Sprintf("%d minutes", timing.TimeSinceLastEdit) + "\n")
 			} else {
 				days := timing.TimeSinceLastEdit / 1440
-				output.WriteString("⏱️  Time Since Last:    " + fmt.Sprintf("%d days", days) + "\n")
+				output.WriteString("⏱️  " + label("Time Since Last:", 20) + fmt.Sprintf("%d days", days) + "\n")
 			}
 		}
 
 		if context.ConflictContext.IsContested {
-			output.WriteString("⚔️  Conflict Status:    " + warningColor.Sprint("CONTESTED EDIT") + "\n")
-			output.WriteString(fmt.Sprintf("📊 Conflict Severity:  %.1f/1.0\n", context.ConflictContext.ConflictSeverity))
+			output.WriteString("⚔️  " + label("Conflict Status:", 20) + warningColor.Sprint("CONTESTED EDIT") + "\n")
+			output.WriteString(fmt.Sprintf("📊 %s%.1f/1.0\n", label("Conflict Severity:", 20), context.ConflictContext.ConflictSeverity))
 		}
 
 		// Related edits
 		if len(context.RelatedEdits) > 0 {
-			output.WriteString(fmt.Sprintf("🔗 Related Edits:      %d found\n", len(context.RelatedEdits)))
+			output.WriteString(fmt.Sprintf("🔗 %s%d found\n", label("Related Edits:", 20), len(context.RelatedEdits)))
 
 			// Show top 3 related edits
 			for i, related := range context.RelatedEdits {
@@ -305,20 +332,22 @@ func formatContributionAsTable(profile *models.ContributionProfile) string {
 	}
 
 	// Recommendations
-	output.WriteString(headerColor.Sprint("💡 RECOMMENDATIONS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("💡 " + tr("RECOMMENDATIONS") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
-	if profile.SuspicionScore >= 70 {
-		output.WriteString(dangerColor.Sprint("🚨 HIGH RISK CONTRIBUTION\n"))
+	risk := getRiskSeverity(profile.SuspicionScore)
+	switch risk.Level {
+	case "HIGH":
+		output.WriteString(risk.Color.Sprint(risk.Icon + " HIGH RISK CONTRIBUTION\n"))
 		output.WriteString("   • Investigate this edit immediately\n")
 		output.WriteString("   • Check author's other recent contributions\n")
 		output.WriteString("   • Consider reverting if problematic\n")
-	} else if profile.SuspicionScore >= 40 {
-		output.WriteString(warningColor.Sprint("⚠️ MODERATE RISK CONTRIBUTION\n"))
+	case "MODERATE":
+		output.WriteString(risk.Color.Sprint(risk.Icon + " MODERATE RISK CONTRIBUTION\n"))
 		output.WriteString("   • Monitor this edit for issues\n")
 		output.WriteString("   • Review content for policy compliance\n")
-	} else {
-		output.WriteString(successColor.Sprint("✅ LOW RISK CONTRIBUTION\n"))
+	default:
+		output.WriteString(risk.Color.Sprint(risk.Icon + " LOW RISK CONTRIBUTION\n"))
 		output.WriteString("   • Edit appears to be constructive\n")
 		output.WriteString("   • Continue normal monitoring\n")
 	}
@@ -339,6 +368,8 @@ func formatContributionSuspicionFlag(flag string) string {
 	switch flag {
 	case "REVERT_EDIT":
 		return "This edit is a revert of previous content"
+	case "TOOL_ASSISTED_REVERT":
+		return "This revert was made within seconds, with a patrolling tool"
 	case "RAPID_EDITING":
 		return "Author shows rapid editing patterns"
 	case "ANONYMOUS_EDIT":
@@ -353,6 +384,14 @@ func formatContributionSuspicionFlag(flag string) string {
 		return "Significant content removal"
 	case "BLOCKED_USER":
 		return "Edit made by currently blocked user"
+	case "COSMETIC_ONLY":
+		return "Cosmetic-only edit (whitespace/markup, rendered text unchanged)"
+	case "MISLEADING_SUMMARY":
+		return "Summary describes a typo/format fix but the edit changes content"
+	case "STEALTH_POV":
+		return "Tiny diff changes a loaded word, a negation or a key figure"
+	case "MAINTENANCE_TAG_REMOVAL":
+		return "Removes maintenance tags without fixing the issue they point out"
 	default:
 		return flag
 	}
@@ -369,6 +408,8 @@ func formatContentType(contentType string) string {
 		return "Structural modification"
 	case "minor_edit":
 		return "Minor edit"
+	case "cosmetic_only":
+		return "Cosmetic only (whitespace/markup)"
 	case "content_edit":
 		return "Content modification"
 	default:
@@ -0,0 +1,65 @@
+// internal/formatter/estimate.go
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// FormatCallEstimate formats a dry-run API call estimate according to the specified format
+func FormatCallEstimate(estimate *models.CallEstimate, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		data, err := marshalJSON(estimate)
+		if err != nil {
+			return "", fmt.Errorf("JSON formatting error: %w", err)
+		}
+		return string(data), nil
+	case "yaml", "yml":
+		data, err := marshalYAML(estimate)
+		if err != nil {
+			return "", fmt.Errorf("YAML formatting error: %w", err)
+		}
+		return string(data), nil
+	case "table", "":
+		return formatCallEstimateAsTable(estimate), nil
+	case "plain":
+		return plainText(formatCallEstimateAsTable(estimate)), nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
+	}
+}
+
+// formatCallEstimateAsTable formats the estimate as a readable table
+func formatCallEstimateAsTable(estimate *models.CallEstimate) string {
+	var output strings.Builder
+
+	output.WriteString(boxHeader("🧮 DRY RUN: ", estimate.Target, 47))
+
+	output.WriteString(headerColor.Sprint("📡 " + tr("EXPECTED API REQUESTS") + "\n"))
+	output.WriteString(separator(80) + "\n")
+	for _, step := range estimate.Steps {
+		calls := fmt.Sprintf("%d", step.Calls)
+		if step.UpperBound {
+			calls = "≤ " + calls
+		}
+		line := fmt.Sprintf("%-*s %8s", scaleWidth(48), truncateString(step.Step, scaleWidth(48)), calls)
+		if step.Note != "" {
+			line += secondaryColor.Sprint("  " + step.Note)
+		}
+		output.WriteString(line + "\n")
+	}
+	output.WriteString(separator(80) + "\n")
+
+	total := pluralf(estimate.Total, "%d request", "%d requests")
+	if estimate.UpperBound {
+		total = fmt.Sprintf(tr("at most %s"), total)
+	}
+	output.WriteString("🎯 " + label("Total:", 20) + warningColor.Sprint(total) + "\n")
+	output.WriteString(secondaryColor.Sprint("   " + tr("Nothing was sent.") + "\n   " +
+		tr("Retries (maxlag) come on top: lower the analysis limits, or set --max-api-calls, to reduce the load.") + "\n\n"))
+
+	return output.String()
+}
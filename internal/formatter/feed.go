@@ -0,0 +1,133 @@
+// internal/formatter/feed.go
+package formatter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// atomNamespace is the XML namespace of Atom 1.0 documents (RFC 4287)
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+// atomFeed is the subset of an Atom 1.0 feed written by watch mode
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	XMLNS   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID      string     `xml:"id"`
+	Title   string     `xml:"title"`
+	Updated string     `xml:"updated"`
+	Author  atomAuthor `xml:"author"`
+	Link    atomLink   `xml:"link"`
+	Summary string     `xml:"summary"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+// FormatAtomFeed renders the suspicious revisions found on a watched page as an
+// Atom feed, newest entries first
+func FormatAtomFeed(pageTitle, language string, profiles []*models.ContributionProfile, updated time.Time) (string, error) {
+	pageURL := fmt.Sprintf("https://%s.wikipedia.org/wiki/%s", language, strings.ReplaceAll(pageTitle, " ", "_"))
+
+	feed := atomFeed{
+		XMLNS:   atomNamespace,
+		ID:      pageURL,
+		Title:   fmt.Sprintf("WikiOSINT: suspicious edits to %s (%s)", pageTitle, language),
+		Updated: updated.UTC().Format(time.RFC3339),
+		Link:    atomLink{Href: pageURL},
+	}
+
+	for i := len(profiles) - 1; i >= 0; i-- {
+		profile := profiles[i]
+		diffURL := client.DiffURL(language, profile.RevisionID)
+
+		author := profile.Author.Username
+		if author == "" {
+			author = "(hidden)"
+		}
+
+		feed.Entries = append(feed.Entries, atomEntry{
+			ID:      diffURL,
+			Title:   fmt.Sprintf("%s: revision %d by %s (score %d/100)", profile.PageTitle, profile.RevisionID, author, profile.SuspicionScore),
+			Updated: profile.Timestamp.UTC().Format(time.RFC3339),
+			Author:  atomAuthor{Name: author},
+			Link:    atomLink{Href: diffURL, Rel: "alternate"},
+			Summary: feedEntrySummary(profile),
+		})
+	}
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("error generating Atom feed: %w", err)
+	}
+	output := xml.Header + string(data) + "\n"
+
+	if err := validateAtomFeed(output); err != nil {
+		return "", err
+	}
+
+	return output, nil
+}
+
+// feedEntrySummary lists the suspicion flags of a revision in plain text
+func feedEntrySummary(profile *models.ContributionProfile) string {
+	summary := fmt.Sprintf("Suspicion score %d/100.", profile.SuspicionScore)
+	if len(profile.SuspicionFlags) > 0 {
+		var flags []string
+		for _, flag := range profile.SuspicionFlags {
+			flags = append(flags, formatContributionSuspicionFlag(flag))
+		}
+		summary += " Flags: " + strings.Join(flags, "; ") + "."
+	}
+	if profile.Comment != "" {
+		summary += fmt.Sprintf(" Edit summary: %q", profile.Comment)
+	}
+	return summary
+}
+
+// validateAtomFeed parses a generated feed back and checks the elements Atom
+// requires: id, title and updated on the feed and on every entry
+func validateAtomFeed(document string) error {
+	var feed atomFeed
+	decoder := xml.NewDecoder(bytes.NewReader([]byte(document)))
+	if err := decoder.Decode(&feed); err != nil {
+		return fmt.Errorf("invalid Atom feed XML: %w", err)
+	}
+
+	if feed.XMLName.Space != atomNamespace {
+		return fmt.Errorf("invalid Atom feed: unexpected namespace %q", feed.XMLName.Space)
+	}
+	if feed.ID == "" || feed.Title == "" || feed.Updated == "" {
+		return fmt.Errorf("invalid Atom feed: missing id, title or updated")
+	}
+	for i, entry := range feed.Entries {
+		if entry.ID == "" || entry.Title == "" || entry.Updated == "" {
+			return fmt.Errorf("invalid Atom feed: entry %d is missing id, title or updated", i+1)
+		}
+		if _, err := time.Parse(time.RFC3339, entry.Updated); err != nil {
+			return fmt.Errorf("invalid Atom feed: entry %d has a malformed date: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+// internal/formatter/fields.go
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// fieldNode is one level of the selected field paths; a node without children
+// keeps its whole value
+type fieldNode map[string]fieldNode
+
+// fields holds the paths selected for JSON and YAML output, nil for everything
+var fields fieldNode
+
+// SetFields trims JSON and YAML output to a comma-separated list of dot-paths
+// of JSON field names ("suspicion_score,top_contributors.username"). Arrays are
+// crossed: a path below one applies to each element. "*" matches every key of
+// an object, such as the pages of page_profiles. An empty list keeps everything.
+func SetFields(spec string) error {
+	fields = nil
+	if strings.TrimSpace(spec) == "" {
+		return nil
+	}
+
+	root := fieldNode{}
+	for _, path := range strings.Split(spec, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		node := root
+		for _, segment := range strings.Split(path, ".") {
+			if segment == "" {
+				return fmt.Errorf("invalid field path: %s (empty segment)", path)
+			}
+			child, exists := node[segment]
+			if !exists {
+				child = fieldNode{}
+				node[segment] = child
+			}
+			node = child
+		}
+	}
+	fields = root
+	return nil
+}
+
+// marshalJSON serializes v as indented JSON, trimmed to the selected fields
+func marshalJSON(v interface{}) ([]byte, error) {
+	if fields == nil {
+		return json.MarshalIndent(v, "", "  ")
+	}
+	selected, err := jsonTree(v, fields)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(selected, "", "  ")
+}
+
+// marshalYAML serializes v as YAML, trimmed to the selected fields. YAML goes
+// through the JSON encoding, so that its keys are the JSON field names, the
+// ones field paths refer to, whether fields are selected or not.
+func marshalYAML(v interface{}) ([]byte, error) {
+	selected, err := jsonTree(v, fields)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(selected)
+}
+
+// fieldObject is a decoded JSON object, its keys kept in their encoded order
+type fieldObject []yaml.MapItem
+
+// MarshalJSON encodes the object with its keys in order
+func (object fieldObject) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for i, item := range object {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		key, err := json.Marshal(item.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// MarshalYAML encodes the object as a YAML mapping with its keys in order
+func (object fieldObject) MarshalYAML() (interface{}, error) {
+	return yaml.MapSlice(object), nil
+}
+
+// jsonTree decodes the JSON encoding of v into objects, arrays and plain values,
+// projected onto node when fields are selected. Paths absent from v (an omitted
+// empty field) are left out.
+func jsonTree(v interface{}, node fieldNode) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	decoded, err := decodeJSONValue(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	if node == nil {
+		return decoded, nil
+	}
+	projected, ok := projectFields(decoded, node)
+	if !ok {
+		projected = fieldObject{}
+	}
+	return projected, nil
+}
+
+// decodeJSONValue reads the next value of decoder, objects keeping their key
+// order and numbers decoded as integers whenever they are
+func decodeJSONValue(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch typed := token.(type) {
+	case json.Delim:
+		if typed == '[' {
+			array := []interface{}{}
+			for decoder.More() {
+				element, err := decodeJSONValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, element)
+			}
+			_, err := decoder.Token() // ]
+			return array, err
+		}
+
+		object := fieldObject{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeJSONValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, yaml.MapItem{Key: key, Value: value})
+		}
+		_, err := decoder.Token() // }
+		return object, err
+	case json.Number:
+		if integer, err := typed.Int64(); err == nil {
+			return integer, nil
+		}
+		return typed.Float64()
+	default:
+		return token, nil
+	}
+}
+
+// projectFields keeps the parts of a decoded JSON value under node, and reports
+// whether anything was kept
+func projectFields(value interface{}, node fieldNode) (interface{}, bool) {
+	if len(node) == 0 {
+		return value, true
+	}
+
+	switch typed := value.(type) {
+	case []interface{}:
+		projected := make([]interface{}, 0, len(typed))
+		for _, element := range typed {
+			if kept, ok := projectFields(element, node); ok {
+				projected = append(projected, kept)
+			}
+		}
+		return projected, true
+	case fieldObject:
+		projected := fieldObject{}
+		for _, item := range typed {
+			key, _ := item.Key.(string)
+			child, exists := node[key]
+			if !exists {
+				child, exists = node["*"]
+			}
+			if !exists {
+				continue
+			}
+			if kept, ok := projectFields(item.Value, child); ok {
+				projected = append(projected, yaml.MapItem{Key: key, Value: kept})
+			}
+		}
+		return projected, len(projected) > 0
+	default:
+		// A path going on below a plain value selects nothing
+		return nil, false
+	}
+}
+
+// yamlWithJSONNames serializes v as YAML keyed by its JSON field names, whatever
+// fields are selected
+func yamlWithJSONNames(v interface{}) ([]byte, error) {
+	tree, err := jsonTree(v, nil)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(tree)
+}
@@ -0,0 +1,74 @@
+// internal/formatter/flagged.go
+package formatter
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// DefaultFlaggedThreshold is the suspicion score from which --list-flagged lists
+// an entity, the start of the HIGH level
+const DefaultFlaggedThreshold = suspicionHighThreshold
+
+// FormatFlaggedList renders entities one per line, without decoration, for
+// scripts reading the output of --list-flagged
+func FormatFlaggedList(entities []string) string {
+	if len(entities) == 0 {
+		return ""
+	}
+	return strings.Join(entities, "\n") + "\n"
+}
+
+// FlaggedUsers lists the profiled user when their suspicion score meets threshold
+func FlaggedUsers(profile *models.UserProfile, threshold int) []string {
+	if profile.SuspicionScore < threshold {
+		return nil
+	}
+	return []string{profile.Username}
+}
+
+// FlaggedCommonContributors lists the contributors of a cross-page analysis whose
+// suspicion score meets threshold, in report order
+func FlaggedCommonContributors(analysis *models.CrossPageAnalysis, threshold int) []string {
+	var usernames []string
+	for _, contributor := range analysis.CommonContributors {
+		if contributor.SuspicionScore >= threshold {
+			usernames = append(usernames, contributor.Username)
+		}
+	}
+	return usernames
+}
+
+// FlaggedPageRevisions lists the IDs of a page's recent revisions made by
+// contributors whose suspicion score meets threshold. Revisions are not scored
+// themselves in a page analysis: their author's score stands for them.
+func FlaggedPageRevisions(profile *models.PageProfile, threshold int) []string {
+	flaggedAuthors := make(map[string]bool)
+	for _, contributor := range profile.Contributors {
+		if contributor.SuspicionScore >= threshold {
+			flaggedAuthors[contributor.Username] = true
+		}
+	}
+
+	var revisionIDs []string
+	for _, revision := range profile.RecentRevisions {
+		if !revision.IsHidden && flaggedAuthors[revision.Username] {
+			revisionIDs = append(revisionIDs, strconv.Itoa(revision.RevID))
+		}
+	}
+	return revisionIDs
+}
+
+// FlaggedContributions lists the IDs of the analyzed revisions whose suspicion
+// score meets threshold
+func FlaggedContributions(profiles []*models.ContributionProfile, threshold int) []string {
+	var revisionIDs []string
+	for _, profile := range profiles {
+		if profile.SuspicionScore >= threshold {
+			revisionIDs = append(revisionIDs, strconv.Itoa(profile.RevisionID))
+		}
+	}
+	return revisionIDs
+}
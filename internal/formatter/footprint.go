@@ -0,0 +1,118 @@
+// internal/formatter/footprint.go
+package formatter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// FormatUserFootprint formats a user's cross-page footprint according to the specified format
+func FormatUserFootprint(report *models.UserFootprint, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		data, err := marshalJSON(report)
+		if err != nil {
+			return "", fmt.Errorf("JSON formatting error: %w", err)
+		}
+		return string(data), nil
+	case "yaml", "yml":
+		data, err := marshalYAML(report)
+		if err != nil {
+			return "", fmt.Errorf("YAML formatting error: %w", err)
+		}
+		return string(data), nil
+	case "table", "":
+		return formatFootprintAsTable(report), nil
+	case "plain":
+		return plainText(formatFootprintAsTable(report)), nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
+	}
+}
+
+// formatFootprintAsTable formats the footprint as a readable table
+func formatFootprintAsTable(report *models.UserFootprint) string {
+	var output strings.Builder
+
+	output.WriteString(boxHeader("👣 USER FOOTPRINT: ", report.Username, 32))
+
+	output.WriteString(headerColor.Sprint("📊 " + tr("SUMMARY") + "\n"))
+	output.WriteString(separator(50) + "\n")
+	output.WriteString("✏️ " + label("Edit Count:", 20) + strconv.Itoa(report.EditCount) + "\n")
+	output.WriteString("🔬 " + label("Analyzed Sample:", 20) + pluralf(report.SampleSize, "%d most recent contribution", "%d most recent contributions") + "\n")
+	output.WriteString("🎯 " + label("Suspicion Score:", 20) + getSuspicionColor(report.SuspicionScore).Sprintf("%d/100", report.SuspicionScore) + "\n")
+	output.WriteString("📄 " + label("Pages Analyzed:", 20) + strconv.Itoa(len(report.Pages)) + "\n\n")
+
+	if len(report.Pages) > 0 {
+		output.WriteString(headerColor.Sprint("📄 " + tr("PAGE INVOLVEMENT") + "\n"))
+		output.WriteString(separator(100) + "\n")
+		output.WriteString(fmt.Sprintf("%-*s %-6s %-7s %-5s %-8s %-9s %-9s %s\n",
+			scaleWidth(30), "Page", "Edits", "Share", "Rank", "Reverts", "Reverted", "Conflict", "Page score"))
+		output.WriteString(separator(100) + "\n")
+
+		for _, page := range report.Pages {
+			rank := "-"
+			if page.ContributorRank > 0 {
+				rank = "#" + strconv.Itoa(page.ContributorRank)
+			}
+			conflict := fmt.Sprintf("%-9s", "-")
+			if page.ConflictingUser || page.EditWarPeriods > 0 || page.PageOwner {
+				conflict = dangerColor.Sprintf("%-9s", "yes")
+			}
+
+			output.WriteString(fmt.Sprintf("%-*s %-6s %-7s %-5s %-8d %-9d %s %s\n",
+				scaleWidth(30), truncateString(page.PageTitle, scaleWidth(30)),
+				fmt.Sprintf("%d/%d", page.UserRevisions, page.PageRevisions),
+				fmt.Sprintf("%.0f%%", page.ContributorShare*100),
+				rank,
+				page.RevertsMade,
+				page.RevertsReceived,
+				conflict,
+				getSuspicionColor(page.PageSuspicionScore).Sprintf("%d/100", page.PageSuspicionScore)))
+		}
+		output.WriteString(secondaryColor.Sprint("   Edits, share and reverts are over each page's analyzed revisions\n\n"))
+	}
+
+	// The pages where the user is part of the dispute rather than a bystander
+	var conflicts []string
+	for _, page := range report.Pages {
+		var roles []string
+		if page.PageOwner {
+			roles = append(roles, "reverts most other editors' changes")
+		}
+		if page.EditWarPeriods > 0 {
+			roles = append(roles, "took part in "+pluralf(page.EditWarPeriods, "%d edit war", "%d edit wars"))
+		}
+		if page.ConflictingUser {
+			roles = append(roles, "among the conflicting users")
+		}
+		if len(roles) > 0 {
+			conflicts = append(conflicts, fmt.Sprintf("   • %s: %s (controversy %.2f)\n",
+				page.PageTitle, strings.Join(roles, ", "), page.ControversyScore))
+		}
+	}
+	if len(conflicts) > 0 {
+		output.WriteString(warningColor.Sprint("⚔️ " + tr("CONFLICT INVOLVEMENT") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		for _, line := range conflicts {
+			output.WriteString(line)
+		}
+		output.WriteString("\n")
+	} else if len(report.Pages) > 0 {
+		output.WriteString(successColor.Sprint("✅ No conflict involvement on the analyzed pages\n\n"))
+	}
+
+	if len(report.Errors) > 0 {
+		output.WriteString(headerColor.Sprint("❗ " + tr("INCOMPLETE ANALYSIS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		for _, analysisError := range report.Errors {
+			output.WriteString(warningColor.Sprint("• ") + analysisError + "\n")
+		}
+		output.WriteString("\n")
+	}
+
+	return output.String()
+}
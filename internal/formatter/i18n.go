@@ -0,0 +1,89 @@
+// internal/formatter/i18n.go
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// uiLanguage is the language of table report labels. Data values (titles,
+// usernames, comments) and suspicion flag descriptions are never translated.
+var uiLanguage = "en"
+
+// catalogs maps a UI language to its translations, keyed by the English message.
+// Messages missing from a catalog are shown in English.
+var catalogs = map[string]map[string]string{
+	"fr": frenchMessages,
+	"es": spanishMessages,
+	"de": germanMessages,
+}
+
+// SetUILanguage sets the language of table report labels (en, fr, es, de)
+func SetUILanguage(language string) error {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if _, exists := catalogs[language]; !exists && language != "en" {
+		return fmt.Errorf("unsupported UI language: %s (supported: %s)", language, strings.Join(UILanguages(), ", "))
+	}
+	uiLanguage = language
+	return nil
+}
+
+// UILanguages lists the supported UI languages
+func UILanguages() []string {
+	languages := []string{"en"}
+	for language := range catalogs {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages[1:])
+	return languages
+}
+
+// tr translates a report label to the UI language
+func tr(message string) string {
+	if translated, exists := catalogs[uiLanguage][message]; exists {
+		return translated
+	}
+	return message
+}
+
+// label translates a field label and pads it to width, the column where the
+// English layout puts the value. Longer translations keep one separating space.
+func label(message string, width int) string {
+	translated := tr(message)
+	padding := width - utf8.RuneCountInString(translated)
+	if padding < 1 {
+		padding = 1
+	}
+	return translated + strings.Repeat(" ", padding)
+}
+
+// translateHeading translates a heading behind its icon ("📊 SUMMARY"), keeping
+// the icon and its spacing
+func translateHeading(heading string) string {
+	start := strings.IndexFunc(heading, func(r rune) bool { return r < utf8.RuneSelf && unicode.IsLetter(r) })
+	if start < 0 {
+		return heading
+	}
+	return heading[:start] + tr(heading[start:])
+}
+
+// plural picks the singular or plural English form for n under the plural rule
+// of the UI language, then translates it. French uses the singular for 0 and 1.
+func plural(n int, one, other string) string {
+	singular := n == 1 || n == -1
+	if uiLanguage == "fr" {
+		singular = n >= -1 && n <= 1
+	}
+	if singular {
+		return tr(one)
+	}
+	return tr(other)
+}
+
+// pluralf formats n with the plural form for it, e.g. pluralf(3, "%d edit", "%d edits")
+func pluralf(n int, one, other string) string {
+	return fmt.Sprintf(plural(n, one, other), n)
+}
@@ -0,0 +1,148 @@
+// internal/formatter/investigation.go
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// FormatInvestigationReport formats the investigation report according to the specified format
+func FormatInvestigationReport(report *models.InvestigationReport, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		return formatInvestigationAsJSON(report)
+	case "yaml", "yml":
+		return formatInvestigationAsYAML(report)
+	case "table", "":
+		return formatInvestigationAsTable(report), nil
+	case "plain":
+		return plainText(formatInvestigationAsTable(report)), nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
+	}
+}
+
+// formatInvestigationAsJSON formats investigation report as JSON
+func formatInvestigationAsJSON(report *models.InvestigationReport) (string, error) {
+	data, err := marshalJSON(report)
+	if err != nil {
+		return "", fmt.Errorf("JSON formatting error: %w", err)
+	}
+	return string(data), nil
+}
+
+// formatInvestigationAsYAML formats investigation report as YAML
+func formatInvestigationAsYAML(report *models.InvestigationReport) (string, error) {
+	data, err := marshalYAML(report)
+	if err != nil {
+		return "", fmt.Errorf("YAML formatting error: %w", err)
+	}
+	return string(data), nil
+}
+
+// formatInvestigationAsTable formats investigation report as readable table
+func formatInvestigationAsTable(report *models.InvestigationReport) string {
+	var output strings.Builder
+
+	// Header
+	output.WriteString(boxHeader("🕵️  INVESTIGATION REPORT: Revision ", fmt.Sprint(report.RevisionID), 24))
+
+	// Combined verdict
+	riskColor := getSuspicionLevelColor(report.RiskLevel)
+	output.WriteString(fmt.Sprintf("🚨 %s %s (%d/100)\n\n",
+		riskColor.Sprint("Combined Risk Verdict:"),
+		riskColor.Sprint(strings.ReplaceAll(report.RiskLevel, "_", " ")),
+		report.RiskScore))
+
+	// Score breakdown
+	output.WriteString(headerColor.Sprint("📊 " + tr("SCORE BREAKDOWN") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	contributionColor := getSuspicionColor(report.Contribution.SuspicionScore)
+	output.WriteString(fmt.Sprintf("📝 %s%s\n", label("Contribution:", 20),
+		contributionColor.Sprintf("%d/100", report.Contribution.SuspicionScore)))
+
+	if report.AuthorProfile != nil {
+		authorColor := getSuspicionColor(report.AuthorProfile.SuspicionScore)
+		output.WriteString(fmt.Sprintf("👤 %s%s (%s)\n", label("Author:", 20),
+			authorColor.Sprintf("%d/100", report.AuthorProfile.SuspicionScore),
+			truncateString(report.AuthorProfile.Username, scaleWidth(30))))
+	} else {
+		output.WriteString("👤 " + label("Author:", 20) + secondaryColor.Sprint("not analyzed") + "\n")
+	}
+
+	if report.PageProfile != nil {
+		pageColor := getSuspicionColor(report.PageProfile.SuspicionScore)
+		output.WriteString(fmt.Sprintf("📄 %s%s (%s)\n", label("Page:", 20),
+			pageColor.Sprintf("%d/100", report.PageProfile.SuspicionScore),
+			truncateString(report.PageProfile.PageTitle, scaleWidth(30))))
+	} else {
+		output.WriteString("📄 " + label("Page:", 20) + secondaryColor.Sprint("not analyzed") + "\n")
+	}
+	output.WriteString("\n")
+
+	// Narrative
+	if len(report.Narrative) > 0 {
+		output.WriteString(headerColor.Sprint("📖 " + tr("SUMMARY") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		for _, line := range report.Narrative {
+			output.WriteString("• " + line + "\n")
+		}
+		output.WriteString("\n")
+	}
+
+	// Page conflict state
+	if report.PageProfile != nil {
+		conflicts := report.PageProfile.ConflictStats
+		output.WriteString(headerColor.Sprint("⚔️  " + tr("PAGE CONFLICT STATE") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		output.WriteString(fmt.Sprintf("🔄 %s%d\n", label("Reversions:", 20), conflicts.ReversionsCount))
+		output.WriteString(fmt.Sprintf("🔥 %s%d (%s)\n", label("Recent conflicts:", 20), conflicts.RecentConflicts, windowText(conflicts.ConflictWindowDays)))
+		output.WriteString(fmt.Sprintf("📈 %s%.2f\n", label("Controversy:", 20), conflicts.ControversyScore))
+		output.WriteString(fmt.Sprintf("⚖️  %s%d\n", label("Edit war periods:", 20), len(conflicts.EditWarPeriods)))
+		output.WriteString("\n")
+	}
+
+	// Deduplicated signals
+	if len(report.Signals) > 0 {
+		output.WriteString(headerColor.Sprint("⚠️  " + tr("SIGNALS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		for _, signal := range report.Signals {
+			text := formatInvestigationSignal(signal)
+			sources := strings.Join(signal.Sources, ", ")
+			if len(signal.Sources) > 1 {
+				output.WriteString(dangerColor.Sprint("🔴 ") + text + " " + secondaryColor.Sprintf("[%s]", sources) + "\n")
+			} else {
+				output.WriteString(warningColor.Sprint("🟡 ") + text + " " + secondaryColor.Sprintf("[%s]", sources) + "\n")
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Sub-analysis failures
+	if len(report.Errors) > 0 {
+		output.WriteString(headerColor.Sprint("❗ " + tr("INCOMPLETE ANALYSIS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		for _, analysisError := range report.Errors {
+			output.WriteString(warningColor.Sprint("• ") + analysisError + "\n")
+		}
+		output.WriteString("\n")
+	}
+
+	// Footer
+	output.WriteString(secondaryColor.Sprint("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n"))
+	output.WriteString(secondaryColor.Sprintf("🕵️  WikiOSINT Investigation - Revision %d on %s.wikipedia.org\n",
+		report.RevisionID, report.Language))
+
+	return output.String()
+}
+
+// formatInvestigationSignal formats a signal using the wording of the analysis that raised it
+func formatInvestigationSignal(signal models.InvestigationSignal) string {
+	if len(signal.Sources) == 0 {
+		return signal.Flag
+	}
+	return DescribeSuspicionFlag(signal.Sources[0], signal.Flag)
+}
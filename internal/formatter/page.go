@@ -2,14 +2,13 @@
 package formatter
 
 import (
-	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/intMeric/wikipedia-analyser/internal/models"
-	"gopkg.in/yaml.v2"
+	"github.com/intMeric/wikipedia-analyser/internal/utils"
 )
 
 // FormatPageProfile formats the page profile according to the specified format
@@ -21,8 +20,10 @@ func FormatPageProfile(profile *models.PageProfile, format string) (string, erro
 		return formatPageAsYAML(profile)
 	case "table", "":
 		return formatPageAsTable(profile), nil
+	case "plain":
+		return plainText(formatPageAsTable(profile)), nil
 	default:
-		return "", fmt.Errorf("unsupported format: %s (supported: table, json, yaml)", format)
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
 	}
 }
 
@@ -35,8 +36,10 @@ func FormatPageHistory(profile *models.PageProfile, format string) (string, erro
 		return formatPageAsYAML(profile)
 	case "table", "":
 		return formatPageHistoryAsTable(profile), nil
+	case "plain":
+		return plainText(formatPageHistoryAsTable(profile)), nil
 	default:
-		return "", fmt.Errorf("unsupported format: %s (supported: table, json, yaml)", format)
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
 	}
 }
 
@@ -49,8 +52,10 @@ func FormatPageConflicts(profile *models.PageProfile, format string) (string, er
 		return formatPageAsYAML(profile)
 	case "table", "":
 		return formatPageConflictsAsTable(profile), nil
+	case "plain":
+		return plainText(formatPageConflictsAsTable(profile)), nil
 	default:
-		return "", fmt.Errorf("unsupported format: %s (supported: table, json, yaml)", format)
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
 	}
 }
 
@@ -59,31 +64,39 @@ func formatPageHistoryAsTable(profile *models.PageProfile) string {
 	var output strings.Builder
 
 	// Header with page title
-	output.WriteString(headerColor.Sprint("╭─────────────────────────────────────────────────────────────╮\n"))
-	output.WriteString(headerColor.Sprintf("│  📚 EDIT HISTORY ANALYSIS: %-29s │\n", truncateString(profile.PageTitle, 29)))
-	output.WriteString(headerColor.Sprint("╰─────────────────────────────────────────────────────────────╯\n\n"))
+	output.WriteString(boxHeader("📚 EDIT HISTORY ANALYSIS: ", profile.PageTitle, 29))
 
 	// Basic page info
-	output.WriteString(headerColor.Sprint("📋 PAGE OVERVIEW\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
-	output.WriteString("📄 Page Title:         " + profile.PageTitle + "\n")
-	output.WriteString("📊 Total Revisions:    " + strconv.Itoa(profile.TotalRevisions) + "\n")
-	output.WriteString("👥 Total Contributors: " + strconv.Itoa(len(profile.Contributors)) + "\n")
-	output.WriteString("🔄 Last Modified:      " + profile.LastModified.Format("02/01/2006 15:04") + "\n")
+	output.WriteString(headerColor.Sprint("📋 " + tr("PAGE OVERVIEW") + "\n"))
+	output.WriteString(separator(50) + "\n")
+	output.WriteString("📄 " + label("Page Title:", 20) + profile.PageTitle + formatRequestedTitle(profile) + "\n")
+	output.WriteString("📊 " + label("Total Revisions:", 20) + strconv.Itoa(profile.TotalRevisions) + "\n")
+	output.WriteString("👥 " + label("Total Contributors:", 20) + strconv.Itoa(len(profile.Contributors)) + "\n")
+	output.WriteString("🔄 " + label("Last Modified:", 20) + formatTimestamp(profile.LastModified, "02/01/2006 15:04") + "\n")
 	output.WriteString("\n")
 
 	// Edit frequency analysis
-	output.WriteString(headerColor.Sprint("📈 EDITING ACTIVITY TIMELINE\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("📈 " + tr("EDITING ACTIVITY TIMELINE") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
-	output.WriteString("📅 Last 7 days:       " + strconv.Itoa(profile.QualityMetrics.EditFrequency.EditsLast7Days) + " edits\n")
-	output.WriteString("📅 Last 30 days:      " + strconv.Itoa(profile.QualityMetrics.EditFrequency.EditsLast30Days) + " edits\n")
-	output.WriteString("📅 Last 90 days:      " + strconv.Itoa(profile.QualityMetrics.EditFrequency.EditsLast90Days) + " edits\n")
+	for _, window := range profile.QualityMetrics.EditFrequency.Windows {
+		output.WriteString("📅 " + label(windowLabel(window.Days), 19) + pluralf(window.Edits, "%d edit", "%d edits") + "\n")
+	}
 
 	if profile.QualityMetrics.RecentActivityBurst {
-		output.WriteString("💥 Activity Pattern:   " + warningColor.Sprint("RECENT BURST DETECTED") + "\n")
+		output.WriteString("💥 " + label("Activity Pattern:", 20) + warningColor.Sprint("RECENT BURST DETECTED") + "\n")
 	} else {
-		output.WriteString("💥 Activity Pattern:   " + successColor.Sprint("Normal distribution") + "\n")
+		output.WriteString("💥 " + label("Activity Pattern:", 20) + successColor.Sprint("Normal distribution") + "\n")
+	}
+
+	if turnover := profile.QualityMetrics.ContributorTurnover; turnover != nil {
+		output.WriteString("🔁 " + label("Editor Turnover:", 20) + formatContributorTurnover(turnover) + "\n")
+	}
+
+	if traffic := profile.QualityMetrics.TrafficContext; traffic != nil {
+		output.WriteString(fmt.Sprintf("👁️  %s%d "+tr("(%.0f/day vs %.0f/day baseline)")+"\n", label("Views last 7 days:", 19),
+			traffic.ViewsLast7Days, traffic.RecentDailyViews, traffic.AverageDailyViews))
+		output.WriteString("📰 " + label("Burst Context:", 20) + formatBurstContext(traffic.BurstContext) + "\n")
 	}
 
 	if len(profile.QualityMetrics.EditFrequency.PeakEditingHours) > 0 {
@@ -91,14 +104,19 @@ func formatPageHistoryAsTable(profile *models.PageProfile) string {
 		for i, hour := range profile.QualityMetrics.EditFrequency.PeakEditingHours {
 			hours[i] = fmt.Sprintf("%02d:00", hour)
 		}
-		output.WriteString("🕐 Peak Hours:         " + strings.Join(hours, ", ") + "\n")
+		output.WriteString("🕐 " + label("Peak Hours:", 20) + strings.Join(hours, ", ") + "\n")
 	}
 	output.WriteString("\n")
 
 	// Daily activity breakdown
 	if len(profile.QualityMetrics.EditFrequency.EditsByDay) > 0 {
-		output.WriteString(headerColor.Sprint("📅 DAILY ACTIVITY BREAKDOWN\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
+		output.WriteString(headerColor.Sprint("📅 " + tr("DAILY ACTIVITY BREAKDOWN") + "\n"))
+		output.WriteString(separator(50) + "\n")
+
+		viewsByDay := make(map[string]int)
+		for _, day := range profile.PageViews {
+			viewsByDay[day.Date.Format("2006-01-02")] = day.Views
+		}
 
 		// Show last 14 days of activity
 		count := 0
@@ -112,7 +130,11 @@ func formatPageHistoryAsTable(profile *models.PageProfile) string {
 			} else if edits > 5 {
 				intensity = infoColor.Sprint(" (Moderate)")
 			}
-			output.WriteString(fmt.Sprintf("📆 %s: %2d edits%s\n", date, edits, intensity))
+			views := ""
+			if dayViews, exists := viewsByDay[date]; exists {
+				views = secondaryColor.Sprintf(" - %d views", dayViews)
+			}
+			output.WriteString(fmt.Sprintf("📆 %s: %2d edits%s%s\n", date, edits, intensity, views))
 			count++
 		}
 		output.WriteString("\n")
@@ -120,24 +142,21 @@ func formatPageHistoryAsTable(profile *models.PageProfile) string {
 
 	// Detailed revision history
 	if len(profile.RecentRevisions) > 0 {
-		output.WriteString(headerColor.Sprint("🕒 DETAILED REVISION HISTORY\n"))
-		output.WriteString(strings.Repeat("─", 85) + "\n")
+		output.WriteString(headerColor.Sprint("🕒 " + tr("DETAILED REVISION HISTORY") + "\n"))
+		output.WriteString(separator(85) + "\n")
 
 		for i, revision := range profile.RecentRevisions {
 			if i >= 20 { // Show more revisions for history view
 				break
 			}
 
-			username := revision.Username
-			if len(username) > 18 {
-				username = username[:18] + "..."
-			}
+			username := truncateString(revision.Username, scaleWidth(21))
 
-			comment := revision.Comment
-			if len(comment) > 35 {
-				comment = comment[:35] + "..."
-			}
-			if comment == "" {
+			comment := truncateString(revision.Comment, scaleWidth(38))
+			if revision.Section != "" {
+				_, summary := utils.SplitEditSummary(revision.Comment)
+				comment = infoColor.Sprint("§"+truncateString(revision.Section, scaleWidth(16))) + " " + truncateString(summary, scaleWidth(20))
+			} else if comment == "" {
 				comment = secondaryColor.Sprint("(no comment)")
 			}
 
@@ -148,23 +167,22 @@ func formatPageHistoryAsTable(profile *models.PageProfile) string {
 				diffStr = warningColor.Sprint(diffStr)
 			}
 
-			revertFlag := ""
-			if revision.IsRevert {
-				revertFlag = dangerColor.Sprint(" [REVERT]")
-			}
+			revertFlag := formatRevertFlag(revision)
 
 			minorFlag := ""
 			if revision.IsMinor {
 				minorFlag = secondaryColor.Sprint(" [m]")
 			}
 
-			output.WriteString(fmt.Sprintf("%-12s %-20s %s %s%s%s\n",
-				revision.Timestamp.Format("02/01 15:04"),
-				username,
+			output.WriteString(fmt.Sprintf("%-12s %-*s %s %s%s%s%s%s\n",
+				columnTimestamp(revision.Timestamp, "02/01 15:04"),
+				scaleWidth(20), username,
 				diffStr,
 				comment,
 				revertFlag,
 				minorFlag,
+				formatRevisionTags(revision.Tags),
+				timestampSuffix(revision.Timestamp),
 			))
 		}
 		output.WriteString("\n")
@@ -172,18 +190,15 @@ func formatPageHistoryAsTable(profile *models.PageProfile) string {
 
 	// Contributor activity patterns
 	if len(profile.Contributors) > 0 {
-		output.WriteString(headerColor.Sprint("👥 CONTRIBUTOR ACTIVITY PATTERNS\n"))
-		output.WriteString(strings.Repeat("─", 70) + "\n")
+		output.WriteString(headerColor.Sprint("👥 " + tr("CONTRIBUTOR ACTIVITY PATTERNS") + "\n"))
+		output.WriteString(separator(70) + "\n")
 
 		for i, contributor := range profile.Contributors {
 			if i >= 10 { // Top 10 for history view
 				break
 			}
 
-			username := contributor.Username
-			if len(username) > 20 {
-				username = username[:20] + "..."
-			}
+			username := truncateString(contributor.Username, scaleWidth(23))
 
 			userType := "👤"
 			if contributor.IsAnonymous {
@@ -195,9 +210,9 @@ func formatPageHistoryAsTable(profile *models.PageProfile) string {
 			activitySpan := int(contributor.LastEdit.Sub(contributor.FirstEdit).Hours() / 24)
 			avgEditsPerDay := float64(contributor.EditCount) / float64(max(1, activitySpan))
 
-			output.WriteString(fmt.Sprintf("%s %-25s %3d edits over %3d days (%.1f/day)\n",
+			output.WriteString(fmt.Sprintf("%s %-*s %3d edits over %3d days (%.1f/day)\n",
 				userType,
-				username,
+				scaleWidth(25), username,
 				contributor.EditCount,
 				activitySpan,
 				avgEditsPerDay,
@@ -227,65 +242,68 @@ func formatPageConflictsAsTable(profile *models.PageProfile) string {
 	var output strings.Builder
 
 	// Header
-	output.WriteString(headerColor.Sprint("╭─────────────────────────────────────────────────────────────╮\n"))
-	output.WriteString(headerColor.Sprintf("│  ⚔️ CONFLICT ANALYSIS: %-32s │\n", truncateString(profile.PageTitle, 32)))
-	output.WriteString(headerColor.Sprint("╰─────────────────────────────────────────────────────────────╯\n\n"))
+	output.WriteString(boxHeader("⚔️ CONFLICT ANALYSIS: ", profile.PageTitle, 32))
 
 	// Conflict overview
-	output.WriteString(headerColor.Sprint("📊 CONFLICT OVERVIEW\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
-
-	output.WriteString("🔄 Total Reversions:   " + strconv.Itoa(profile.ConflictStats.ReversionsCount) + "\n")
-	output.WriteString("📅 Recent Conflicts:   " + strconv.Itoa(profile.ConflictStats.RecentConflicts) + " (last 7 days)\n")
-	output.WriteString(fmt.Sprintf("📈 Stability Score:    %.2f/1.00 ", profile.ConflictStats.StabilityScore))
-
-	if profile.ConflictStats.StabilityScore < 0.7 {
-		output.WriteString(dangerColor.Sprint("(UNSTABLE)"))
-	} else if profile.ConflictStats.StabilityScore < 0.9 {
-		output.WriteString(warningColor.Sprint("(MODERATE)"))
-	} else {
-		output.WriteString(successColor.Sprint("(STABLE)"))
+	output.WriteString(headerColor.Sprint("📊 " + tr("CONFLICT OVERVIEW") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	output.WriteString("🔄 " + label("Total Reversions:", 20) + strconv.Itoa(profile.ConflictStats.ReversionsCount) + formatRevertKinds(profile.ConflictStats) + "\n")
+	output.WriteString("📅 " + label("Recent Conflicts:", 20) + strconv.Itoa(profile.ConflictStats.RecentConflicts) + " (" + windowText(profile.ConflictStats.ConflictWindowDays) + ")\n")
+	output.WriteString(formatOwnership(profile.ConflictStats.Ownership))
+	output.WriteString(formatRevertChain(profile.ConflictStats.LongestRevertChain))
+	output.WriteString(formatProtectionSurge(profile.ConflictStats.ProtectionSurge))
+	output.WriteString(formatReintroducedVandalism(profile.ConflictStats.ReintroducedVandalism))
+	if profile.ConflictStats.ToolAssistedReverts > 0 {
+		output.WriteString("🤖 " + label("Tool-assisted:", 20) + strconv.Itoa(profile.ConflictStats.ToolAssistedReverts) + secondaryColor.Sprint(" (reverted within seconds, patrol tools)") + "\n")
 	}
-	output.WriteString("\n")
-
-	output.WriteString(fmt.Sprintf("⚡ Controversy Score:  %.2f ", profile.ConflictStats.ControversyScore))
-	if profile.ConflictStats.ControversyScore > 0.3 {
-		output.WriteString(dangerColor.Sprint("(HIGH CONTROVERSY)"))
-	} else if profile.ConflictStats.ControversyScore > 0.1 {
-		output.WriteString(warningColor.Sprint("(SOME CONTROVERSY)"))
+	if profile.ConflictStats.SelfReverts > 0 {
+		note := tr("(excluded unless --count-self-reverts)")
+		if profile.ConflictStats.SelfRevertsCounted {
+			note = tr("(counted as conflicts)")
+		}
+		output.WriteString("↩️  " + label("Self-Reverts:", 20) + strconv.Itoa(profile.ConflictStats.SelfReverts) + " " + secondaryColor.Sprint(note) + "\n")
+	}
+	if profile.InsufficientHistory {
+		output.WriteString("📈 " + label("Stability Score:", 20) + insufficientHistoryText(profile) + "\n")
+		output.WriteString("⚡ " + label("Controversy Score:", 20) + insufficientHistoryText(profile) + "\n\n")
 	} else {
-		output.WriteString(successColor.Sprint("(LOW CONTROVERSY)"))
+		output.WriteString(fmt.Sprintf("📈 %s%.2f/1.00 ", label("Stability Score:", 20), profile.ConflictStats.StabilityScore))
+
+		stability := getStabilitySeverity(profile.ConflictStats.StabilityScore)
+		output.WriteString(stability.Color.Sprintf("(%s)", tr(stability.Label)))
+		output.WriteString("\n")
+
+		output.WriteString(fmt.Sprintf("⚡ %s%.2f ", label("Controversy Score:", 20), profile.ConflictStats.ControversyScore))
+		controversy := getControversySeverity(profile.ConflictStats.ControversyScore)
+		output.WriteString(controversy.Color.Sprintf("(%s)", tr(controversy.Label)))
+		output.WriteString("\n")
+		output.WriteString(formatTalkActivity(profile.ConflictStats))
+		output.WriteString("\n")
 	}
-	output.WriteString("\n\n")
 
 	// Conflict severity assessment
-	output.WriteString(headerColor.Sprint("🚨 CONFLICT SEVERITY ASSESSMENT\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
-
-	conflictLevel := "🟢 LOW"
-	if profile.ConflictStats.ControversyScore > 0.3 || profile.ConflictStats.RecentConflicts > 10 {
-		conflictLevel = dangerColor.Sprint("🔴 HIGH")
-	} else if profile.ConflictStats.ControversyScore > 0.1 || profile.ConflictStats.RecentConflicts > 5 {
-		conflictLevel = warningColor.Sprint("🟡 MODERATE")
-	} else {
-		conflictLevel = successColor.Sprint("🟢 LOW")
-	}
+	output.WriteString(headerColor.Sprint("🚨 " + tr("CONFLICT SEVERITY ASSESSMENT") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
-	output.WriteString("🎯 Conflict Level:     " + conflictLevel + "\n")
-	output.WriteString(fmt.Sprintf("📈 Reversion Rate:     %.1f%% of total edits\n",
+	conflict := getConflictSeverity(profile.ConflictStats.ControversyScore, profile.ConflictStats.RecentConflicts)
+	conflictLevel := conflict.Color.Sprint(conflict.Icon + " " + tr(conflict.Label))
+
+	output.WriteString("🎯 " + label("Conflict Level:", 20) + conflictLevel + "\n")
+	output.WriteString(fmt.Sprintf("📈 %s%.1f%% of total edits\n", label("Reversion Rate:", 20),
 		float64(profile.ConflictStats.ReversionsCount)/float64(max(1, profile.TotalRevisions))*100))
 
 	if profile.ConflictStats.RecentConflicts > 0 {
-		output.WriteString("⚠️  Recent Activity:    " + warningColor.Sprint("Active conflicts detected") + "\n")
+		output.WriteString("⚠️  " + label("Recent Activity:", 20) + warningColor.Sprint("Active conflicts detected") + "\n")
 	} else {
-		output.WriteString("✅ Recent Activity:    " + successColor.Sprint("No recent conflicts") + "\n")
+		output.WriteString("✅ " + label("Recent Activity:", 20) + successColor.Sprint("No recent conflicts") + "\n")
 	}
 	output.WriteString("\n")
 
 	// Conflicting users
 	if len(profile.ConflictStats.ConflictingUsers) > 0 {
-		output.WriteString(headerColor.Sprint("👥 USERS INVOLVED IN CONFLICTS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
+		output.WriteString(headerColor.Sprint("👥 " + tr("USERS INVOLVED IN CONFLICTS") + "\n"))
+		output.WriteString(separator(50) + "\n")
 		for i, user := range profile.ConflictStats.ConflictingUsers {
 			if i >= 10 { // Limit to 10
 				output.WriteString(fmt.Sprintf("... and %d more users\n", len(profile.ConflictStats.ConflictingUsers)-10))
@@ -298,8 +316,8 @@ func formatPageConflictsAsTable(profile *models.PageProfile) string {
 
 	// Edit war periods
 	if len(profile.ConflictStats.EditWarPeriods) > 0 {
-		output.WriteString(headerColor.Sprint("💥 DETECTED EDIT WAR PERIODS\n"))
-		output.WriteString(strings.Repeat("─", 70) + "\n")
+		output.WriteString(headerColor.Sprint("💥 " + tr("DETECTED EDIT WAR PERIODS") + "\n"))
+		output.WriteString(separator(70) + "\n")
 		for i, period := range profile.ConflictStats.EditWarPeriods {
 			if i >= 5 { // Limit to 5 most recent
 				break
@@ -307,10 +325,13 @@ func formatPageConflictsAsTable(profile *models.PageProfile) string {
 
 			duration := period.EndTime.Sub(period.StartTime)
 			output.WriteString(fmt.Sprintf("📅 %s - %s (%s duration)\n",
-				period.StartTime.Format("02/01 15:04"),
-				period.EndTime.Format("02/01 15:04"),
+				formatTimestamp(period.StartTime, "02/01 15:04"),
+				formatTimestamp(period.EndTime, "02/01 15:04"),
 				duration.String()))
 			output.WriteString(fmt.Sprintf("   👥 Participants: %s\n", strings.Join(period.Participants, ", ")))
+			if reverts := formatEditWarReverts(period); reverts != "" {
+				output.WriteString("   ⚔️  Reverts: " + reverts + "\n")
+			}
 			output.WriteString(fmt.Sprintf("   📊 Revisions: %d ", period.RevisionCount))
 
 			// Intensity assessment
@@ -332,8 +353,8 @@ func formatPageConflictsAsTable(profile *models.PageProfile) string {
 
 	// Recent reverts analysis
 	revertCount := 0
-	output.WriteString(headerColor.Sprint("🔄 RECENT REVERT ANALYSIS\n"))
-	output.WriteString(strings.Repeat("─", 75) + "\n")
+	output.WriteString(headerColor.Sprint("🔄 " + tr("RECENT REVERT ANALYSIS") + "\n"))
+	output.WriteString(separator(75) + "\n")
 
 	for _, revision := range profile.RecentRevisions {
 		if revision.IsRevert {
@@ -342,20 +363,25 @@ func formatPageConflictsAsTable(profile *models.PageProfile) string {
 				break
 			}
 
-			username := revision.Username
-			if len(username) > 18 {
-				username = username[:18] + "..."
-			}
+			username := truncateString(revision.Username, scaleWidth(21))
 
-			comment := revision.Comment
-			if len(comment) > 30 {
-				comment = comment[:30] + "..."
+			comment := truncateString(revision.Comment, scaleWidth(33))
+
+			// The kind column keeps its width when unknown, so comments stay aligned
+			kind := fmt.Sprintf("%-8s", revision.RevertKind)
+			switch revision.RevertKind {
+			case "full":
+				kind = dangerColor.Sprint(kind)
+			case "partial":
+				kind = secondaryColor.Sprint(kind)
 			}
 
-			output.WriteString(fmt.Sprintf("%-12s %-20s %s\n",
-				revision.Timestamp.Format("02/01 15:04"),
-				username,
+			output.WriteString(fmt.Sprintf("%-12s %-*s %s%s%s\n",
+				columnTimestamp(revision.Timestamp, "02/01 15:04"),
+				scaleWidth(20), username,
+				kind,
 				comment,
+				timestampSuffix(revision.Timestamp),
 			))
 		}
 	}
@@ -363,26 +389,27 @@ func formatPageConflictsAsTable(profile *models.PageProfile) string {
 	if revertCount == 0 {
 		output.WriteString(successColor.Sprint("✅ No recent reverts detected - page appears stable\n"))
 	} else {
-		output.WriteString(fmt.Sprintf("\n📊 Total recent reverts shown: %d\n", revertCount))
+		output.WriteString(fmt.Sprintf("\n📊 %s%d\n", label("Total recent reverts shown:", 28), revertCount))
 	}
 	output.WriteString("\n")
 
 	// Recommendations
-	output.WriteString(headerColor.Sprint("💡 CONFLICT MANAGEMENT RECOMMENDATIONS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("💡 " + tr("CONFLICT MANAGEMENT RECOMMENDATIONS") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
-	if profile.ConflictStats.ControversyScore > 0.3 {
-		output.WriteString(dangerColor.Sprint("🚨 HIGH PRIORITY ACTIONS NEEDED:\n"))
+	switch getControversySeverity(profile.ConflictStats.ControversyScore).Level {
+	case "HIGH":
+		output.WriteString(dangerColor.Sprint("🚨 " + tr("HIGH PRIORITY ACTIONS NEEDED:") + "\n"))
 		output.WriteString("   • Consider page protection or editing restrictions\n")
 		output.WriteString("   • Review user conduct and consider blocks if needed\n")
 		output.WriteString("   • Initiate dispute resolution procedures\n")
 		output.WriteString("   • Monitor for sockpuppet activity\n")
-	} else if profile.ConflictStats.ControversyScore > 0.1 {
-		output.WriteString(warningColor.Sprint("⚠️ MONITORING RECOMMENDED:\n"))
+	case "MODERATE":
+		output.WriteString(warningColor.Sprint("⚠️ " + tr("MONITORING RECOMMENDED:") + "\n"))
 		output.WriteString("   • Watch for escalation patterns\n")
 		output.WriteString("   • Consider discussion page mediation\n")
 		output.WriteString("   • Document conflict patterns\n")
-	} else {
+	default:
 		output.WriteString(successColor.Sprint("✅ PAGE STATUS: STABLE\n"))
 		output.WriteString("   • Continue regular monitoring\n")
 		output.WriteString("   • No immediate action required\n")
@@ -398,7 +425,7 @@ func formatPageConflictsAsTable(profile *models.PageProfile) string {
 
 // formatPageAsJSON formats page profile as JSON
 func formatPageAsJSON(profile *models.PageProfile) (string, error) {
-	data, err := json.MarshalIndent(profile, "", "  ")
+	data, err := marshalJSON(profile)
 	if err != nil {
 		return "", fmt.Errorf("JSON formatting error: %w", err)
 	}
@@ -407,7 +434,7 @@ func formatPageAsJSON(profile *models.PageProfile) (string, error) {
 
 // formatPageAsYAML formats page profile as YAML
 func formatPageAsYAML(profile *models.PageProfile) (string, error) {
-	data, err := yaml.Marshal(profile)
+	data, err := marshalYAML(profile)
 	if err != nil {
 		return "", fmt.Errorf("YAML formatting error: %w", err)
 	}
@@ -419,60 +446,75 @@ func formatPageAsTable(profile *models.PageProfile) string {
 	var output strings.Builder
 
 	// Header with page title and suspicion score
-	output.WriteString(headerColor.Sprint("╭─────────────────────────────────────────────────────────────╮\n"))
-	output.WriteString(headerColor.Sprintf("│  📄 WIKIPEDIA PAGE ANALYSIS: %-27s │\n", truncateString(profile.PageTitle, 27)))
-	output.WriteString(headerColor.Sprint("╰─────────────────────────────────────────────────────────────╯\n\n"))
+	output.WriteString(boxHeader("📄 WIKIPEDIA PAGE ANALYSIS: ", profile.PageTitle, 27))
 
 	// Suspicion score with color
 	suspicionText := getSuspicionText(profile.SuspicionScore)
 	suspicionColor := getSuspicionColor(profile.SuspicionScore)
 	output.WriteString(fmt.Sprintf("🚨 %s %s (%d/100)\n\n",
-		suspicionColor.Sprint("Suspicion Score:"),
+		suspicionColor.Sprint(tr("Suspicion Score:")),
 		suspicionColor.Sprint(suspicionText),
 		profile.SuspicionScore))
+	output.WriteString(formatCoverage(profile.Coverage))
+	output.WriteString(formatScoreBreakdown(profile.ScoreBreakdown))
 
 	// Basic information
-	output.WriteString(headerColor.Sprint("📋 PAGE INFORMATION\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("📋 " + tr("PAGE INFORMATION") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
-	output.WriteString("📄 Page Title:         " + profile.PageTitle + "\n")
-	output.WriteString("🆔 Page ID:            " + strconv.Itoa(profile.PageID) + "\n")
-	output.WriteString("📊 Total Revisions:    " + strconv.Itoa(profile.TotalRevisions) + "\n")
-	output.WriteString("📏 Current Size:       " + strconv.Itoa(profile.PageSize) + " bytes\n")
+	output.WriteString("📄 " + label("Page Title:", 20) + profile.PageTitle + formatRequestedTitle(profile) + "\n")
+	output.WriteString("🆔 " + label("Page ID:", 20) + strconv.Itoa(profile.PageID) + "\n")
+	output.WriteString("📊 " + label("Total Revisions:", 20) + strconv.Itoa(profile.TotalRevisions) + "\n")
+	output.WriteString("📏 " + label("Current Size:", 20) + strconv.Itoa(profile.PageSize) + " bytes\n")
 
 	if profile.CreationDate != nil {
-		creationDate := profile.CreationDate.Format("02/01/2006")
-		daysSince := int(time.Since(*profile.CreationDate).Hours() / 24)
-		output.WriteString(fmt.Sprintf("📅 Created:            %s (%d days ago)\n", creationDate, daysSince))
+		output.WriteString("📅 " + label("Created:", 20) + formatDatedAge(*profile.CreationDate, "02/01/2006") + "\n")
+	}
+
+	for _, move := range profile.Moves {
+		output.WriteString(fmt.Sprintf("🔀 %s%s → %s (%s by %s", label("Renamed:", 20),
+			move.FromTitle, move.ToTitle, formatTimestamp(move.MovedAt, "02/01/2006"), move.MovedBy))
+		if move.RevisionsAdded > 0 {
+			output.WriteString(fmt.Sprintf(", %d revisions merged from former title", move.RevisionsAdded))
+		}
+		output.WriteString(")\n")
 	}
 
-	output.WriteString("🔄 Last Modified:      " + profile.LastModified.Format("02/01/2006 15:04") + "\n")
-	output.WriteString("🌍 Wikipedia Language: " + profile.Language + "\n")
-	output.WriteString("🔍 Analysis Performed: " + profile.RetrievedAt.Format("02/01/2006 15:04:05") + "\n")
+	output.WriteString("🔄 " + label("Last Modified:", 20) + formatTimestamp(profile.LastModified, "02/01/2006 15:04") + "\n")
+	output.WriteString("🌍 " + label("Wikipedia Language:", 20) + profile.Language + "\n")
+	output.WriteString("🔍 " + label("Analysis Performed:", 20) + formatTimestamp(profile.RetrievedAt, "02/01/2006 15:04:05") + "\n")
 	output.WriteString("\n")
 
 	// Suspicion flags
 	if len(profile.SuspicionFlags) > 0 {
-		output.WriteString(warningColor.Sprint("⚠️  SUSPICION INDICATORS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
-		for _, flag := range profile.SuspicionFlags {
-			flagText := formatPageSuspicionFlag(flag)
-			output.WriteString(fmt.Sprintf("🔸 %s\n", warningColor.Sprint(flagText)))
-		}
+		output.WriteString(warningColor.Sprint("⚠️  " + tr("SUSPICION INDICATORS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		output.WriteString(formatFlagLines(profile.SuspicionFlags, formatPageSuspicionFlag))
 		output.WriteString("\n")
 	}
 
 	// Conflict statistics
-	output.WriteString(headerColor.Sprint("⚔️ CONFLICT ANALYSIS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
-
-	output.WriteString("🔄 Total Reversions:   " + strconv.Itoa(profile.ConflictStats.ReversionsCount) + "\n")
-	output.WriteString("📅 Recent Conflicts:   " + strconv.Itoa(profile.ConflictStats.RecentConflicts) + " (last 7 days)\n")
-	output.WriteString(fmt.Sprintf("📈 Stability Score:    %.2f/1.00\n", profile.ConflictStats.StabilityScore))
-	output.WriteString(fmt.Sprintf("⚡ Controversy Score:  %.2f\n", profile.ConflictStats.ControversyScore))
+	output.WriteString(headerColor.Sprint("⚔️ " + tr("CONFLICT ANALYSIS") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	output.WriteString("🔄 " + label("Total Reversions:", 20) + strconv.Itoa(profile.ConflictStats.ReversionsCount) + formatRevertKinds(profile.ConflictStats) + "\n")
+	output.WriteString("📅 " + label("Recent Conflicts:", 20) + strconv.Itoa(profile.ConflictStats.RecentConflicts) + " (" + windowText(profile.ConflictStats.ConflictWindowDays) + ")\n")
+	output.WriteString(formatOwnership(profile.ConflictStats.Ownership))
+	output.WriteString(formatRevertChain(profile.ConflictStats.LongestRevertChain))
+	output.WriteString(formatProtectionSurge(profile.ConflictStats.ProtectionSurge))
+	output.WriteString(formatReintroducedVandalism(profile.ConflictStats.ReintroducedVandalism))
+	if profile.InsufficientHistory {
+		output.WriteString("📈 " + label("Stability Score:", 20) + insufficientHistoryText(profile) + "\n")
+		output.WriteString("⚡ " + label("Controversy Score:", 20) + insufficientHistoryText(profile) + "\n")
+	} else {
+		output.WriteString(fmt.Sprintf("📈 %s%.2f/1.00\n", label("Stability Score:", 20), profile.ConflictStats.StabilityScore))
+		output.WriteString(fmt.Sprintf("⚡ %s%.2f (%.1f weighted reverts)\n", label("Controversy Score:", 20),
+			profile.ConflictStats.ControversyScore, profile.ConflictStats.WeightedReverts))
+		output.WriteString(formatTalkActivity(profile.ConflictStats))
+	}
 
 	if len(profile.ConflictStats.ConflictingUsers) > 0 {
-		output.WriteString("👥 Conflicting Users:  " + strings.Join(profile.ConflictStats.ConflictingUsers[:min(5, len(profile.ConflictStats.ConflictingUsers))], ", "))
+		output.WriteString("👥 " + label("Conflicting Users:", 20) + strings.Join(profile.ConflictStats.ConflictingUsers[:min(5, len(profile.ConflictStats.ConflictingUsers))], ", "))
 		if len(profile.ConflictStats.ConflictingUsers) > 5 {
 			output.WriteString(fmt.Sprintf(" (+%d more)", len(profile.ConflictStats.ConflictingUsers)-5))
 		}
@@ -480,30 +522,40 @@ func formatPageAsTable(profile *models.PageProfile) string {
 	}
 	output.WriteString("\n")
 
-	// Quality metrics
-	output.WriteString(headerColor.Sprint("📊 QUALITY METRICS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(formatDeletionDiscussion(profile.DeletionDiscussion))
 
-	output.WriteString(fmt.Sprintf("📝 Average Edit Size:  %.1f bytes\n", profile.QualityMetrics.AverageEditSize))
-	output.WriteString(fmt.Sprintf("👤 Anonymous Ratio:    %.1f%%\n", profile.QualityMetrics.AnonymousEditRatio*100))
-	output.WriteString(fmt.Sprintf("🆕 New Editor Ratio:   %.1f%%\n", profile.QualityMetrics.NewEditorRatio*100))
-	output.WriteString(fmt.Sprintf("🏆 Contributor Diversity: %.2f/1.00\n", profile.QualityMetrics.ContributorDiversity))
+	// Quality metrics
+	output.WriteString(headerColor.Sprint("📊 " + tr("QUALITY METRICS") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	output.WriteString(fmt.Sprintf("📝 %s%.1f bytes\n", label("Average Edit Size:", 20), profile.QualityMetrics.AverageEditSize))
+	output.WriteString(fmt.Sprintf("👤 %s%.1f%%\n", label("Anonymous Ratio:", 20), profile.QualityMetrics.AnonymousEditRatio*100))
+	output.WriteString(fmt.Sprintf("🆕 %s%.1f%%\n", label("New Editor Ratio:", 20), profile.QualityMetrics.NewEditorRatio*100))
+	if profile.InsufficientHistory {
+		output.WriteString("🏆 " + label("Contributor Diversity:", 23) + insufficientHistoryText(profile) + "\n")
+	} else {
+		output.WriteString(fmt.Sprintf("🏆 %s%.2f/1.00\n", label("Contributor Diversity:", 23), profile.QualityMetrics.ContributorDiversity))
+	}
 
 	if profile.QualityMetrics.RecentActivityBurst {
-		output.WriteString("💥 Recent Activity:    " + warningColor.Sprint("HIGH BURST DETECTED") + "\n")
+		output.WriteString("💥 " + label("Recent Activity:", 20) + warningColor.Sprint("HIGH BURST DETECTED") + "\n")
 	} else {
-		output.WriteString("💥 Recent Activity:    " + successColor.Sprint("Normal") + "\n")
+		output.WriteString("💥 " + label("Recent Activity:", 20) + successColor.Sprint("Normal") + "\n")
+	}
+	if traffic := profile.QualityMetrics.TrafficContext; traffic != nil {
+		output.WriteString(fmt.Sprintf("👁️  %s"+tr("%.1fx baseline (%d views last 7 days)")+"\n", label("Reader Traffic:", 20), traffic.TrafficRatio, traffic.ViewsLast7Days))
+		output.WriteString("📰 " + label("Burst Context:", 20) + formatBurstContext(traffic.BurstContext) + "\n")
 	}
 	output.WriteString("\n")
 
 	// Source analysis (if available)
 	if profile.SourceAnalysis != nil {
-		output.WriteString(headerColor.Sprint("📚 SOURCE RELIABILITY ANALYSIS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
+		output.WriteString(headerColor.Sprint("📚 " + tr("SOURCE RELIABILITY ANALYSIS") + "\n"))
+		output.WriteString(separator(50) + "\n")
 
 		// Basic statistics
-		output.WriteString(fmt.Sprintf("📊 Total References:   %d\n", profile.SourceAnalysis.TotalReferences))
-		output.WriteString(fmt.Sprintf("🔗 Unique References:  %d\n", profile.SourceAnalysis.UniqueReferences))
+		output.WriteString(fmt.Sprintf("📊 %s%d\n", label("Total References:", 20), profile.SourceAnalysis.TotalReferences))
+		output.WriteString(fmt.Sprintf("🔗 %s%d\n", label("Unique References:", 20), profile.SourceAnalysis.UniqueReferences))
 
 		// Reliability score
 		reliabilityScore := profile.SourceAnalysis.ReliabilityScore
@@ -515,7 +567,7 @@ func formatPageAsTable(profile *models.PageProfile) string {
 		} else {
 			scoreColor = dangerColor.Sprint
 		}
-		output.WriteString(fmt.Sprintf("⭐ Reliability Score:  %s\n", scoreColor(fmt.Sprintf("%.1f%%", reliabilityScore))))
+		output.WriteString(fmt.Sprintf("⭐ %s%s\n", label("Reliability Score:", 20), scoreColor(fmt.Sprintf("%.1f%%", reliabilityScore))))
 
 		// Domain distribution (top 5)
 		if len(profile.SourceAnalysis.DomainDistribution) > 0 {
@@ -558,6 +610,22 @@ func formatPageAsTable(profile *models.PageProfile) string {
 			}
 		}
 
+		// Reference churn across recent revisions
+		if churn := profile.SourceAnalysis.ReferenceChurn; churn != nil {
+			output.WriteString(fmt.Sprintf("\n🔁 %s+%d / -%d over %d revisions\n", label("Reference Churn:", 20),
+				churn.TotalAdded, churn.TotalRemoved, churn.RevisionsAnalyzed))
+			for _, remover := range churn.CitationRemovers {
+				output.WriteString(fmt.Sprintf("   • %s removed %d references in %d edits (added %d)\n",
+					dangerColor.Sprint(truncateString(remover.Username, scaleWidth(25))),
+					remover.RefsRemoved, remover.RemovalEdits, remover.RefsAdded))
+			}
+			for _, adder := range churn.UnsourcedAdders {
+				output.WriteString(fmt.Sprintf("   • %s added %d chars without citations in %d of %d additions\n",
+					warningColor.Sprint(truncateString(adder.Username, scaleWidth(25))),
+					adder.UnsourcedChars, adder.UnsourcedAdditions, adder.Additions))
+			}
+		}
+
 		// Dead links
 		if len(profile.SourceAnalysis.DeadLinks) > 0 {
 			output.WriteString("\n" + dangerColor.Sprint("🔗 DEAD LINKS DETECTED") + "\n")
@@ -574,36 +642,49 @@ func formatPageAsTable(profile *models.PageProfile) string {
 	}
 
 	// Edit frequency
-	output.WriteString(headerColor.Sprint("📈 EDIT FREQUENCY\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("📈 " + tr("EDIT FREQUENCY") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
-	output.WriteString("📅 Last 7 days:       " + strconv.Itoa(profile.QualityMetrics.EditFrequency.EditsLast7Days) + " edits\n")
-	output.WriteString("📅 Last 30 days:      " + strconv.Itoa(profile.QualityMetrics.EditFrequency.EditsLast30Days) + " edits\n")
-	output.WriteString("📅 Last 90 days:      " + strconv.Itoa(profile.QualityMetrics.EditFrequency.EditsLast90Days) + " edits\n")
+	for _, window := range profile.QualityMetrics.EditFrequency.Windows {
+		output.WriteString("📅 " + label(windowLabel(window.Days), 19) + pluralf(window.Edits, "%d edit", "%d edits") + "\n")
+	}
 
 	if len(profile.QualityMetrics.EditFrequency.PeakEditingHours) > 0 {
 		hours := make([]string, len(profile.QualityMetrics.EditFrequency.PeakEditingHours))
 		for i, hour := range profile.QualityMetrics.EditFrequency.PeakEditingHours {
 			hours[i] = fmt.Sprintf("%02d:00", hour)
 		}
-		output.WriteString("🕐 Peak Hours:         " + strings.Join(hours, ", ") + "\n")
+		output.WriteString("🕐 " + label("Peak Hours:", 20) + strings.Join(hours, ", ") + "\n")
 	}
 	output.WriteString("\n")
 
 	// Top contributors
 	if len(profile.Contributors) > 0 {
-		output.WriteString(headerColor.Sprint("👥 TOP CONTRIBUTORS ANALYSIS\n"))
-		output.WriteString(strings.Repeat("─", 80) + "\n")
+		output.WriteString(headerColor.Sprint("👥 " + tr("TOP CONTRIBUTORS ANALYSIS") + "\n"))
+		output.WriteString(separator(80) + "\n")
+
+		if baseline := profile.ActivityBaseline; baseline != nil {
+			output.WriteString(secondaryColor.Sprintf("📏 %smedian %.1f edits across %d contributors, thresholds ×%.1f\n", label("Relative scoring:", 18),
+				baseline.MedianEdits, baseline.Contributors, baseline.Factor))
+		}
+		if arrival := profile.CoordinatedArrival; arrival != nil {
+			output.WriteString(warningColor.Sprintf("🐝 %s%d accounts first edited within %d min (%s)\n", label("Coordinated arrival:", 21),
+				len(arrival.Accounts), arrival.WindowMinutes, formatTimestamp(arrival.WindowStart, "02/01/2006 15:04")))
+			output.WriteString(fmt.Sprintf("   %s\n", truncateString(strings.Join(arrival.Accounts, ", "), scaleWidth(75))))
+		}
+		for _, match := range profile.LoggedOutEditing {
+			output.WriteString(warningColor.Sprintf("🕶️  %s%s edits like %s\n", label("Logged-out editing:", 21),
+				match.Address, match.Account))
+			output.WriteString(secondaryColor.Sprintf("   %.0f%% same hours, %.0f%% same focus, %.0f%% of its %d edits within 3 hours of the account's %d\n",
+				match.HourOverlap*100, match.FocusOverlap*100, match.Proximity*100, match.AddressEdits, match.AccountEdits))
+		}
 
 		for i, contributor := range profile.Contributors {
 			if i >= 15 { // Limit to top 15
 				break
 			}
 
-			username := contributor.Username
-			if len(username) > 22 {
-				username = username[:22] + "..."
-			}
+			username := truncateString(contributor.Username, scaleWidth(25))
 
 			userType := "👤"
 			suspicionDisplay := ""
@@ -612,6 +693,13 @@ func formatPageAsTable(profile *models.PageProfile) string {
 				userType = "🌐"
 				username = secondaryColor.Sprint(username)
 				suspicionDisplay = secondaryColor.Sprint("(Anonymous)")
+				if proxy := contributor.Proxy; proxy != nil {
+					source := proxy.Kind
+					if proxy.Provider != "" {
+						source = proxy.Provider
+					}
+					suspicionDisplay = warningColor.Sprintf("(Anonymous, %s range: %s)", proxy.Kind, source)
+				}
 			} else {
 				// Display suspicion score with color
 				if contributor.SuspicionScore == -1 {
@@ -625,12 +713,19 @@ func formatPageAsTable(profile *models.PageProfile) string {
 				}
 			}
 
-			output.WriteString(fmt.Sprintf("%s %-25s %4d edits %+6d bytes %s %s\n",
+			// Editors who never explain their changes on the page stand out
+			summaryDisplay := fmt.Sprintf("%3.0f%% "+tr("unsummarized"), contributor.EmptySummaryRatio*100)
+			if contributor.EmptySummaryRatio > 0.7 {
+				summaryDisplay = warningColor.Sprint(summaryDisplay)
+			}
+
+			output.WriteString(fmt.Sprintf("%s %-*s %4d edits %+6d bytes %s %s %s\n",
 				userType,
-				username,
+				scaleWidth(25), username,
 				contributor.EditCount,
 				contributor.TotalSizeDiff,
-				contributor.LastEdit.Format("02/01/06"),
+				summaryDisplay,
+				formatTimestamp(contributor.LastEdit, "02/01/06"),
 				suspicionDisplay,
 			))
 
@@ -655,8 +750,8 @@ func formatPageAsTable(profile *models.PageProfile) string {
 	}
 
 	if len(suspiciousContributors) > 0 {
-		output.WriteString(warningColor.Sprint("🚨 SUSPICIOUS CONTRIBUTORS DETECTED\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
+		output.WriteString(warningColor.Sprint("🚨 " + tr("SUSPICIOUS CONTRIBUTORS DETECTED") + "\n"))
+		output.WriteString(separator(50) + "\n")
 
 		for i, contributor := range suspiciousContributors {
 			if i >= 5 { // Limit to 5 most suspicious
@@ -686,23 +781,17 @@ func formatPageAsTable(profile *models.PageProfile) string {
 
 	// Recent revisions (preview)
 	if len(profile.RecentRevisions) > 0 {
-		output.WriteString(headerColor.Sprint("🕒 RECENT REVISIONS (last 10)\n"))
-		output.WriteString(strings.Repeat("─", 80) + "\n")
+		output.WriteString(headerColor.Sprint("🕒 " + tr("RECENT REVISIONS (last 10)") + "\n"))
+		output.WriteString(separator(80) + "\n")
 
 		for i, revision := range profile.RecentRevisions {
 			if i >= 10 {
 				break
 			}
 
-			username := revision.Username
-			if len(username) > 20 {
-				username = username[:20] + "..."
-			}
+			username := truncateString(revision.Username, scaleWidth(23))
 
-			comment := revision.Comment
-			if len(comment) > 30 {
-				comment = comment[:30] + "..."
-			}
+			comment := truncateString(revision.Comment, scaleWidth(33))
 			if comment == "" {
 				comment = secondaryColor.Sprint("(no comment)")
 			}
@@ -714,17 +803,15 @@ func formatPageAsTable(profile *models.PageProfile) string {
 				diffStr = warningColor.Sprint(diffStr)
 			}
 
-			revertFlag := ""
-			if revision.IsRevert {
-				revertFlag = dangerColor.Sprint(" [REVERT]")
-			}
+			revertFlag := formatRevertFlag(revision)
 
-			output.WriteString(fmt.Sprintf("%-12s %-22s %s %s%s\n",
-				revision.Timestamp.Format("02/01 15:04"),
-				username,
+			output.WriteString(fmt.Sprintf("%-12s %-*s %s %s%s%s\n",
+				columnTimestamp(revision.Timestamp, "02/01 15:04"),
+				scaleWidth(22), username,
 				diffStr,
 				comment,
 				revertFlag,
+				timestampSuffix(revision.Timestamp),
 			))
 		}
 		output.WriteString("\n")
@@ -745,6 +832,8 @@ func formatPageSuspicionFlag(flag string) string {
 	switch flag {
 	case "PAGE_HIGH_CONFLICT":
 		return "High conflict ratio detected"
+	case "PAGE_CITATION_REMOVAL_PATTERN":
+		return "Contributors repeatedly removing citations"
 	case "PAGE_FEW_CONTRIBUTORS":
 		return "Too few contributors for edit volume"
 	case "PAGE_RECENT_INTENSIVE_ACTIVITY":
@@ -757,11 +846,41 @@ func formatPageSuspicionFlag(flag string) string {
 		return "Low contributor diversity"
 	case "PAGE_RECENT_CONFLICTS":
 		return "Recent editing conflicts detected"
+	case "PAGE_TRAFFIC_DRIVEN_ACTIVITY":
+		return "Recent editing burst matching a reader traffic spike"
+	case "PAGE_OWNERSHIP_BEHAVIOR":
+		return "One editor reverts most other editors' changes (page ownership)"
+	case "PAGE_REVERT_CHAIN":
+		return "Chain of reverts undoing each other (edit war)"
+	case "COORDINATED_ARRIVAL":
+		return "Several accounts made their first edit to the page at the same time"
+	case "PROTECTION_EXPIRY_SURGE":
+		return "Editing rush right after the page's protection ended"
+	case "COORDINATED_DELETION_VOTES":
+		return "Several accounts cast the same !vote within minutes (canvassing)"
+	case "REINTRODUCED_VANDALISM":
+		return "Content reverted as vandalism was put back"
+	case "PAGE_ANON_PROXY_EDITS":
+		return "Anonymous edits from hosting, VPN or open proxy ranges"
+	case "POSSIBLE_LOGGED_OUT_EDITING":
+		return "An IP address edits like a registered account (possible logged-out editing)"
 	default:
 		return flag
 	}
 }
 
+// formatBurstContext formats the traffic context of an editing burst into readable text
+func formatBurstContext(context string) string {
+	switch context {
+	case "traffic_driven":
+		return infoColor.Sprint("Traffic-driven (topic likely in the news)")
+	case "unexplained":
+		return warningColor.Sprint("Unexplained by traffic (possible coordination)")
+	default:
+		return successColor.Sprint("No editing burst")
+	}
+}
+
 // filterContributorFlags filters and formats contributor-specific flags
 func filterContributorFlags(flags []string) []string {
 	var filtered []string
@@ -770,9 +889,23 @@ func filterContributorFlags(flags []string) []string {
 		"NEW_ACCOUNT_HIGH_PAGE_ACTIVITY": "New account, high activity",
 		"VERY_RECENT_ACTIVITY":           "Very recent edits",
 		"LARGE_CONTENT_CHANGES":          "Large content changes",
+		"NEW_TO_PAGE_OLD_ACCOUNT":        "Old account, new to page",
+		"NEW_ACCOUNT_NEW_TO_PAGE":        "New account, new to page",
 		"RECENT_ACCOUNT_HIGH_ACTIVITY":   "Recent account, active",
 		"USER_BLOCKED":                   "Currently blocked",
 		"SINGLE_PAGE_FOCUS":              "Single page focus",
+		"SINGLE_PURPOSE_ACCOUNT":         "Single-purpose account",
+		"CITATION_REMOVAL_PATTERN":       "Strips citations",
+		"REINTRODUCED_VANDALISM":         "Restores reverted vandalism",
+		"POSSIBLE_LOGGED_OUT_EDITING":    "Possible logged-out editing",
+		"UNSOURCED_CONTENT_ADDER":        "Adds unsourced text",
+		"COSMETIC_EDIT_INFLATION":        "Cosmetic edit inflation",
+		"AUTOCONFIRMED_GAMING":           "Autoconfirmed gaming",
+		"REACTIVATED_AFTER_DORMANCY":     "Reactivated dormant account",
+		"HIGH_DELETED_CONTRIBUTIONS":     "Many deleted edits",
+		"CAMPAIGN_WINDOW_CONCENTRATION":  "Campaign account",
+		"POSSIBLE_COI":                   "Possible COI",
+		"LOW_PROFILE_SUSTAINED_CAMPAIGN": "Low-profile campaign",
 		"NO_SPECIAL_GROUPS":              "No special groups",
 		"SENSITIVE_NAMESPACE_FOCUS":      "Sensitive namespace focus",
 		"FREQUENT_EMPTY_COMMENTS":        "Empty comments",
@@ -787,6 +920,209 @@ func filterContributorFlags(flags []string) []string {
 	return filtered
 }
 
+// insufficientHistoryText replaces ratio metrics computed over too few revisions
+func insufficientHistoryText(profile *models.PageProfile) string {
+	return secondaryColor.Sprintf("insufficient data (%d revisions in history)", profile.HistoryRevisions)
+}
+
+// formatOwnership renders the editor reverting most of the other editors' changes
+func formatOwnership(owner *models.PageOwnership) string {
+	if owner == nil {
+		return ""
+	}
+	return fmt.Sprintf("👑 %s%s reverted %s of others' edits (%d/%d)\n", label("Page Ownership:", 20),
+		owner.Username,
+		dangerColor.Sprintf("%.0f%%", owner.Ratio*100),
+		owner.RevertedEdits, owner.OthersEdits)
+}
+
+// formatRevertChain renders the longest run of reverts undoing each other
+func formatRevertChain(chain *models.RevertChain) string {
+	if chain == nil {
+		return ""
+	}
+	lengthColor := warningColor
+	if chain.Length >= 3 {
+		lengthColor = dangerColor
+	}
+	return fmt.Sprintf("⛓️  %s%s by %s (%s → %s)\n", label("Revert Chain:", 20),
+		lengthColor.Sprintf("%d reverts of reverts", chain.Length),
+		truncateString(strings.Join(chain.Users, ", "), scaleWidth(40)),
+		formatTimestamp(chain.StartTime, "2006-01-02 15:04"),
+		formatTimestamp(chain.EndTime, "2006-01-02 15:04"))
+}
+
+// formatReintroducedVandalism renders the revisions restoring content reverted
+// as vandalism, the first few in full
+func formatReintroducedVandalism(reintroduced []models.ReintroducedVandalism) string {
+	if len(reintroduced) == 0 {
+		return ""
+	}
+
+	var output strings.Builder
+	output.WriteString("☣️  " + label("Vandalism Restored:", 20) + dangerColor.Sprint(pluralf(len(reintroduced), "%d revision", "%d revisions")) +
+		secondaryColor.Sprint(" (content reverted as vandalism put back)") + "\n")
+	for i, restore := range reintroduced {
+		if i >= 3 {
+			output.WriteString(secondaryColor.Sprintf("   ... and %d more\n", len(reintroduced)-3))
+			break
+		}
+		account := "another account"
+		if restore.SameAccount {
+			account = "the same account"
+		}
+		output.WriteString(fmt.Sprintf("   r%d by %s on %s restores r%d by %s, reverted by %s (%s)\n",
+			restore.RevisionID, truncateString(restore.User, scaleWidth(20)), formatTimestamp(restore.Timestamp, "2006-01-02 15:04"),
+			restore.VandalRevisionID, truncateString(restore.VandalUser, scaleWidth(20)), restore.RevertedBy, account))
+	}
+	return output.String()
+}
+
+// formatProtectionSurge renders the rush of edits following the end of a
+// protection, or nothing when there was none
+func formatProtectionSurge(surge *models.ProtectionExpirySurge) string {
+	if surge == nil {
+		return ""
+	}
+	ending := "expired"
+	if surge.LiftedBy != "" {
+		ending = "lifted by " + surge.LiftedBy
+	}
+	revertsColor := warningColor
+	if surge.Reverts >= models.ProtectionSurgeMinReverts {
+		revertsColor = dangerColor
+	}
+	return fmt.Sprintf("🔓 %s%s, %s within %dh of %s protection %s on %s (%.1f expected)\n", label("Protection Expiry:", 20),
+		dangerColor.Sprint(pluralf(surge.Edits, "%d edit", "%d edits")),
+		revertsColor.Sprint(pluralf(surge.Reverts, "%d revert", "%d reverts")),
+		surge.WindowHours, surge.Level, ending,
+		formatTimestamp(surge.ProtectionEnded, "2006-01-02 15:04"), surge.ExpectedEdits)
+}
+
+// formatDeletionDiscussion shows the !vote tally of a deletion discussion and
+// the groups of identical !votes cast together
+func formatDeletionDiscussion(discussion *models.DeletionDiscussion) string {
+	if discussion == nil {
+		return ""
+	}
+	var output strings.Builder
+
+	output.WriteString(headerColor.Sprint("🗳️  " + tr("DELETION DISCUSSION") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	positions := make([]string, 0, len(discussion.Tally))
+	for position := range discussion.Tally {
+		positions = append(positions, position)
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		if discussion.Tally[positions[i]] != discussion.Tally[positions[j]] {
+			return discussion.Tally[positions[i]] > discussion.Tally[positions[j]]
+		}
+		return positions[i] < positions[j]
+	})
+	tally := make([]string, len(positions))
+	for i, position := range positions {
+		tally[i] = fmt.Sprintf("%s %d", position, discussion.Tally[position])
+	}
+	output.WriteString("🗳️  " + label("Votes:", 20) + strconv.Itoa(len(discussion.Votes)))
+	if len(tally) > 0 {
+		output.WriteString(" (" + strings.Join(tally, ", ") + ")")
+	}
+	output.WriteString("\n")
+
+	for _, cluster := range discussion.Clusters {
+		output.WriteString(dangerColor.Sprintf("🐝 %s"+tr("%d accounts voted %s within %d min (%s)")+"\n", label("Vote Cluster:", 20),
+			len(cluster.Users), cluster.Position, cluster.SpanMinutes, formatTimestamp(cluster.FirstVote, "02/01/2006 15:04")))
+		output.WriteString(fmt.Sprintf("   %s\n", truncateString(strings.Join(cluster.Users, ", "), scaleWidth(75))))
+	}
+	output.WriteString("\n")
+
+	return output.String()
+}
+
+// formatEditWarReverts names who reverted whom within an edit war period,
+// "X: 4 reverts (Y ×3, Z ×1), Y: 3 reverts (X ×3)", most reverts first
+func formatEditWarReverts(period models.EditWarPeriod) string {
+	var parts []string
+	for _, participant := range period.ParticipantStats {
+		if participant.Reverts == 0 {
+			continue
+		}
+
+		targets := make([]string, 0, len(participant.RevertedUsers))
+		for user := range participant.RevertedUsers {
+			targets = append(targets, user)
+		}
+		sort.Slice(targets, func(i, j int) bool {
+			if participant.RevertedUsers[targets[i]] != participant.RevertedUsers[targets[j]] {
+				return participant.RevertedUsers[targets[i]] > participant.RevertedUsers[targets[j]]
+			}
+			return targets[i] < targets[j]
+		})
+		for i, user := range targets {
+			targets[i] = fmt.Sprintf("%s ×%d", user, participant.RevertedUsers[user])
+		}
+
+		part := participant.Username + ": " + pluralf(participant.Reverts, "%d revert", "%d reverts")
+		if len(targets) > 0 {
+			part += " (" + strings.Join(targets, ", ") + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatTalkActivity shows the talk page discussion and the controversy score
+// weighed with it, when the talk page was analyzed
+func formatTalkActivity(stats models.ConflictStats) string {
+	talk := stats.TalkActivity
+	if talk == nil {
+		return ""
+	}
+
+	heatColor := successColor
+	if talk.HeatScore >= 0.6 {
+		heatColor = dangerColor
+	} else if talk.HeatScore >= 0.3 {
+		heatColor = warningColor
+	}
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("💬 %s%s by %s, %s %s\n", label("Talk Page:", 20),
+		pluralf(talk.Revisions, "%d edit", "%d edits"),
+		pluralf(talk.Participants, "%d participant", "%d participants"),
+		heatColor.Sprintf("heat %.2f", talk.HeatScore),
+		secondaryColor.Sprintf("(%d of the conflicting users, %d heated summaries)", talk.ConflictParticipants, talk.HeatedSummaries)))
+
+	controversy := getControversySeverity(stats.CombinedControversy)
+	output.WriteString(fmt.Sprintf("⚖️  %s%.2f %s\n", label("Combined Score:", 20), stats.CombinedControversy,
+		controversy.Color.Sprintf("(%s)", tr(controversy.Label))))
+	return output.String()
+}
+
+// formatRevertFlag marks reverts in revision lists, with the delay of the ones
+// made with a tool, and the revisions restoring reverted vandalism
+func formatRevertFlag(revision models.Revision) string {
+	if revision.ReintroducesVandalism {
+		return dangerColor.Sprint(" [RESTORES VANDALISM]")
+	}
+	if !revision.IsRevert {
+		return ""
+	}
+	if revision.ToolAssisted {
+		return dangerColor.Sprint(" [REVERT]") + secondaryColor.Sprintf(" [tool %ds]", revision.RevertDelaySeconds)
+	}
+	return dangerColor.Sprint(" [REVERT]")
+}
+
+// formatRevertKinds splits a reversion count into full and partial reverts
+func formatRevertKinds(stats models.ConflictStats) string {
+	if stats.FullReverts == 0 && stats.PartialReverts == 0 {
+		return ""
+	}
+	return secondaryColor.Sprintf(" (%d full, %d partial)", stats.FullReverts, stats.PartialReverts)
+}
+
 // formatContributorSuspicionFlag formats contributor suspicion flags into readable text
 func formatContributorSuspicionFlag(flag string) string {
 	switch flag {
@@ -798,12 +1134,36 @@ func formatContributorSuspicionFlag(flag string) string {
 		return "Very recent editing activity"
 	case "LARGE_CONTENT_CHANGES":
 		return "Made large content modifications"
+	case "NEW_TO_PAGE_OLD_ACCOUNT":
+		return "Established account that only recently appeared on this page"
+	case "NEW_ACCOUNT_NEW_TO_PAGE":
+		return "Recently created account that recently appeared on this page"
 	case "RECENT_ACCOUNT_HIGH_ACTIVITY":
 		return "Recent account with high overall activity"
 	case "USER_BLOCKED":
 		return "Currently blocked user"
 	case "SINGLE_PAGE_FOCUS":
 		return "Focuses primarily on single pages"
+	case "SINGLE_PURPOSE_ACCOUNT":
+		return "Edits concentrated on a single topic"
+	case "CITATION_REMOVAL_PATTERN":
+		return "Repeatedly removes references from the page"
+	case "UNSOURCED_CONTENT_ADDER":
+		return "Consistently adds substantial text without citations"
+	case "COSMETIC_EDIT_INFLATION":
+		return "Pads edit count with cosmetic-only edits"
+	case "AUTOCONFIRMED_GAMING":
+		return "Gamed autoconfirmed status to edit a protected page"
+	case "REACTIVATED_AFTER_DORMANCY":
+		return "Dormant account that returned with contested edits"
+	case "HIGH_DELETED_CONTRIBUTIONS":
+		return "Many contributions deleted by administrators"
+	case "CAMPAIGN_WINDOW_CONCENTRATION":
+		return "Edited intensively during a single month, then vanished"
+	case "POSSIBLE_COI":
+		return "Promotional edits focused on one person or organization (possible COI)"
+	case "LOW_PROFILE_SUSTAINED_CAMPAIGN":
+		return "Slowly reshapes one contentious article in small, spread-out edits"
 	case "NO_SPECIAL_GROUPS":
 		return "No special user groups despite activity"
 	case "SENSITIVE_NAMESPACE_FOCUS":
@@ -812,7 +1172,50 @@ func formatContributorSuspicionFlag(flag string) string {
 		return "Often leaves empty edit comments"
 	case "ANONYMOUS_USER":
 		return "Anonymous IP address"
+	case "REINTRODUCED_VANDALISM":
+		return "Restored content reverted as vandalism"
+	case "ANON_FROM_PROXY":
+		return "IP address in a hosting, VPN or open proxy range"
+	case "POSSIBLE_LOGGED_OUT_EDITING":
+		return "Edits like another contributor, logged in or out (possible logged-out editing)"
 	default:
 		return flag
 	}
 }
+
+// formatContributorTurnover summarizes how many recent editors are new to the page
+func formatContributorTurnover(turnover *models.ContributorTurnover) string {
+	turnoverColor := successColor
+	if turnover.TurnoverScore >= 0.7 {
+		turnoverColor = dangerColor
+	} else if turnover.TurnoverScore >= 0.4 {
+		turnoverColor = warningColor
+	}
+
+	return turnoverColor.Sprintf("%.0f%%", turnover.TurnoverScore*100) + secondaryColor.Sprintf(" (%d of %d editors of the last %d days new to the page, %d long-term)",
+		turnover.NewContributors, turnover.RecentContributors, turnover.WindowDays, turnover.LongTermContributors)
+}
+
+// formatRequestedTitle notes the title as given when the API normalized it
+func formatRequestedTitle(profile *models.PageProfile) string {
+	if profile.RequestedTitle == "" {
+		return ""
+	}
+	return secondaryColor.Sprintf(" (requested as %q)", profile.RequestedTitle)
+}
+
+// windowLabel labels the edit count of a window, e.g. "Last 30 days:"
+func windowLabel(days int) string {
+	if days == 1 {
+		return tr("Last day:")
+	}
+	return fmt.Sprintf(tr("Last %d days:"), days)
+}
+
+// windowText describes a window in running text, e.g. "last 7 days"
+func windowText(days int) string {
+	if days == 1 {
+		return tr("last day")
+	}
+	return fmt.Sprintf(tr("last %d days"), days)
+}
@@ -3,13 +3,14 @@
 package formatter
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/intMeric/wikipedia-analyser/internal/models"
-	"gopkg.in/yaml.v2"
 )
 
 // FormatCrossPageAnalysis formats the cross-page analysis according to the specified format
@@ -21,14 +22,16 @@ func FormatCrossPageAnalysis(analysis *models.CrossPageAnalysis, format string)
 		return formatCrossPageAsYAML(analysis)
 	case "table", "":
 		return formatCrossPageAsTable(analysis), nil
+	case "plain":
+		return plainText(formatCrossPageAsTable(analysis)), nil
 	default:
-		return "", fmt.Errorf("unsupported format: %s (supported: table, json, yaml)", format)
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
 	}
 }
 
 // formatCrossPageAsJSON formats cross-page analysis as JSON
 func formatCrossPageAsJSON(analysis *models.CrossPageAnalysis) (string, error) {
-	data, err := json.MarshalIndent(analysis, "", "  ")
+	data, err := marshalJSON(analysis)
 	if err != nil {
 		return "", fmt.Errorf("JSON formatting error: %w", err)
 	}
@@ -37,21 +40,88 @@ func formatCrossPageAsJSON(analysis *models.CrossPageAnalysis) (string, error) {
 
 // formatCrossPageAsYAML formats cross-page analysis as YAML
 func formatCrossPageAsYAML(analysis *models.CrossPageAnalysis) (string, error) {
-	data, err := yaml.Marshal(analysis)
+	data, err := marshalYAML(analysis)
 	if err != nil {
 		return "", fmt.Errorf("YAML formatting error: %w", err)
 	}
 	return string(data), nil
 }
 
+// FormatEvidenceExport serializes a cross-page evidence export (json or yaml)
+func FormatEvidenceExport(export *models.EvidenceExport, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "json", "":
+		data, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("JSON formatting error: %w", err)
+		}
+		return string(data), nil
+	case "yaml", "yml":
+		data, err := yamlWithJSONNames(export)
+		if err != nil {
+			return "", fmt.Errorf("YAML formatting error: %w", err)
+		}
+		return string(data), nil
+	default:
+		return "", fmt.Errorf("unsupported evidence format: %s (supported: json, yaml)", format)
+	}
+}
+
+// FormatEdgeList serializes an interaction edge list (csv or json). CSV columns
+// follow the Gephi edge table: Source, Target, Type (always Directed, the column
+// Gephi reads the edge direction from), Weight, then the interaction kind in its
+// own Kind column, the timestamps and the pages separated by "|".
+func FormatEdgeList(edges []models.InteractionEdge, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "csv", "":
+		var buffer strings.Builder
+		writer := csv.NewWriter(&buffer)
+		writer.Write([]string{"Source", "Target", "Type", "Weight", "Kind", "FirstSeen", "LastSeen", "Pages"})
+		for _, edge := range edges {
+			writer.Write([]string{
+				edge.Source,
+				edge.Target,
+				"Directed",
+				strconv.Itoa(edge.Weight),
+				edge.Type,
+				edge.FirstSeen.UTC().Format(time.RFC3339),
+				edge.LastSeen.UTC().Format(time.RFC3339),
+				strings.Join(edge.Pages, "|"),
+			})
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return "", fmt.Errorf("CSV formatting error: %w", err)
+		}
+		return buffer.String(), nil
+	case "json":
+		data, err := json.MarshalIndent(edges, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("JSON formatting error: %w", err)
+		}
+		return string(data), nil
+	default:
+		return "", fmt.Errorf("unsupported edge list format: %s (supported: csv, json)", format)
+	}
+}
+
 // formatCrossPageAsTable formats cross-page analysis as readable table
 func formatCrossPageAsTable(analysis *models.CrossPageAnalysis) string {
 	var output strings.Builder
 
 	// Header with pages and suspicion score
-	output.WriteString(headerColor.Sprint("╭─────────────────────────────────────────────────────────────╮\n"))
-	output.WriteString(headerColor.Sprintf("│  🔗 CROSS-PAGE COORDINATION ANALYSIS                      │\n"))
-	output.WriteString(headerColor.Sprint("╰─────────────────────────────────────────────────────────────╯\n\n"))
+	output.WriteString(boxHeader("🔗 CROSS-PAGE COORDINATION ANALYSIS", "", 21))
+
+	// Overall risk first: the page scores and the coordination score reconciled
+	overall := analysis.OverallRisk
+	overallColor := getSuspicionColor(overall.Score)
+	output.WriteString(fmt.Sprintf("🎯 %s %s (%d/100)\n",
+		overallColor.Sprint(tr("Overall Investigation Risk:")),
+		overallColor.Sprint(getSuspicionText(overall.Score)),
+		overall.Score))
+	for _, line := range overall.Explanation {
+		output.WriteString(secondaryColor.Sprint("   • ") + line + "\n")
+	}
 
 	// Suspicion score with color
 	suspicionText := getSuspicionText(analysis.SuspicionScore)
@@ -62,31 +132,31 @@ func formatCrossPageAsTable(analysis *models.CrossPageAnalysis) string {
 		analysis.SuspicionScore))
 
 	// Analysis overview
-	output.WriteString(headerColor.Sprint("📊 ANALYSIS OVERVIEW\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
-
-	output.WriteString("📄 Pages Analyzed:     " + strings.Join(analysis.Pages, ", ") + "\n")
-	output.WriteString("🌍 Wikipedia Language: " + analysis.Language + "\n")
-	output.WriteString("📊 Total Contributors: " + strconv.Itoa(analysis.TotalContributors) + "\n")
-	output.WriteString("👥 Common Contributors: " + strconv.Itoa(len(analysis.CommonContributors)) + "\n")
-	output.WriteString("🔍 Analysis Timestamp: " + analysis.AnalysisTimestamp.Format("02/01/2006 15:04:05") + "\n")
+	output.WriteString(headerColor.Sprint("📊 " + tr("ANALYSIS OVERVIEW") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	output.WriteString("📄 " + label("Pages Analyzed:", 20) + strings.Join(analysis.Pages, ", ") + "\n")
+	output.WriteString("🌍 " + label("Wikipedia Language:", 20) + analysis.Language + "\n")
+	output.WriteString("📊 " + label("Total Contributors:", 20) + strconv.Itoa(analysis.TotalContributors) + "\n")
+	output.WriteString("👥 " + label("Common Contributors:", 21) + strconv.Itoa(len(analysis.CommonContributors)) + "\n")
+	if len(analysis.Renames) > 0 {
+		output.WriteString("🏷️  " + label("Renamed Accounts:", 20) + formatUserRenames(analysis.Renames) + "\n")
+	}
+	output.WriteString("🔍 " + label("Analysis Timestamp:", 20) + formatTimestamp(analysis.AnalysisTimestamp, "02/01/2006 15:04:05") + "\n")
 	output.WriteString("\n")
 
 	// Suspicion flags
 	if len(analysis.SuspicionFlags) > 0 {
-		output.WriteString(warningColor.Sprint("⚠️  COORDINATION INDICATORS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
-		for _, flag := range analysis.SuspicionFlags {
-			flagText := formatCrossPageSuspicionFlag(flag)
-			output.WriteString(fmt.Sprintf("🔸 %s\n", warningColor.Sprint(flagText)))
-		}
+		output.WriteString(warningColor.Sprint("⚠️  " + tr("COORDINATION INDICATORS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		output.WriteString(formatFlagLines(analysis.SuspicionFlags, formatCrossPageSuspicionFlag))
 		output.WriteString("\n")
 	}
 
 	// Mutual support patterns
 	if len(analysis.CoordinatedPatterns.MutualSupportPairs) > 0 {
-		output.WriteString(headerColor.Sprint("🛡️ MUTUAL SUPPORT PATTERNS\n"))
-		output.WriteString(strings.Repeat("─", 80) + "\n")
+		output.WriteString(headerColor.Sprint("🛡️ " + tr("MUTUAL SUPPORT PATTERNS") + "\n"))
+		output.WriteString(separator(80) + "\n")
 
 		for i, pair := range analysis.CoordinatedPatterns.MutualSupportPairs {
 			if i >= 10 { // Limit to top 10
@@ -114,7 +184,7 @@ func formatCrossPageAsTable(analysis *models.CrossPageAnalysis) string {
 						break
 					}
 					output.WriteString(fmt.Sprintf("      %s: %s defended %s (%s, %dm reaction)\n",
-						event.Timestamp.Format("02/01 15:04"),
+						formatTimestamp(event.Timestamp, "02/01 15:04"),
 						event.DefenderUser,
 						event.SupportedUser,
 						event.SupportType,
@@ -127,18 +197,15 @@ func formatCrossPageAsTable(analysis *models.CrossPageAnalysis) string {
 
 	// Common contributors analysis
 	if len(analysis.CommonContributors) > 0 {
-		output.WriteString(headerColor.Sprint("👥 CONTRIBUTORS ACROSS MULTIPLE PAGES\n"))
-		output.WriteString(strings.Repeat("─", 80) + "\n")
+		output.WriteString(headerColor.Sprint("👥 " + tr("CONTRIBUTORS ACROSS MULTIPLE PAGES") + "\n"))
+		output.WriteString(separator(80) + "\n")
 
 		for i, contributor := range analysis.CommonContributors {
 			if i >= 15 { // Limit to top 15
 				break
 			}
 
-			username := contributor.Username
-			if len(username) > 25 {
-				username = username[:25] + "..."
-			}
+			username := truncateString(contributor.Username, scaleWidth(28))
 
 			userType := "👤"
 			suspicionDisplay := ""
@@ -159,9 +226,9 @@ func formatCrossPageAsTable(analysis *models.CrossPageAnalysis) string {
 				}
 			}
 
-			output.WriteString(fmt.Sprintf("%s %-28s %3d pages | %4d total edits | %s\n",
+			output.WriteString(fmt.Sprintf("%s %-*s %3d pages | %4d total edits | %s\n",
 				userType,
-				username,
+				scaleWidth(28), username,
 				len(contributor.PagesEdited),
 				contributor.TotalEdits,
 				suspicionDisplay))
@@ -176,45 +243,142 @@ func formatCrossPageAsTable(analysis *models.CrossPageAnalysis) string {
 				}
 				if len(pageDetails) > 0 {
 					pageDetailsStr := strings.Join(pageDetails, ", ")
-					if len(pageDetailsStr) > 70 {
-						pageDetailsStr = pageDetailsStr[:70] + "..."
-					}
+					pageDetailsStr = truncateString(pageDetailsStr, scaleWidth(73))
 					output.WriteString(fmt.Sprintf("   📋 %s\n", secondaryColor.Sprint(pageDetailsStr)))
 				}
 			}
+
+			if len(contributor.FormerNames) > 0 {
+				output.WriteString(fmt.Sprintf("   🏷️  %s\n", infoColor.Sprint("Formerly "+strings.Join(contributor.FormerNames, ", ")+" (edits merged)")))
+			}
+
+			if cadence := contributor.Cadence; cadence != nil && cadence.Regular {
+				output.WriteString(fmt.Sprintf("   ⏱️  %s\n", warningColor.Sprintf("Edits every %.1f min (variation %.0f%% over %d gaps)",
+					cadence.MeanGapMinutes, cadence.CoefficientOfVariation*100, cadence.Intervals)))
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Accounts sharing the same page footprint
+	if len(analysis.FootprintClusters) > 0 {
+		output.WriteString(headerColor.Sprint("🧩 " + tr("SHARED PAGE FOOTPRINTS") + "\n"))
+		output.WriteString(separator(80) + "\n")
+
+		for _, cluster := range analysis.FootprintClusters {
+			output.WriteString(fmt.Sprintf("🧩 %d accounts | %.0f%% average overlap | %d shared pages\n",
+				len(cluster.Users),
+				cluster.AverageSimilarity*100,
+				len(cluster.SharedPages)))
+			output.WriteString(fmt.Sprintf("   👥 %s\n",
+				truncateString(strings.Join(cluster.Users, ", "), scaleWidth(73))))
+			if len(cluster.SharedPages) > 0 {
+				output.WriteString(fmt.Sprintf("   📋 %s\n",
+					secondaryColor.Sprint(truncateString(strings.Join(cluster.SharedPages, ", "), scaleWidth(73)))))
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// Accounts editing at the same regular interval
+	if len(analysis.CadenceGroups) > 0 {
+		output.WriteString(headerColor.Sprint("⏱️  " + tr("MATCHING EDIT CADENCE") + "\n"))
+		output.WriteString(separator(80) + "\n")
+
+		for _, group := range analysis.CadenceGroups {
+			output.WriteString(fmt.Sprintf("⏱️  %d accounts editing every ~%.1f min\n",
+				len(group.Users), group.MeanGapMinutes))
+			output.WriteString(fmt.Sprintf("   👥 %s\n",
+				truncateString(strings.Join(group.Users, ", "), scaleWidth(73))))
+		}
+		output.WriteString("\n")
+	}
+
+	// Accounts taking up another's edit summary phrasing
+	if len(analysis.SummaryAdoptions) > 0 {
+		output.WriteString(headerColor.Sprint("✍️  " + tr("ADOPTED EDIT SUMMARIES") + "\n"))
+		output.WriteString(separator(80) + "\n")
+
+		for _, adoption := range analysis.SummaryAdoptions {
+			output.WriteString(fmt.Sprintf("✍️  %s → %s, %.0fh after %s appeared\n",
+				adoption.Originator, warningColor.Sprint(adoption.Adopter),
+				adoption.ReactionHours, adoption.Originator))
+			output.WriteString(fmt.Sprintf("   💬 \"%s\" (%s / %s)\n",
+				truncateString(adoption.Summary, scaleWidth(50)),
+				pluralf(adoption.OriginatorUses, "%d use", "%d uses"),
+				pluralf(adoption.AdopterUses, "%d use", "%d uses")))
+			output.WriteString(fmt.Sprintf("   📋 %s\n",
+				secondaryColor.Sprint(truncateString(strings.Join(adoption.Pages, ", "), scaleWidth(73)))))
+		}
+		output.WriteString("\n")
+	}
+
+	// Accounts succeeding each other on the same pages
+	if len(analysis.AccountHandoffs) > 0 {
+		output.WriteString(headerColor.Sprint("🔀 " + tr("ACCOUNT HANDOFFS") + "\n"))
+		output.WriteString(separator(80) + "\n")
+
+		for _, handoff := range analysis.AccountHandoffs {
+			var timing string
+			if handoff.GapHours < 0 {
+				timing = fmt.Sprintf("%.0fh of overlap", -handoff.GapHours)
+			} else {
+				timing = fmt.Sprintf("%.0fh after the last edit", handoff.GapHours)
+			}
+			output.WriteString(fmt.Sprintf("🔀 %s → %s on %s (%s)\n",
+				handoff.Predecessor, warningColor.Sprint(handoff.Successor),
+				formatTimestamp(handoff.ChangeoverDate, "2006-01-02"), timing))
+			output.WriteString(fmt.Sprintf("   📋 %s\n",
+				secondaryColor.Sprint(truncateString(strings.Join(handoff.SharedPages, ", "), scaleWidth(73)))))
+		}
+		output.WriteString("\n")
+	}
+
+	// IP addresses editing like one of the accounts
+	if len(analysis.LoggedOutEditing) > 0 {
+		output.WriteString(headerColor.Sprint("🕶️  " + tr("LOGGED-OUT EDITING") + "\n"))
+		output.WriteString(separator(80) + "\n")
+
+		for _, match := range analysis.LoggedOutEditing {
+			output.WriteString(fmt.Sprintf("🕶️  %s edits like %s (%.0f%% same hours, %.0f%% within 3 hours of its edits)\n",
+				warningColor.Sprint(match.Address), match.Account, match.HourOverlap*100, match.Proximity*100))
+			output.WriteString(fmt.Sprintf("   📋 %s\n",
+				secondaryColor.Sprint(truncateString(strings.Join(match.Pages, ", "), scaleWidth(73)))))
 		}
 		output.WriteString("\n")
 	}
 
 	// Coordination score breakdown
-	output.WriteString(headerColor.Sprint("📈 COORDINATION METRICS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
-
-	output.WriteString(fmt.Sprintf("🤝 Coordination Score:    %.1f/100\n", analysis.CoordinatedPatterns.CoordinationScore))
-	output.WriteString(fmt.Sprintf("🛡️  Mutual Support Pairs:  %d\n", len(analysis.CoordinatedPatterns.MutualSupportPairs)))
-	output.WriteString(fmt.Sprintf("🔄 Tag Team Patterns:     %d\n", len(analysis.CoordinatedPatterns.TagTeamEditing)))
-	output.WriteString(fmt.Sprintf("⚔️  Coordinated Reverts:   %d\n", len(analysis.CoordinatedPatterns.CoordinatedReversions)))
-	output.WriteString(fmt.Sprintf("🕸️  Support Networks:      %d\n", len(analysis.CoordinatedPatterns.SupportNetworks)))
-	output.WriteString(fmt.Sprintf("🎭 Sockpuppet Networks:   %d\n", len(analysis.SockpuppetNetworks)))
+	output.WriteString(headerColor.Sprint("📈 " + tr("COORDINATION METRICS") + "\n"))
+	output.WriteString(separator(50) + "\n")
+
+	output.WriteString(fmt.Sprintf("🤝 %s%.1f/100\n", label("Coordination Score:", 23), analysis.CoordinatedPatterns.CoordinationScore))
+	output.WriteString(fmt.Sprintf("🛡️  %s%d\n", label("Mutual Support Pairs:", 23), len(analysis.CoordinatedPatterns.MutualSupportPairs)))
+	output.WriteString(fmt.Sprintf("🔄 %s%d\n", label("Tag Team Patterns:", 23), len(analysis.CoordinatedPatterns.TagTeamEditing)))
+	output.WriteString(fmt.Sprintf("⚔️  %s%d\n", label("Coordinated Reverts:", 23), len(analysis.CoordinatedPatterns.CoordinatedReversions)))
+	output.WriteString(fmt.Sprintf("🕸️  %s%d\n", label("Support Networks:", 23), len(analysis.CoordinatedPatterns.SupportNetworks)))
+	output.WriteString(fmt.Sprintf("🎭 %s%d\n", label("Sockpuppet Networks:", 23), len(analysis.SockpuppetNetworks)))
+	output.WriteString(fmt.Sprintf("🧩 %s%d\n", label("Footprint Clusters:", 23), len(analysis.FootprintClusters)))
+	output.WriteString(fmt.Sprintf("⏱️  %s%d\n", label("Cadence Groups:", 23), len(analysis.CadenceGroups)))
+	output.WriteString(fmt.Sprintf("✍️  %s%d\n", label("Summary Adoptions:", 23), len(analysis.SummaryAdoptions)))
+	output.WriteString(fmt.Sprintf("🔀 %s%d\n", label("Account Handoffs:", 23), len(analysis.AccountHandoffs)))
+	output.WriteString(fmt.Sprintf("🕶️  %s%d\n", label("Logged-out editing:", 23), len(analysis.LoggedOutEditing)))
 	output.WriteString("\n")
 
 	// Page-by-page summary
 	if len(analysis.PageProfiles) > 0 {
-		output.WriteString(headerColor.Sprint("📄 PAGE-BY-PAGE SUMMARY\n"))
-		output.WriteString(strings.Repeat("─", 80) + "\n")
+		output.WriteString(headerColor.Sprint("📄 " + tr("PAGE-BY-PAGE SUMMARY") + "\n"))
+		output.WriteString(separator(80) + "\n")
 
 		for _, pageName := range analysis.Pages {
 			if profile, exists := analysis.PageProfiles[pageName]; exists {
-				pageTitle := pageName
-				if len(pageTitle) > 40 {
-					pageTitle = pageTitle[:40] + "..."
-				}
+				pageTitle := truncateString(pageName, scaleWidth(43))
 
 				suspicionText := getSuspicionText(profile.SuspicionScore)
 				suspicionColor := getSuspicionColor(profile.SuspicionScore)
 
-				output.WriteString(fmt.Sprintf("📄 %-43s %s (%d/100)\n",
-					pageTitle,
+				output.WriteString(fmt.Sprintf("📄 %-*s %s (%d/100)\n",
+					scaleWidth(43), pageTitle,
 					suspicionColor.Sprint(suspicionText),
 					profile.SuspicionScore))
 				output.WriteString(fmt.Sprintf("   📊 %d revisions | %d contributors | %.1f%% conflict rate\n",
@@ -227,20 +391,22 @@ func formatCrossPageAsTable(analysis *models.CrossPageAnalysis) string {
 	}
 
 	// Recommendations
-	output.WriteString(headerColor.Sprint("💡 ANALYSIS RECOMMENDATIONS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("💡 " + tr("ANALYSIS RECOMMENDATIONS") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
-	if analysis.SuspicionScore >= 70 {
-		output.WriteString(dangerColor.Sprint("🚨 HIGH COORDINATION DETECTED\n"))
+	risk := getRiskSeverity(analysis.SuspicionScore)
+	switch risk.Level {
+	case "HIGH":
+		output.WriteString(risk.Color.Sprint(risk.Icon + " HIGH COORDINATION DETECTED\n"))
 		output.WriteString("   • Investigate identified user pairs for sockpuppetry\n")
 		output.WriteString("   • Review coordination timeline for organized campaigns\n")
 		output.WriteString("   • Consider checking additional related pages\n")
-	} else if analysis.SuspicionScore >= 40 {
-		output.WriteString(warningColor.Sprint("⚠️ MODERATE COORDINATION DETECTED\n"))
+	case "MODERATE":
+		output.WriteString(risk.Color.Sprint(risk.Icon + " MODERATE COORDINATION DETECTED\n"))
 		output.WriteString("   • Monitor identified patterns for escalation\n")
 		output.WriteString("   • Review user behavior for policy violations\n")
-	} else {
-		output.WriteString(successColor.Sprint("✅ LOW COORDINATION RISK\n"))
+	default:
+		output.WriteString(risk.Color.Sprint(risk.Icon + " LOW COORDINATION RISK\n"))
 		output.WriteString("   • Normal collaborative editing patterns observed\n")
 		output.WriteString("   • Continue standard monitoring procedures\n")
 	}
@@ -254,6 +420,19 @@ func formatCrossPageAsTable(analysis *models.CrossPageAnalysis) string {
 	return output.String()
 }
 
+// FormatPageProgress renders the one-line summary of a page whose analysis just
+// completed during a cross-page run
+func FormatPageProgress(index, total int, profile *models.PageProfile) string {
+	suspicionColor := getSuspicionColor(profile.SuspicionScore)
+	return fmt.Sprintf("✔️  [%d/%d] %s: %s (%d/100) | %.1f%% conflict rate | %d contributors\n",
+		index, total,
+		truncateString(profile.PageTitle, scaleWidth(40)),
+		suspicionColor.Sprint(getSuspicionText(profile.SuspicionScore)),
+		profile.SuspicionScore,
+		profile.ConflictStats.ControversyScore*100,
+		len(profile.Contributors))
+}
+
 // Helper functions for cross-page formatting
 
 // formatCrossPageSuspicionFlag formats cross-page suspicion flags into readable text
@@ -267,6 +446,18 @@ func formatCrossPageSuspicionFlag(flag string) string {
 		return "Potential sockpuppet network identified"
 	case "HIGH_CONTRIBUTOR_OVERLAP":
 		return "High overlap of contributors across pages"
+	case "SHARED_AUTOMATION_CADENCE":
+		return "Several accounts edit at the same regular interval (possible shared automation)"
+	case "REGULAR_CADENCE":
+		return "Edits at near-constant intervals (bot-like)"
+	case "SUMMARY_STYLE_ADOPTION":
+		return "Accounts took up another's distinctive edit summaries shortly after it appeared"
+	case "ADOPTED_SUMMARY_STYLE":
+		return "Reuses the distinctive edit summaries of an account that appeared shortly before"
+	case "ACCOUNT_HANDOFF":
+		return "Account started editing the same pages as another stopped (possible handoff)"
+	case "POSSIBLE_LOGGED_OUT_EDITING":
+		return "An IP address edits the same pages, at the same hours, as one of the accounts (possible logged-out editing)"
 	case "TEMPORAL_SYNCHRONIZATION":
 		return "Synchronized editing patterns detected"
 	case "TAG_TEAM_EDITING":
@@ -277,3 +468,12 @@ func formatCrossPageSuspicionFlag(flag string) string {
 		return flag
 	}
 }
+
+// formatUserRenames lists account renames as "Old → New" pairs
+func formatUserRenames(renames []models.UserRename) string {
+	pairs := make([]string, len(renames))
+	for i, rename := range renames {
+		pairs[i] = rename.OldName + " → " + rename.NewName
+	}
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,106 @@
+// internal/formatter/plain.go
+package formatter
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	// ansiPattern matches terminal color escape sequences
+	ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	// labelPaddingPattern matches the alignment spaces between a label and its value
+	labelPaddingPattern = regexp.MustCompile(`: {2,}`)
+
+	// plainReplacer spells out the symbols that carry meaning
+	plainReplacer = strings.NewReplacer("•", "-", "→", "->", "↔", "<->", "⇄", "<->", "↳", "->", "×", "x")
+)
+
+// plainText turns a table report into plain "Key: value" lines and indented
+// lists, for screen readers and log files: colors, box drawing and emoji are
+// removed, the box title becomes a line of its own.
+func plainText(table string) string {
+	lines := strings.Split(ansiPattern.ReplaceAllString(table, ""), "\n")
+
+	var output strings.Builder
+	blank := true
+	for _, line := range lines {
+		line = plainReplacer.Replace(line)
+
+		// Separators and box borders carry no information
+		if isDecorationLine(line) {
+			continue
+		}
+		// Box title rows: "│  TITLE │"
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "│") {
+			line = strings.TrimSpace(strings.Trim(trimmed, "│"))
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		text := strings.TrimSpace(stripDecoration(line))
+		text = labelPaddingPattern.ReplaceAllString(text, ": ")
+
+		// Collapse the blank lines around removed decorations
+		if text == "" {
+			if !blank {
+				output.WriteString("\n")
+			}
+			blank = true
+			continue
+		}
+		blank = false
+
+		output.WriteString(strings.Repeat(" ", indent) + text + "\n")
+	}
+
+	return output.String()
+}
+
+// isDecorationLine reports whether a line only holds box drawing characters
+func isDecorationLine(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return false
+	}
+	for _, r := range line {
+		if r < 0x2500 || r > 0x257F {
+			return false
+		}
+	}
+	return true
+}
+
+// stripDecoration removes the emoji of a line with the space following each, so
+// that the spacing of table columns is kept as is
+func stripDecoration(line string) string {
+	var stripped strings.Builder
+	dropped := false
+	for _, r := range line {
+		if dropDecoration(r) < 0 {
+			dropped = true
+			continue
+		}
+		if dropped && r == ' ' {
+			dropped = false
+			continue
+		}
+		dropped = false
+		stripped.WriteRune(r)
+	}
+	return stripped.String()
+}
+
+// dropDecoration removes emoji, their variation selectors and joiners, and the
+// box drawing characters left inside lines
+func dropDecoration(r rune) rune {
+	switch {
+	case unicode.Is(unicode.So, r):
+		return -1
+	case r == '\uFE0F' || r == '\uFE0E' || r == '\u200D' || r == '\u20E3':
+		return -1
+	case r >= 0x2500 && r <= 0x257F:
+		return -1
+	}
+	return r
+}
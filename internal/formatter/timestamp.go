@@ -0,0 +1,133 @@
+// internal/formatter/timestamp.go
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Timestamp display modes
+const (
+	TimeDisplayAuto     = "auto"     // Each report picks the style of each timestamp
+	TimeDisplayAbsolute = "absolute" // "02/01/2006 15:04"
+	TimeDisplayRelative = "relative" // "3 days ago"
+	TimeDisplayBoth     = "both"     // "02/01/2006 15:04 (3 days ago)"
+)
+
+// timeDisplay is the timestamp style of table reports
+var timeDisplay = TimeDisplayAuto
+
+// now is the reference time of relative timestamps
+var now = time.Now
+
+// SetTimeDisplay sets how table reports render timestamps: auto, absolute,
+// relative or both
+func SetTimeDisplay(mode string) error {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	switch mode {
+	case TimeDisplayAuto, TimeDisplayAbsolute, TimeDisplayRelative, TimeDisplayBoth:
+	default:
+		return fmt.Errorf("unsupported time display: %s (supported: auto, absolute, relative, both)", mode)
+	}
+	timeDisplay = mode
+	return nil
+}
+
+// formatTimestamp renders an instant, absolute unless another time display is set
+func formatTimestamp(t time.Time, layout string) string {
+	return renderTime(t, layout, TimeDisplayAbsolute)
+}
+
+// formatDatedAge renders an instant whose age matters (account registration, page
+// creation), with its age unless another time display is set
+func formatDatedAge(t time.Time, layout string) string {
+	return renderTime(t, layout, TimeDisplayBoth)
+}
+
+// formatTimeAgo renders how long ago an instant was, unless another time display is set
+func formatTimeAgo(t time.Time, layout string) string {
+	return renderTime(t, layout, TimeDisplayRelative)
+}
+
+// columnTimestamp renders an instant in a column of a list, always absolute: a
+// relative rendering varies in width and would break the columns. timestampSuffix
+// carries the relative rendering at the end of the line.
+func columnTimestamp(t time.Time, layout string) string {
+	return t.Format(layout)
+}
+
+// timestampSuffix renders how long ago the instant of a list line was, appended
+// to the line when the relative or both time display is set
+func timestampSuffix(t time.Time) string {
+	if suffix := TimestampSuffix(t); suffix != "" {
+		return secondaryColor.Sprint(suffix)
+	}
+	return ""
+}
+
+// FormatTimestamp renders an instant in the time display set by SetTimeDisplay,
+// absolute in auto
+func FormatTimestamp(t time.Time, layout string) string {
+	return formatTimestamp(t, layout)
+}
+
+// TimestampSuffix renders " (3 days ago)" for the end of a list line whose
+// timestamp column stays absolute, when the relative or both time display is
+// set, and nothing otherwise
+func TimestampSuffix(t time.Time) string {
+	switch timeDisplay {
+	case TimeDisplayRelative, TimeDisplayBoth:
+		return " (" + relativeTime(t) + ")"
+	default:
+		return ""
+	}
+}
+
+// renderTime renders t in the configured time display, or in auto when the
+// display is left to the report
+func renderTime(t time.Time, layout, auto string) string {
+	mode := timeDisplay
+	if mode == TimeDisplayAuto {
+		mode = auto
+	}
+
+	switch mode {
+	case TimeDisplayRelative:
+		return relativeTime(t)
+	case TimeDisplayBoth:
+		return t.Format(layout) + " (" + relativeTime(t) + ")"
+	default:
+		return t.Format(layout)
+	}
+}
+
+// relativeTime renders the distance from now to t in the largest whole unit,
+// "3 days ago" or "in 2 hours"
+func relativeTime(t time.Time) string {
+	elapsed := now().Sub(t)
+	future := elapsed < 0
+	if future {
+		elapsed = -elapsed
+	}
+
+	switch {
+	case elapsed < time.Minute:
+		return tr("just now")
+	case elapsed < time.Hour:
+		if future {
+			return pluralf(int(elapsed.Minutes()), "in %d minute", "in %d minutes")
+		}
+		return pluralf(int(elapsed.Minutes()), "%d minute ago", "%d minutes ago")
+	case elapsed < 24*time.Hour:
+		if future {
+			return pluralf(int(elapsed.Hours()), "in %d hour", "in %d hours")
+		}
+		return pluralf(int(elapsed.Hours()), "%d hour ago", "%d hours ago")
+	default:
+		if future {
+			return pluralf(int(elapsed.Hours()/24), "in %d day", "in %d days")
+		}
+		return pluralf(int(elapsed.Hours()/24), "%d day ago", "%d days ago")
+	}
+}
@@ -2,7 +2,6 @@
 package formatter
 
 import (
-	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -11,7 +10,6 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/intMeric/wikipedia-analyser/internal/models"
-	"gopkg.in/yaml.v2"
 )
 
 // FormatUserProfile formats the user profile according to the specified format
@@ -23,14 +21,16 @@ func FormatUserProfile(profile *models.UserProfile, format string) (string, erro
 		return formatUserAsYAML(profile)
 	case "table", "":
 		return formatUserAsTable(profile), nil
+	case "plain":
+		return plainText(formatUserAsTable(profile)), nil
 	default:
-		return "", fmt.Errorf("unsupported format: %s (supported: table, json, yaml)", format)
+		return "", fmt.Errorf("unsupported format: %s (supported: table, plain, json, yaml)", format)
 	}
 }
 
 // formatUserAsJSON formats user profile as JSON
 func formatUserAsJSON(profile *models.UserProfile) (string, error) {
-	data, err := json.MarshalIndent(profile, "", "  ")
+	data, err := marshalJSON(profile)
 	if err != nil {
 		return "", fmt.Errorf("JSON formatting error: %w", err)
 	}
@@ -39,7 +39,7 @@ func formatUserAsJSON(profile *models.UserProfile) (string, error) {
 
 // formatUserAsYAML formats user profile as YAML
 func formatUserAsYAML(profile *models.UserProfile) (string, error) {
-	data, err := yaml.Marshal(profile)
+	data, err := marshalYAML(profile)
 	if err != nil {
 		return "", fmt.Errorf("YAML formatting error: %w", err)
 	}
@@ -51,26 +51,27 @@ func formatUserAsTable(profile *models.UserProfile) string {
 	var output strings.Builder
 
 	// Header with username and suspicion score
-	output.WriteString(headerColor.Sprint("╭─────────────────────────────────────────────────────────────╮\n"))
-	output.WriteString(headerColor.Sprintf("│  📊 WIKIPEDIA USER PROFILE: %-27s │\n", profile.Username))
-	output.WriteString(headerColor.Sprint("╰─────────────────────────────────────────────────────────────╯\n\n"))
+	output.WriteString(boxHeader("📊 WIKIPEDIA USER PROFILE: ", profile.Username, 27))
 
 	// Suspicion score with color
 	suspicionText := getSuspicionText(profile.SuspicionScore)
 	suspicionColor := getSuspicionColor(profile.SuspicionScore)
 	output.WriteString(fmt.Sprintf("🚨 %s %s (%d/100)\n\n",
-		suspicionColor.Sprint("Suspicion Score:"),
+		suspicionColor.Sprint(tr("Suspicion Score:")),
 		suspicionColor.Sprint(suspicionText),
 		profile.SuspicionScore))
+	output.WriteString(formatCoverage(profile.Coverage))
+	output.WriteString(formatScoreBreakdown(profile.ScoreBreakdown))
 
 	// Basic information
-	output.WriteString(headerColor.Sprint("📋 BASIC INFORMATION\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("📋 " + tr("BASIC INFORMATION") + "\n"))
+	output.WriteString(separator(50) + "\n")
 
 	// Basic information - using simple formatting instead of complex table
-	output.WriteString("👤 Username:           " + profile.Username + "\n")
-	output.WriteString("🆔 User ID:            " + strconv.Itoa(profile.UserID) + "\n")
-	output.WriteString("✏️ Edit Count:         " + strconv.Itoa(profile.EditCount) + "\n")
+	output.WriteString("👤 " + label("Username:", 20) + profile.Username + "\n")
+	output.WriteString("🆔 " + label("User ID:", 20) + strconv.Itoa(profile.UserID) + "\n")
+	output.WriteString("✏️ " + label("Edit Count:", 20) + strconv.Itoa(profile.EditCount) + " " + tr("(lifetime)") + "\n")
+	output.WriteString("🔬 " + label("Analyzed Sample:", 20) + pluralf(profile.SampleSize, "%d most recent contribution", "%d most recent contributions") + "\n")
 
 	// Add revoked contributions percentage in basic info
 	if profile.RevokedCount > 0 {
@@ -87,32 +88,40 @@ func formatUserAsTable(profile *models.UserProfile) string {
 		} else {
 			revokedDisplay = successColor.Sprintf("%.1f%% (MINIMAL)", revokedPercentage)
 		}
-		output.WriteString("🚫 Revoked Ratio:      " + revokedDisplay + "\n")
+		output.WriteString("🚫 " + label("Revoked Ratio:", 20) + revokedDisplay + fmt.Sprintf(" of %d sampled\n", profile.SampleSize))
+	} else if profile.RevokedSkipped {
+		output.WriteString("🚫 " + label("Revoked Ratio:", 20) + secondaryColor.Sprint("not analyzed (skipped)") + "\n")
 	} else {
-		output.WriteString("🚫 Revoked Ratio:      " + successColor.Sprint("0.0% (NONE)") + "\n")
+		output.WriteString("🚫 " + label("Revoked Ratio:", 20) + successColor.Sprint("0.0% (NONE)") + "\n")
+	}
+
+	if profile.DeletedCount > 0 {
+		output.WriteString("🗑️  " + label("Deleted Edits:", 20) + warningColor.Sprint(strconv.Itoa(profile.DeletedCount)) + "\n")
 	}
 
 	if profile.RegistrationDate != nil {
-		regDate := profile.RegistrationDate.Format("02/01/2006")
-		daysSince := int(time.Since(*profile.RegistrationDate).Hours() / 24)
-		output.WriteString(fmt.Sprintf("📅 Registration Date:  %s (%d days ago)\n", regDate, daysSince))
+		output.WriteString("📅 " + label("Registration Date:", 20) + formatDatedAge(*profile.RegistrationDate, "02/01/2006"))
+		if profile.RegistrationEst {
+			output.WriteString(secondaryColor.Sprint(" (estimated from first edit)"))
+		}
+		output.WriteString("\n")
 	}
 
-	output.WriteString("🌍 Wikipedia Language: " + profile.Language + "\n")
-	output.WriteString("🔍 Analysis Performed: " + profile.RetrievedAt.Format("02/01/2006 15:04:05") + "\n")
+	output.WriteString("🌍 " + label("Wikipedia Language:", 20) + profile.Language + "\n")
+	output.WriteString("🔍 " + label("Analysis Performed:", 20) + formatTimestamp(profile.RetrievedAt, "02/01/2006 15:04:05") + "\n")
 	output.WriteString("\n")
 
 	// Groups and rights
 	if len(profile.Groups) > 0 || len(profile.ImplicitGroups) > 0 {
-		output.WriteString(headerColor.Sprint("👥 GROUPS AND RIGHTS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
+		output.WriteString(headerColor.Sprint("👥 " + tr("GROUPS AND RIGHTS") + "\n"))
+		output.WriteString(separator(50) + "\n")
 
 		if len(profile.Groups) > 0 {
-			output.WriteString(fmt.Sprintf("🏷️  Explicit Groups: %s\n",
+			output.WriteString(fmt.Sprintf("🏷️  %s%s\n", label("Explicit Groups:", 17),
 				infoColor.Sprint(strings.Join(profile.Groups, ", "))))
 		}
 		if len(profile.ImplicitGroups) > 0 {
-			output.WriteString(fmt.Sprintf("🔒 Implicit Groups: %s\n",
+			output.WriteString(fmt.Sprintf("🔒 %s%s\n", label("Implicit Groups:", 17),
 				secondaryColor.Sprint(strings.Join(profile.ImplicitGroups, ", "))))
 		}
 		output.WriteString("\n")
@@ -120,51 +129,38 @@ func formatUserAsTable(profile *models.UserProfile) string {
 
 	// Block information
 	if profile.BlockInfo != nil && profile.BlockInfo.Blocked {
-		output.WriteString(dangerColor.Sprint("🚫 USER BLOCKED\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
-		output.WriteString(fmt.Sprintf("👮 Blocked by: %s\n", profile.BlockInfo.BlockedBy))
-		output.WriteString(fmt.Sprintf("📝 Reason: %s\n", profile.BlockInfo.Reason))
+		output.WriteString(dangerColor.Sprint("🚫 " + tr("USER BLOCKED") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		output.WriteString(fmt.Sprintf("👮 %s%s\n", label("Blocked by:", 12), profile.BlockInfo.BlockedBy))
+		output.WriteString(fmt.Sprintf("📝 %s%s\n", label("Reason:", 8), profile.BlockInfo.Reason))
 		if !profile.BlockInfo.BlockEnd.IsZero() {
-			output.WriteString(fmt.Sprintf("⏰ Block expires: %s\n",
-				profile.BlockInfo.BlockEnd.Format("02/01/2006 15:04:05")))
+			output.WriteString(fmt.Sprintf("⏰ %s%s\n", label("Block expires:", 15),
+				formatTimestamp(profile.BlockInfo.BlockEnd, "02/01/2006 15:04:05")))
 		}
 		output.WriteString("\n")
 	}
 
 	// Suspicion flags
 	if len(profile.SuspicionFlags) > 0 {
-		output.WriteString(warningColor.Sprint("⚠️  SUSPICION INDICATORS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
-		for _, flag := range profile.SuspicionFlags {
-			flagText := formatUserSuspicionFlag(flag)
-			output.WriteString(fmt.Sprintf("🔸 %s\n", warningColor.Sprint(flagText)))
-		}
+		output.WriteString(warningColor.Sprint("⚠️  " + tr("SUSPICION INDICATORS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+		output.WriteString(formatFlagLines(profile.SuspicionFlags, formatUserSuspicionFlag))
 		output.WriteString("\n")
 	}
 
 	// Revoked contributions analysis
 	if profile.RevokedCount > 0 {
-		output.WriteString(warningColor.Sprint("🚫 REVOKED CONTRIBUTIONS ANALYSIS\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
+		output.WriteString(warningColor.Sprint("🚫 " + tr("REVOKED CONTRIBUTIONS ANALYSIS") + "\n"))
+		output.WriteString(separator(50) + "\n")
 
-		output.WriteString("🔄 Total Revoked:      " + strconv.Itoa(profile.RevokedCount) + "\n")
-		output.WriteString(fmt.Sprintf("📊 Revoked Ratio:      %.1f%% of all contributions\n", profile.RevokedRatio*100))
+		output.WriteString("🔄 " + label("Total Revoked:", 20) + strconv.Itoa(profile.RevokedCount) + "\n")
+		output.WriteString(fmt.Sprintf("📊 %s%.1f%% of the %d sampled contributions\n", label("Revoked Ratio:", 20), profile.RevokedRatio*100, profile.SampleSize))
 
 		// Display suspicion level based on ratio
-		var revokedStatus string
-		if profile.RevokedRatio > 0.5 {
-			revokedStatus = dangerColor.Sprint("VERY HIGH - Potential vandal")
-		} else if profile.RevokedRatio > 0.3 {
-			revokedStatus = warningColor.Sprint("HIGH - Suspicious activity")
-		} else if profile.RevokedRatio > 0.2 {
-			revokedStatus = warningColor.Sprint("MODERATE - Needs monitoring")
-		} else if profile.RevokedRatio > 0.1 {
-			revokedStatus = infoColor.Sprint("LOW - Some issues")
-		} else {
-			revokedStatus = successColor.Sprint("MINIMAL - Normal conflicts")
-		}
+		revokedSeverity := getRevokedRatioSeverity(profile.RevokedRatio)
+		revokedStatus := revokedSeverity.Color.Sprint(tr(revokedSeverity.Label))
 
-		output.WriteString("⚠️  Risk Level:        " + revokedStatus + "\n")
+		output.WriteString("⚠️  " + label("Risk Level:", 19) + revokedStatus + "\n")
 
 		// Analyze revert types
 		revertTypes := make(map[string]int)
@@ -215,8 +211,8 @@ func formatUserAsTable(profile *models.UserProfile) string {
 
 	// Detailed revoked contributions list
 	if len(profile.RevokedContribs) > 0 {
-		output.WriteString(dangerColor.Sprint("📋 DETAILED REVOKED CONTRIBUTIONS\n"))
-		output.WriteString(strings.Repeat("─", 100) + "\n")
+		output.WriteString(dangerColor.Sprint("📋 " + tr("DETAILED REVOKED CONTRIBUTIONS") + "\n"))
+		output.WriteString(separator(100) + "\n")
 
 		// Sort revoked contributions by date (most recent first)
 		sortedRevoked := make([]models.RevokedContribution, len(profile.RevokedContribs))
@@ -239,16 +235,10 @@ func formatUserAsTable(profile *models.UserProfile) string {
 			contrib := revoked.OriginalContrib
 
 			// Format page title
-			title := contrib.PageTitle
-			if len(title) > 35 {
-				title = title[:35] + "..."
-			}
+			title := truncateString(contrib.PageTitle, scaleWidth(38))
 
 			// Format comment
-			comment := contrib.Comment
-			if len(comment) > 30 {
-				comment = comment[:30] + "..."
-			}
+			comment := truncateString(contrib.Comment, scaleWidth(33))
 			if comment == "" {
 				comment = secondaryColor.Sprint("(no comment)")
 			}
@@ -290,29 +280,27 @@ func formatUserAsTable(profile *models.UserProfile) string {
 				revokedBy = secondaryColor.Sprint("system")
 			} else if revokedBy == "detected" {
 				revokedBy = secondaryColor.Sprint("detect")
-			} else if len(revokedBy) > 15 {
-				revokedBy = revokedBy[:15] + "..."
+			} else {
+				revokedBy = truncateString(revokedBy, scaleWidth(18))
 			}
 
 			// Main line: Date | Page | Size | Comment | Reverted by | Delay | Type
-			output.WriteString(fmt.Sprintf("%-12s %-37s %s %-32s rev:%s (%s) %s\n",
-				contrib.Timestamp.Format("02/01 15:04"),
-				title,
+			output.WriteString(fmt.Sprintf("%-12s %-*s %s %-*s rev:%s (%s) %s%s\n",
+				columnTimestamp(contrib.Timestamp, "02/01 15:04"),
+				scaleWidth(37), title,
 				diffStr,
-				comment,
+				scaleWidth(32), comment,
 				revokedBy,
 				delayStr,
 				revertColor.Sprint(revertTypeDisplay),
+				timestampSuffix(contrib.Timestamp),
 			))
 
 			// Second line: Revert comment (if meaningful and not too long)
 			if revoked.RevertComment != "" &&
 				revoked.RevertComment != "Detected from revision tags" &&
 				len(strings.TrimSpace(revoked.RevertComment)) > 5 {
-				revertComment := revoked.RevertComment
-				if len(revertComment) > 80 {
-					revertComment = revertComment[:80] + "..."
-				}
+				revertComment := truncateString(revoked.RevertComment, scaleWidth(83))
 				output.WriteString(fmt.Sprintf("             %s\n",
 					secondaryColor.Sprintf("↳ \"%s\"", revertComment)))
 			}
@@ -326,26 +314,110 @@ func formatUserAsTable(profile *models.UserProfile) string {
 	}
 
 	// Activity statistics - using simple formatting
-	output.WriteString(headerColor.Sprint("📈 ACTIVITY STATISTICS\n"))
-	output.WriteString(strings.Repeat("─", 50) + "\n")
+	output.WriteString(headerColor.Sprint("📈 " + tr("ACTIVITY STATISTICS") + "\n"))
+	output.WriteString(separator(50) + "\n")
+	if len(profile.Namespaces) > 0 {
+		namespaces := make([]string, len(profile.Namespaces))
+		for i, namespace := range profile.Namespaces {
+			namespaces[i] = strconv.Itoa(namespace)
+		}
+		output.WriteString(secondaryColor.Sprintf(tr("Computed over the %d most recent contributions in namespace(s) %s, not the lifetime edit count")+"\n",
+			profile.SampleSize, strings.Join(namespaces, ", ")))
+	} else {
+		output.WriteString(secondaryColor.Sprintf(tr("Computed over the %d most recent contributions, not the lifetime edit count")+"\n", profile.SampleSize))
+	}
 
 	if profile.ActivityStats.DaysActive > 0 {
-		output.WriteString("📅 Days Active:        " + strconv.Itoa(profile.ActivityStats.DaysActive) + "\n")
-		output.WriteString(fmt.Sprintf("📊 Edits/day (average): %.2f\n", profile.ActivityStats.AverageEditsPerDay))
+		output.WriteString("📅 " + label("Days Active:", 20) + strconv.Itoa(profile.ActivityStats.DaysActive) + "\n")
+		output.WriteString(fmt.Sprintf("📊 %s%.2f\n", label("Edits/day (average):", 21), profile.ActivityStats.AverageEditsPerDay))
+	}
+	output.WriteString(fmt.Sprintf("🕐 %s%02d:00\n", label("Most Active Hour:", 20), profile.ActivityStats.MostActiveHour))
+	output.WriteString("📆 " + label("Most Active Day:", 20) + profile.ActivityStats.MostActiveDay + "\n")
+	if profile.CosmeticStats != nil {
+		output.WriteString(fmt.Sprintf("🧹 %s%d/%d sampled (%.1f%%)\n", label("Cosmetic Edits:", 20),
+			profile.CosmeticStats.CosmeticEdits,
+			profile.CosmeticStats.SampledEdits,
+			profile.CosmeticStats.CosmeticRatio*100))
+	}
+	if jump := profile.AutoconfirmedJump; jump != nil {
+		output.WriteString(fmt.Sprintf("🔓 %s%s after %d edits (day %.1f)\n", label("First Protected Edit:", 22),
+			truncateString(jump.PageTitle, scaleWidth(30)), jump.EditsBefore, jump.AccountAgeDays))
+	}
+	if concentration := profile.Concentration; concentration != nil && concentration.TotalEdits > 1 {
+		output.WriteString(fmt.Sprintf("🗓️  Densest %d Days:    %d/%d edits (%.1f%%, %s → %s)\n",
+			concentration.WindowDays,
+			concentration.WindowEdits,
+			concentration.TotalEdits,
+			concentration.Ratio*100,
+			formatTimestamp(concentration.WindowStart, "2006-01-02"),
+			formatTimestamp(concentration.WindowEnd, "2006-01-02")))
+	}
+	if coi := profile.ConflictOfInterest; coi != nil {
+		output.WriteString(fmt.Sprintf("💼 %s%d/%d edits on %s (%.1f%%), promotional wording in %d/%d sampled edits\n", label("Entity Focus:", 20),
+			coi.EntityEdits,
+			coi.TotalEdits,
+			truncateString(coi.Entity, scaleWidth(30)),
+			coi.Ratio*100,
+			coi.PromotionalEdits,
+			coi.SampledEdits))
+		if len(coi.PromotionalTerms) > 0 {
+			output.WriteString("   " + label("Promotional Terms:", 20) + warningColor.Sprint(strings.Join(coi.PromotionalTerms, ", ")) + "\n")
+		}
+		if coi.MatchesUsername {
+			output.WriteString("   " + dangerColor.Sprint("Entity named like the account (possible autobiography)") + "\n")
+		}
+	}
+	if creation := profile.MassCreation; creation != nil {
+		output.WriteString(fmt.Sprintf("🏭 %s%d created, %d of %d sampled stubs on a shared skeleton",
+			label("Page Creations:", 20), creation.CreatedPages, len(creation.TemplatePages), creation.SampledPages))
+		if len(creation.TemplatePages) > 0 {
+			output.WriteString(fmt.Sprintf(" (%.0f%% similar, %d bytes on average)", creation.TemplateSimilarity*100, creation.AverageStubBytes))
+		}
+		output.WriteString("\n")
+		if len(creation.TemplatePages) > 0 {
+			output.WriteString("   " + label("Template Stubs:", 20) + truncateString(strings.Join(creation.TemplatePages, ", "), scaleWidth(60)) + "\n")
+		}
+	}
+	if campaign := profile.LowProfileCampaign; campaign != nil {
+		output.WriteString(fmt.Sprintf("🐢 %s%d edits to %s over %d days, %d bytes on average, at most %d a day",
+			label("Low-Profile Edits:", 20),
+			campaign.Edits,
+			truncateString(campaign.PageTitle, scaleWidth(30)),
+			campaign.SpanDays,
+			campaign.AverageBytes,
+			campaign.MaxDailyEdits))
+		var contention []string
+		if campaign.RevertedEdits > 0 {
+			contention = append(contention, strconv.Itoa(campaign.RevertedEdits)+" reverted")
+		}
+		if campaign.Disputed {
+			contention = append(contention, "page disputed")
+		}
+		if len(contention) > 0 {
+			output.WriteString(dangerColor.Sprint(" (" + strings.Join(contention, ", ") + ")"))
+		}
+		output.WriteString("\n")
+	}
+	if reactivation := profile.Reactivation; reactivation != nil {
+		output.WriteString(fmt.Sprintf("💤 %s%d days (%s → %s), then %d edits on %d pages in %d days (%d reverted)\n", label("Longest Gap:", 20),
+			reactivation.GapDays,
+			formatTimestamp(reactivation.DormantSince, "2006-01-02"),
+			formatTimestamp(reactivation.ReactivatedAt, "2006-01-02"),
+			reactivation.BurstEdits,
+			len(reactivation.BurstPages),
+			reactivation.WindowDays,
+			reactivation.ContentiousEdits))
 	}
-	output.WriteString(fmt.Sprintf("🕐 Most Active Hour:   %02d:00\n", profile.ActivityStats.MostActiveHour))
-	output.WriteString("📆 Most Active Day:    " + profile.ActivityStats.MostActiveDay + "\n")
 	output.WriteString("\n")
 
 	// Namespace distribution - using simple formatting
-	if len(profile.ActivityStats.NamespaceDistrib) > 0 {
-		output.WriteString(headerColor.Sprint("📂 NAMESPACE DISTRIBUTION\n"))
-		output.WriteString(strings.Repeat("─", 50) + "\n")
-
-		totalEdits := 0
-		for _, count := range profile.ActivityStats.NamespaceDistrib {
-			totalEdits += count
-		}
+	totalEdits := 0
+	for _, count := range profile.ActivityStats.NamespaceDistrib {
+		totalEdits += count
+	}
+	if totalEdits > 0 {
+		output.WriteString(headerColor.Sprint("📂 " + tr("NAMESPACE DISTRIBUTION") + "\n"))
+		output.WriteString(separator(50) + "\n")
 
 		for ns, count := range profile.ActivityStats.NamespaceDistrib {
 			percentage := float64(count) / float64(totalEdits) * 100
@@ -356,24 +428,60 @@ func formatUserAsTable(profile *models.UserProfile) string {
 
 	// Most edited pages - using simple formatting
 	if len(profile.TopPages) > 0 {
-		output.WriteString(headerColor.Sprint("📄 MOST EDITED PAGES\n"))
-		output.WriteString(strings.Repeat("─", 80) + "\n")
+		output.WriteString(headerColor.Sprint("📄 " + tr("MOST EDITED PAGES") + "\n"))
+		output.WriteString(separator(80) + "\n")
 
 		for i, page := range profile.TopPages {
 			if i >= 5 { // Limit to 5 pages
 				break
 			}
 
-			title := page.PageTitle
-			if len(title) > 50 {
-				title = title[:50] + "..."
-			}
+			title := truncateString(page.PageTitle, scaleWidth(53))
 
-			output.WriteString(fmt.Sprintf("%-55s %3d edits %+5d diff %s\n",
-				title,
+			output.WriteString(fmt.Sprintf("%-*s %3d edits %+5d diff %s%s\n",
+				scaleWidth(55), title,
 				page.EditCount,
 				page.TotalSizeDiff,
-				page.LastEdit.Format("02/01/06"),
+				formatTimestamp(page.LastEdit, "02/01/06"),
+				formatPageControversy(page.Controversy),
+			))
+		}
+
+		scanned, disputed := 0, 0
+		for _, page := range profile.TopPages {
+			if page.Controversy != nil {
+				scanned++
+				if page.Controversy.Disputed {
+					disputed++
+				}
+			}
+		}
+		if disputed > 0 {
+			output.WriteString(warningColor.Sprintf("⚔️ %d of %d scanned pages are disputed\n", disputed, scanned))
+		}
+		output.WriteString("\n")
+	}
+
+	// Topic clusters - only worth showing when pages actually group together
+	if len(profile.TopicClusters) > 0 && len(profile.TopicClusters[0].Pages) > 1 {
+		output.WriteString(headerColor.Sprint("🧭 " + tr("TOPIC CLUSTERS") + "\n"))
+		output.WriteString(separator(50) + "\n")
+
+		for i, cluster := range profile.TopicClusters {
+			if i >= 3 || len(cluster.Pages) < 2 {
+				break
+			}
+
+			terms := "-"
+			if len(cluster.SharedTerms) > 0 {
+				terms = truncateString(strings.Join(cluster.SharedTerms, ", "), scaleWidth(40))
+			}
+
+			output.WriteString(fmt.Sprintf("%d pages, %d edits (%.1f%%) - %s\n",
+				len(cluster.Pages),
+				cluster.EditCount,
+				cluster.EditRatio*100,
+				terms,
 			))
 		}
 		output.WriteString("\n")
@@ -381,23 +489,17 @@ func formatUserAsTable(profile *models.UserProfile) string {
 
 	// Recent contributions (preview) - modified to show revocations
 	if len(profile.RecentContribs) > 0 {
-		output.WriteString(headerColor.Sprint("🕒 RECENT CONTRIBUTIONS (last 5)\n"))
-		output.WriteString(strings.Repeat("─", 90) + "\n")
+		output.WriteString(headerColor.Sprint("🕒 " + tr("RECENT CONTRIBUTIONS (last 5)") + "\n"))
+		output.WriteString(separator(90) + "\n")
 
 		for i, contrib := range profile.RecentContribs {
 			if i >= 5 {
 				break
 			}
 
-			title := contrib.PageTitle
-			if len(title) > 30 {
-				title = title[:30] + "..."
-			}
+			title := truncateString(contrib.PageTitle, scaleWidth(33))
 
-			comment := contrib.Comment
-			if len(comment) > 25 {
-				comment = comment[:25] + "..."
-			}
+			comment := truncateString(contrib.Comment, scaleWidth(28))
 			if comment == "" {
 				comment = secondaryColor.Sprint("(no comment)")
 			}
@@ -415,21 +517,16 @@ func formatUserAsTable(profile *models.UserProfile) string {
 				revokedIndicator = dangerColor.Sprint(" [REVOKED]")
 
 				// Add who revoked and when
-				revokedAge := int(time.Since(contrib.RevokedAt).Hours() / 24)
-				if revokedAge == 0 {
-					revokedIndicator += secondaryColor.Sprint(" by " + contrib.RevokedBy + " (today)")
-				} else {
-					revokedIndicator += secondaryColor.Sprintf(" by %s (%dd ago)",
-						contrib.RevokedBy, revokedAge)
-				}
+				revokedIndicator += secondaryColor.Sprintf(" by %s (%s)", contrib.RevokedBy, formatTimeAgo(contrib.RevokedAt, "02/01/2006"))
 			}
 
-			output.WriteString(fmt.Sprintf("%-12s %-32s %s %s%s\n",
-				contrib.Timestamp.Format("02/01 15:04"),
-				title,
+			output.WriteString(fmt.Sprintf("%-12s %-*s %s %s%s%s\n",
+				columnTimestamp(contrib.Timestamp, "02/01 15:04"),
+				scaleWidth(32), title,
 				diffStr,
 				comment,
 				revokedIndicator,
+				timestampSuffix(contrib.Timestamp),
 			))
 		}
 		output.WriteString("\n")
@@ -458,6 +555,24 @@ func formatUserSuspicionFlag(flag string) string {
 		return "User currently blocked"
 	case "SINGLE_PAGE_FOCUS":
 		return "Excessive focus on single page"
+	case "SINGLE_PURPOSE_ACCOUNT":
+		return "Single-purpose account (edits concentrated on one topic)"
+	case "COSMETIC_EDIT_INFLATION":
+		return "Edit count inflated by cosmetic-only edits"
+	case "AUTOCONFIRMED_GAMING":
+		return "Reached autoconfirmed with minimal edits, then targeted a semi-protected page"
+	case "REACTIVATED_AFTER_DORMANCY":
+		return "Dormant account reactivated with a burst of contested edits"
+	case "HIGH_DELETED_CONTRIBUTIONS":
+		return "Large share of contributions deleted by administrators"
+	case "CAMPAIGN_WINDOW_CONCENTRATION":
+		return "Nearly all edits made within one month, then inactive (campaign account)"
+	case "POSSIBLE_COI":
+		return "Possible conflict of interest (promotional edits focused on one person or organization)"
+	case "MASS_STUB_CREATION":
+		return "Mass creation of near-identical stub pages from one template (spam farming)"
+	case "LOW_PROFILE_SUSTAINED_CAMPAIGN":
+		return "Many small, spread-out edits to one contentious article, staying under patrol radar"
 	case "NO_SPECIAL_GROUPS":
 		return "No special groups despite activity"
 	case "SENSITIVE_NAMESPACE_FOCUS":
@@ -530,3 +645,16 @@ func formatRevertTypeShort(revertType string) string {
 		return strings.ToUpper(revertType)
 	}
 }
+
+// formatPageControversy renders the conflict scan of a most edited page, empty
+// when the page was not scanned
+func formatPageControversy(controversy *models.PageControversy) string {
+	if controversy == nil {
+		return ""
+	}
+	text := fmt.Sprintf(" %d/%d reverts, controversy %.2f", controversy.Reverts, controversy.ScannedRevisions, controversy.ControversyScore)
+	if controversy.Disputed {
+		return dangerColor.Sprint(text + " ⚔️ disputed")
+	}
+	return secondaryColor.Sprint(text)
+}
@@ -7,22 +7,27 @@ import (
 
 // ContributionProfile represents the complete analysis of a Wikipedia contribution
 type ContributionProfile struct {
-	RevisionID      int                 `json:"revision_id"`
-	PageTitle       string              `json:"page_title"`
-	PageID          int                 `json:"page_id"`
-	Language        string              `json:"language"`
-	Timestamp       time.Time           `json:"timestamp"`
-	Comment         string              `json:"comment"`
-	Size            int                 `json:"size"`
-	IsMinor         bool                `json:"is_minor"`
-	IsRevert        bool                `json:"is_revert"`
-	Author          ContributionAuthor  `json:"author"`
-	ContentAnalysis ContributionContent `json:"content_analysis"`
-	ContextAnalysis ContributionContext `json:"context_analysis"`
-	QualityMetrics  ContributionQuality `json:"quality_metrics"`
-	SuspicionScore  int                 `json:"suspicion_score"`
-	SuspicionFlags  []string            `json:"suspicion_flags"`
-	RetrievedAt     time.Time           `json:"retrieved_at"`
+	RevisionID         int                 `json:"revision_id"`
+	PageTitle          string              `json:"page_title"`
+	PageID             int                 `json:"page_id"`
+	Language           string              `json:"language"`
+	Timestamp          time.Time           `json:"timestamp"`
+	Comment            string              `json:"comment"`
+	Size               int                 `json:"size"`
+	IsMinor            bool                `json:"is_minor"`
+	IsRevert           bool                `json:"is_revert"`
+	RevertDelaySeconds int                 `json:"revert_delay_seconds,omitempty"` // Time since the reverted edit
+	ToolAssistedRevert bool                `json:"tool_assisted_revert,omitempty"` // Revert too fast for manual review
+	Tags               []string            `json:"tags,omitempty"`
+	Author             ContributionAuthor  `json:"author"`
+	ContentAnalysis    ContributionContent `json:"content_analysis"`
+	ContextAnalysis    ContributionContext `json:"context_analysis"`
+	QualityMetrics     ContributionQuality `json:"quality_metrics"`
+	SuspicionScore     int                 `json:"suspicion_score"`
+	SuspicionFlags     []string            `json:"suspicion_flags"`
+	ScoreBreakdown     *ScoreBreakdown     `json:"score_breakdown,omitempty"`
+	Coverage           []CoverageCheck     `json:"coverage,omitempty"`
+	RetrievedAt        time.Time           `json:"retrieved_at"`
 }
 
 // ContributionAuthor represents the author of a contribution
@@ -30,13 +35,17 @@ type ContributionAuthor struct {
 	Username         string             `json:"username"`
 	UserID           int                `json:"user_id"`
 	IsAnonymous      bool               `json:"is_anonymous"`
+	IsHidden         bool               `json:"is_hidden,omitempty"` // Username revision-deleted
 	IsRegistered     bool               `json:"is_registered"`
 	IsBlocked        bool               `json:"is_blocked"`
 	EditCount        int                `json:"edit_count"`
 	Groups           []string           `json:"groups"`
 	RegistrationDate *time.Time         `json:"registration_date"`
+	RegistrationEst  bool               `json:"registration_estimated,omitempty"` // Date taken from the earliest contribution
 	RecentActivity   RecentUserActivity `json:"recent_activity"`
 	SuspicionScore   int                `json:"suspicion_score"`
+	ProfileSkipped   bool               `json:"profile_skipped,omitempty"` // Author profile budget exhausted
+	ProfileError     string             `json:"profile_error,omitempty"`   // Author profile fetch failed
 }
 
 // RecentUserActivity represents recent activity patterns
@@ -60,13 +69,20 @@ type ContributionContent struct {
 
 // TextChangeAnalysis represents analysis of text changes
 type TextChangeAnalysis struct {
-	CharsAdded       int      `json:"chars_added"`
-	CharsRemoved     int      `json:"chars_removed"`
-	WordsAdded       int      `json:"words_added"`
-	WordsRemoved     int      `json:"words_removed"`
-	SectionsAffected []string `json:"sections_affected"`
-	IsStructural     bool     `json:"is_structural"`
-	IsTrivial        bool     `json:"is_trivial"`
+	CharsAdded             int      `json:"chars_added"`
+	CharsRemoved           int      `json:"chars_removed"`
+	WordsAdded             int      `json:"words_added"`
+	WordsRemoved           int      `json:"words_removed"`
+	SectionsAffected       []string `json:"sections_affected"`
+	IsStructural           bool     `json:"is_structural"`
+	IsTrivial              bool     `json:"is_trivial"`
+	IsCosmetic             bool     `json:"is_cosmetic"`        // Rendered text unchanged (whitespace/markup only)
+	MisleadingSummary      bool     `json:"misleading_summary"` // Typo/format summary on a substantive content change
+	StealthPOV             bool     `json:"stealth_pov"`        // Tiny diff changing a loaded word, a negation or a figure
+	StealthChanges         []string `json:"stealth_changes,omitempty"`
+	RemovedMaintenanceTags []string `json:"removed_maintenance_tags,omitempty"` // Maintenance templates removed
+	MaintenanceAddressed   bool     `json:"maintenance_addressed,omitempty"`    // References added or text rewritten along with them
+	ContentCompared        bool     `json:"content_compared,omitempty"`         // Revision text diffed with its parent's, not estimated from sizes
 }
 
 // LinksAnalysis represents analysis of link changes
@@ -208,3 +224,29 @@ type ComplianceInfo struct {
 	VandalismRisk       float64  `json:"vandalism_risk"`
 	ViolatedPolicies    []string `json:"violated_policies"`
 }
+
+// WatchAlertSchemaVersion is the version of the WatchAlert payload
+const WatchAlertSchemaVersion = 1
+
+// WatchAlert is the JSON payload posted to the watch mode webhook for each new
+// revision whose suspicion score reaches the threshold. The schema is stable:
+// fields may be added, but renaming or removing one bumps SchemaVersion.
+// Text and Content carry the same one-line summary, the field Slack and Discord
+// webhooks respectively display, so both accept the payload as is.
+type WatchAlert struct {
+	SchemaVersion  int       `json:"schema_version"`  // WatchAlertSchemaVersion
+	Event          string    `json:"event"`           // Always "suspicious_revision"
+	Text           string    `json:"text"`            // Summary displayed by Slack
+	Content        string    `json:"content"`         // Summary displayed by Discord
+	Language       string    `json:"language"`        // Wikipedia language code
+	PageTitle      string    `json:"page_title"`      // Watched page
+	RevisionID     int       `json:"revision_id"`     // Suspicious revision
+	Timestamp      time.Time `json:"timestamp"`       // Time of the edit (UTC)
+	Author         string    `json:"author"`          // Username or IP address
+	IsAnonymous    bool      `json:"is_anonymous"`    // Author is an IP address
+	Comment        string    `json:"comment"`         // Edit summary
+	SuspicionScore int       `json:"suspicion_score"` // 0-100
+	Threshold      int       `json:"threshold"`       // Score from which alerts are sent
+	SuspicionFlags []string  `json:"suspicion_flags"` // Contribution suspicion flags
+	DiffURL        string    `json:"diff_url"`        // Link to the diff on Wikipedia
+}
@@ -0,0 +1,19 @@
+// internal/models/estimate.go
+package models
+
+// CallEstimate is the number of API requests an analysis would send, worked out
+// from its options without contacting the API
+type CallEstimate struct {
+	Target     string             `json:"target"` // What would be analyzed, e.g. "3 pages"
+	Steps      []CallEstimateStep `json:"steps"`
+	Total      int                `json:"total"`
+	UpperBound bool               `json:"upper_bound"` // Some steps depend on the data: Total is a maximum
+}
+
+// CallEstimateStep is one step of an analysis and the requests it sends
+type CallEstimateStep struct {
+	Step       string `json:"step"`
+	Calls      int    `json:"calls"`
+	UpperBound bool   `json:"upper_bound,omitempty"` // At most Calls, depending on the data
+	Note       string `json:"note,omitempty"`
+}
@@ -0,0 +1,28 @@
+// internal/models/investigation.go
+package models
+
+import (
+	"time"
+)
+
+// InvestigationReport combines contribution, author and page analysis for a single revision
+type InvestigationReport struct {
+	RevisionID    int                   `json:"revision_id"`
+	PageTitle     string                `json:"page_title"`
+	Language      string                `json:"language"`
+	Contribution  *ContributionProfile  `json:"contribution"`
+	AuthorProfile *UserProfile          `json:"author_profile,omitempty"`
+	PageProfile   *PageProfile          `json:"page_profile,omitempty"`
+	Signals       []InvestigationSignal `json:"signals"`
+	Narrative     []string              `json:"narrative"`
+	RiskScore     int                   `json:"risk_score"`
+	RiskLevel     string                `json:"risk_level"`
+	Errors        []string              `json:"errors,omitempty"`
+	AnalyzedAt    time.Time             `json:"analyzed_at"`
+}
+
+// InvestigationSignal is a suspicion flag deduplicated across sub-analyses
+type InvestigationSignal struct {
+	Flag    string   `json:"flag"`
+	Sources []string `json:"sources"` // "contribution", "author", "page"
+}
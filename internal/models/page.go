@@ -7,22 +7,33 @@ import (
 
 // PageProfile represents the complete profile of a Wikipedia page
 type PageProfile struct {
-	PageTitle       string           `json:"page_title"`
-	PageID          int              `json:"page_id"`
-	Namespace       int              `json:"namespace"`
-	Language        string           `json:"language"`
-	CreationDate    *time.Time       `json:"creation_date"`
-	LastModified    time.Time        `json:"last_modified"`
-	TotalRevisions  int              `json:"total_revisions"`
-	PageSize        int              `json:"page_size"`
-	Contributors    []TopContributor `json:"top_contributors"`
-	RecentRevisions []Revision       `json:"recent_revisions"`
-	ConflictStats   ConflictStats    `json:"conflict_stats"`
-	QualityMetrics  QualityMetrics   `json:"quality_metrics"`
-	SuspicionScore  int              `json:"suspicion_score"`
-	SuspicionFlags  []string         `json:"suspicion_flags"`
-	SourceAnalysis  *SourceAnalysis  `json:"source_analysis,omitempty"`
-	RetrievedAt     time.Time        `json:"retrieved_at"`
+	PageTitle           string              `json:"page_title"`
+	RequestedTitle      string              `json:"requested_title,omitempty"` // Title as given, when the API normalized it
+	PageID              int                 `json:"page_id"`
+	Namespace           int                 `json:"namespace"`
+	Language            string              `json:"language"`
+	CreationDate        *time.Time          `json:"creation_date"`
+	LastModified        time.Time           `json:"last_modified"`
+	TotalRevisions      int                 `json:"total_revisions"`
+	HistoryRevisions    int                 `json:"history_revisions"`              // Revisions in the detailed history window
+	InsufficientHistory bool                `json:"insufficient_history,omitempty"` // Too few for ratio metrics to mean anything
+	PageSize            int                 `json:"page_size"`
+	Contributors        []TopContributor    `json:"top_contributors"`
+	Moves               []PageMove          `json:"moves,omitempty"`
+	ActivityBaseline    *ActivityBaseline   `json:"activity_baseline,omitempty"`
+	CoordinatedArrival  *CoordinatedArrival `json:"coordinated_arrival,omitempty"`
+	LoggedOutEditing    []LoggedOutEditing  `json:"logged_out_editing,omitempty"` // IP addresses editing like an account
+	RecentRevisions     []Revision          `json:"recent_revisions"`
+	ConflictStats       ConflictStats       `json:"conflict_stats"`
+	QualityMetrics      QualityMetrics      `json:"quality_metrics"`
+	SuspicionScore      int                 `json:"suspicion_score"`
+	SuspicionFlags      []string            `json:"suspicion_flags"`
+	ScoreBreakdown      *ScoreBreakdown     `json:"score_breakdown,omitempty"`
+	Coverage            []CoverageCheck     `json:"coverage,omitempty"`
+	SourceAnalysis      *SourceAnalysis     `json:"source_analysis,omitempty"`
+	PageViews           []DailyPageViews    `json:"page_views,omitempty"`
+	DeletionDiscussion  *DeletionDiscussion `json:"deletion_discussion,omitempty"`
+	RetrievedAt         time.Time           `json:"retrieved_at"`
 }
 
 // TopContributor represents a major contributor to the page
@@ -38,49 +49,244 @@ type TopContributor struct {
 	SuspicionScore int       `json:"suspicion_score"`
 	SuspicionFlags []string  `json:"suspicion_flags"`
 	AnalysisError  string    `json:"analysis_error,omitempty"`
+
+	// Edits on the page without an edit summary; the ratio leaves out
+	// revision-deleted summaries
+	EmptySummaries    int     `json:"empty_summaries"`
+	EmptySummaryRatio float64 `json:"empty_summary_ratio"`
+
+	// Account age when the contributor first appeared on the page (top contributors only)
+	AccountCreated  *time.Time `json:"account_created,omitempty"`
+	FirstSeenOnPage *time.Time `json:"first_seen_on_page,omitempty"`
+
+	// Hosting, VPN or proxy range of an anonymous contributor's address (--check-proxies)
+	Proxy *ProxyMatch `json:"proxy,omitempty"`
+}
+
+// ProxyMatch is the hosting, VPN or open proxy range an IP address belongs to
+type ProxyMatch struct {
+	Range    string `json:"range"`
+	Kind     string `json:"kind"` // hosting, vpn or proxy
+	Provider string `json:"provider,omitempty"`
+}
+
+// CoordinatedArrival is a group of accounts whose first edit to the page falls
+// within a short window, a sign of off-wiki coordination
+type CoordinatedArrival struct {
+	Accounts      []string  `json:"accounts"`
+	WindowStart   time.Time `json:"window_start"`
+	WindowEnd     time.Time `json:"window_end"`
+	WindowMinutes int       `json:"window_minutes"` // Width of the detection window
+}
+
+// ActivityBaseline describes normal contributor activity on a page, used to score
+// activity relative to the page instead of against absolute thresholds
+type ActivityBaseline struct {
+	MedianEdits  float64 `json:"median_edits"` // Median edits per contributor over the history window
+	Contributors int     `json:"contributors"` // Contributors the median was computed from
+	Factor       float64 `json:"factor"`       // Multiplier applied to absolute activity thresholds
 }
 
 // Revision represents a single page revision
 type Revision struct {
-	RevID       int       `json:"rev_id"`
-	ParentID    int       `json:"parent_id"`
-	Username    string    `json:"username"`
-	UserID      int       `json:"user_id,omitempty"`
-	Timestamp   time.Time `json:"timestamp"`
-	Comment     string    `json:"comment"`
-	SizeDiff    int       `json:"size_diff"`
-	NewSize     int       `json:"new_size"`
-	IsMinor     bool      `json:"is_minor"`
-	IsRevert    bool      `json:"is_revert"`
-	IsAnonymous bool      `json:"is_anonymous"`
+	RevID              int       `json:"rev_id"`
+	ParentID           int       `json:"parent_id"`
+	Username           string    `json:"username"`
+	UserID             int       `json:"user_id,omitempty"`
+	Timestamp          time.Time `json:"timestamp"`
+	Comment            string    `json:"comment"`
+	Section            string    `json:"section,omitempty"` // Named by the comment's "/* Section */" marker
+	SizeDiff           int       `json:"size_diff"`
+	NewSize            int       `json:"new_size"`
+	IsMinor            bool      `json:"is_minor"`
+	IsRevert           bool      `json:"is_revert"`
+	RevertKind         string    `json:"revert_kind,omitempty"`          // "full" (restores an earlier version exactly) or "partial"
+	RevertDelaySeconds int       `json:"revert_delay_seconds,omitempty"` // Time since the reverted edit
+	ToolAssisted       bool      `json:"tool_assisted,omitempty"`        // Revert too fast for manual review (rollback, Twinkle...)
+	IsAnonymous        bool      `json:"is_anonymous"`
+	IsHidden           bool      `json:"is_hidden,omitempty"` // Author revision-deleted
+	Tags               []string  `json:"tags,omitempty"`      // Change tags (mw-reverted, mobile edit...)
+
+	ReintroducesVandalism bool `json:"reintroduces_vandalism,omitempty"` // Restores content reverted as vandalism
 }
 
 // ConflictStats contains conflict analysis metrics
 type ConflictStats struct {
-	ReversionsCount  int             `json:"reversions_count"`
-	ConflictingUsers []string        `json:"conflicting_users"`
-	EditWarPeriods   []EditWarPeriod `json:"edit_war_periods"`
-	StabilityScore   float64         `json:"stability_score"`
-	ControversyScore float64         `json:"controversy_score"`
-	RecentConflicts  int             `json:"recent_conflicts_7_days"`
+	ReversionsCount     int                    `json:"reversions_count"`
+	WeightedReverts     float64                `json:"weighted_reverts"`      // Reversions weighted by revert type, used for ControversyScore
+	FullReverts         int                    `json:"full_reverts"`          // Restore an earlier version exactly (3RR-relevant)
+	PartialReverts      int                    `json:"partial_reverts"`       // Undo only part of the intervening changes
+	SelfReverts         int                    `json:"self_reverts"`          // excluded from conflict counts unless requested
+	SelfRevertsCounted  bool                   `json:"self_reverts_counted"`  // Self-reverts were requested in the conflict counts
+	ToolAssistedReverts int                    `json:"tool_assisted_reverts"` // Made within seconds of the reverted edit
+	ConflictingUsers    []string               `json:"conflicting_users"`
+	EditWarPeriods      []EditWarPeriod        `json:"edit_war_periods"`
+	StabilityScore      float64                `json:"stability_score"`
+	ControversyScore    float64                `json:"controversy_score"`
+	RecentConflicts     int                    `json:"recent_conflicts"`
+	ConflictWindowDays  int                    `json:"conflict_window_days"` // Span of RecentConflicts
+	Ownership           *PageOwnership         `json:"ownership,omitempty"`
+	LongestRevertChain  *RevertChain           `json:"longest_revert_chain,omitempty"` // Reverts of reverts, an edit-war indicator
+	TalkActivity        *TalkActivity          `json:"talk_activity,omitempty"`        // Talk page discussion, with --with-talk
+	CombinedControversy float64                `json:"combined_controversy,omitempty"` // ControversyScore weighed by talk heat
+	ProtectionSurge     *ProtectionExpirySurge `json:"protection_surge,omitempty"`     // Editing rush after protection ended
+
+	RecentConflicts7Days  int                     `json:"recent_conflicts_7_days"`          // Over the last 7 days, whatever ConflictWindowDays
+	ReintroducedVandalism []ReintroducedVandalism `json:"reintroduced_vandalism,omitempty"` // Content reverted as vandalism, then restored
+}
+
+// ReintroducedVandalism is a revision restoring, byte for byte (same sha1), a
+// page version that a patroller had reverted as vandalism
+type ReintroducedVandalism struct {
+	RevisionID       int       `json:"revision_id"`
+	User             string    `json:"user"`
+	Timestamp        time.Time `json:"timestamp"`
+	VandalRevisionID int       `json:"vandal_revision_id"` // Reverted revision that first had the content
+	VandalUser       string    `json:"vandal_user"`
+	RevertRevisionID int       `json:"revert_revision_id"` // Vandalism revert that removed the content
+	RevertedBy       string    `json:"reverted_by"`
+	SameAccount      bool      `json:"same_account"` // Restored by the account that first added it
+}
+
+// LoggedOutEditing is an IP address whose editing mirrors a registered account's,
+// the trace of an editor logging out to edit: the same hours of the day, the same
+// narrow focus, edits around the account's and never a revert between the two
+type LoggedOutEditing struct {
+	Address      string   `json:"address"`
+	Account      string   `json:"account"`
+	AddressEdits int      `json:"address_edits"`
+	AccountEdits int      `json:"account_edits"`
+	HourOverlap  float64  `json:"hour_overlap"`    // Share of the hour-of-day distributions in common (0-1)
+	FocusOverlap float64  `json:"focus_overlap"`   // Jaccard similarity of the sections (page) or pages (cross-page) edited
+	Proximity    float64  `json:"proximity"`       // Share of the address's edits within 3 hours of an account edit
+	Pages        []string `json:"pages,omitempty"` // Pages both edited, in cross-page analyses
+}
+
+// ProtectionExpirySurge is a rush of edits right after a page's edit protection
+// expired or was lifted, when the dispute it held back resumes
+type ProtectionExpirySurge struct {
+	ProtectionEnded time.Time `json:"protection_ended"`
+	Level           string    `json:"level"`               // Edit protection level that ended
+	LiftedBy        string    `json:"lifted_by,omitempty"` // Administrator who removed it, empty when it expired
+	WindowHours     int       `json:"window_hours"`        // Hours after the end, fewer when it ended less than 48 hours ago
+	Edits           int       `json:"edits"`               // Edits within the window after the end
+	Reverts         int       `json:"reverts"`
+	Editors         []string  `json:"editors"`
+	ExpectedEdits   float64   `json:"expected_edits"` // At the page's usual rate over the same window
+}
+
+// ProtectionSurgeMinReverts is the number of reverts within a protection expiry
+// surge from which the dispute the protection held back is taken to have resumed
+const ProtectionSurgeMinReverts = 2
+
+// TalkActivity describes the discussion on a page's talk page over the analyzed
+// history window
+type TalkActivity struct {
+	TalkTitle            string  `json:"talk_title"`
+	Revisions            int     `json:"revisions"`
+	Participants         int     `json:"participants"`
+	ConflictParticipants int     `json:"conflict_participants"` // Conflicting users of the page who post on its talk page
+	QuickReplies         int     `json:"quick_replies"`         // Replies to another participant within the hour
+	HeatedSummaries      int     `json:"heated_summaries"`      // Dispute-like edit summaries
+	HeatScore            float64 `json:"heat_score"`            // 0 (silent) to 1 (heated)
+}
+
+// DeletionDiscussion tallies the !votes of a deletion discussion page
+type DeletionDiscussion struct {
+	Votes    []DeletionVote `json:"votes"` // Oldest first, undated ones first
+	Tally    map[string]int `json:"tally"` // Votes per position
+	Clusters []VoteCluster  `json:"clusters,omitempty"`
+}
+
+// DeletionVote is one user's bolded !vote
+type DeletionVote struct {
+	User      string    `json:"user"`
+	Position  string    `json:"position"` // keep, delete, merge, redirect, draftify, userfy
+	Text      string    `json:"text"`     // The bolded text, "Strong keep"
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// VoteCluster is a group of accounts casting the same !vote within a short
+// window, a sign of canvassing
+type VoteCluster struct {
+	Position    string    `json:"position"`
+	Users       []string  `json:"users"`
+	FirstVote   time.Time `json:"first_vote"`
+	LastVote    time.Time `json:"last_vote"`
+	SpanMinutes int       `json:"span_minutes"`
+}
+
+// RevertChain is a run of reverts each undoing the previous one: A is reverted by
+// B, B's revert by C, and so on
+type RevertChain struct {
+	Length      int       `json:"length"`       // Reverts in the chain
+	RevisionIDs []int     `json:"revision_ids"` // Oldest revert first
+	Users       []string  `json:"users"`        // Distinct reverting editors
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+}
+
+// PageOwnership describes one editor reverting most changes made by everyone else
+type PageOwnership struct {
+	Username      string  `json:"username"`
+	RevertedEdits int     `json:"reverted_edits"` // Edits by others this user reverted
+	OthersEdits   int     `json:"others_edits"`   // Edits by others in the history window
+	Ratio         float64 `json:"ratio"`
 }
 
 // EditWarPeriod represents a period of intensive editing conflicts
 type EditWarPeriod struct {
-	StartTime     time.Time `json:"start_time"`
-	EndTime       time.Time `json:"end_time"`
-	Participants  []string  `json:"participants"`
-	RevisionCount int       `json:"revision_count"`
+	StartTime        time.Time            `json:"start_time"`
+	EndTime          time.Time            `json:"end_time"`
+	Participants     []string             `json:"participants"`
+	RevisionCount    int                  `json:"revision_count"`
+	ParticipantStats []EditWarParticipant `json:"participant_stats"` // Most reverts first
+}
+
+// EditWarParticipant counts what one participant did within an edit war window
+type EditWarParticipant struct {
+	Username      string         `json:"username"`
+	Edits         int            `json:"edits"`
+	Reverts       int            `json:"reverts"`                  // Self-reverts excluded
+	RevertedUsers map[string]int `json:"reverted_users,omitempty"` // Reverts by reverted author
 }
 
 // QualityMetrics contains page quality indicators
 type QualityMetrics struct {
-	AverageEditSize      float64       `json:"average_edit_size"`
-	AnonymousEditRatio   float64       `json:"anonymous_edit_ratio"`
-	NewEditorRatio       float64       `json:"new_editor_ratio"`
-	RecentActivityBurst  bool          `json:"recent_activity_burst"`
-	ContributorDiversity float64       `json:"contributor_diversity"`
-	EditFrequency        EditFrequency `json:"edit_frequency"`
+	AverageEditSize      float64              `json:"average_edit_size"`
+	AnonymousEditRatio   float64              `json:"anonymous_edit_ratio"`
+	NewEditorRatio       float64              `json:"new_editor_ratio"`
+	RecentActivityBurst  bool                 `json:"recent_activity_burst"`
+	ContributorDiversity float64              `json:"contributor_diversity"`
+	EditFrequency        EditFrequency        `json:"edit_frequency"`
+	TrafficContext       *TrafficContext      `json:"traffic_context,omitempty"`
+	ContributorTurnover  *ContributorTurnover `json:"contributor_turnover,omitempty"`
+}
+
+// ContributorTurnover compares the editors active on a page in the recent window
+// with its long-term editor base
+type ContributorTurnover struct {
+	WindowDays            int     `json:"window_days"`
+	RecentContributors    int     `json:"recent_contributors"`    // Edited within the window
+	NewContributors       int     `json:"new_contributors"`       // Recent, first page edit within the window
+	ReturningContributors int     `json:"returning_contributors"` // Recent, also edited before the window
+	LongTermContributors  int     `json:"long_term_contributors"` // Edited before the window
+	TurnoverScore         float64 `json:"turnover_score"`         // New share of recent contributors (0-1)
+}
+
+// TrafficContext correlates recent editing activity with reader traffic
+type TrafficContext struct {
+	ViewsLast7Days    int     `json:"views_last_7_days"`
+	AverageDailyViews float64 `json:"average_daily_views"` // baseline, before the last 7 days
+	RecentDailyViews  float64 `json:"recent_daily_views"`  // last 7 days
+	TrafficRatio      float64 `json:"traffic_ratio"`       // recent / baseline
+	BurstContext      string  `json:"burst_context"`       // "traffic_driven", "unexplained", "none"
+}
+
+// DailyPageViews represents the number of views of a page on a given day
+type DailyPageViews struct {
+	Date  time.Time `json:"date"`
+	Views int       `json:"views"`
 }
 
 // EditFrequency contains editing frequency analysis
@@ -88,10 +294,17 @@ type EditFrequency struct {
 	EditsLast7Days   int            `json:"edits_last_7_days"`
 	EditsLast30Days  int            `json:"edits_last_30_days"`
 	EditsLast90Days  int            `json:"edits_last_90_days"`
+	Windows          []EditWindow   `json:"windows"` // Configured windows, shortest first
 	PeakEditingHours []int          `json:"peak_editing_hours"`
 	EditsByDay       map[string]int `json:"edits_by_day"`
 }
 
+// EditWindow counts the edits of the last Days days
+type EditWindow struct {
+	Days  int `json:"days"`
+	Edits int `json:"edits"`
+}
+
 // API Response structures for MediaWiki API
 
 // WikiPageInfo represents page information from the API
@@ -103,21 +316,72 @@ type WikiPageInfo struct {
 	LastRevID int    `json:"lastrevid"`
 	Length    int    `json:"length"`
 	Missing   string `json:"missing,omitempty"`
+
+	NormalizedFrom string `json:"normalized_from,omitempty"` // Title as requested, when the API normalized it
+}
+
+// PageProtection represents a protection entry of a page (inprop=protection)
+type PageProtection struct {
+	Type   string `json:"type"`  // "edit", "move"...
+	Level  string `json:"level"` // "autoconfirmed", "extendedconfirmed", "sysop"...
+	Expiry string `json:"expiry"`
+}
+
+// WikiMoveEvent represents a page move from the move log (list=logevents&letype=move)
+type WikiMoveEvent struct {
+	Title       string `json:"title"`        // Title before the move
+	TargetTitle string `json:"target_title"` // Title after the move
+	User        string `json:"user"`
+	Timestamp   string `json:"timestamp"`
+	Comment     string `json:"comment"`
+}
+
+// WikiProtectionEvent represents an entry of the protection log
+// (list=logevents&letype=protect)
+type WikiProtectionEvent struct {
+	Action    string           `json:"action"` // "protect", "modify", "unprotect", "move_prot"
+	User      string           `json:"user"`
+	Timestamp string           `json:"timestamp"`
+	Comment   string           `json:"comment"`
+	Details   []PageProtection `json:"details"` // Protections set by the entry, none for "unprotect"
+}
+
+// PageMove records a rename of the analyzed page
+type PageMove struct {
+	FromTitle      string    `json:"from_title"`
+	ToTitle        string    `json:"to_title"`
+	MovedBy        string    `json:"moved_by"`
+	MovedAt        time.Time `json:"moved_at"`
+	Comment        string    `json:"comment,omitempty"`
+	RevisionsAdded int       `json:"revisions_added"` // History found under the former title and merged in
 }
 
 // WikiRevision represents a revision from the API
 type WikiRevision struct {
-	RevID     int    `json:"revid"`
-	ParentID  int    `json:"parentid"`
-	User      string `json:"user"`
-	UserID    int    `json:"userid,omitempty"`
-	Timestamp string `json:"timestamp"`
-	Size      int    `json:"size"`
-	Comment   string `json:"comment"`
-	Minor     string `json:"minor,omitempty"`
-	Anon      string `json:"anon,omitempty"`
+	RevID     int      `json:"revid"`
+	ParentID  int      `json:"parentid"`
+	User      string   `json:"user"`
+	UserID    int      `json:"userid,omitempty"`
+	Timestamp string   `json:"timestamp"`
+	Size      int      `json:"size"`
+	SHA1      string   `json:"sha1,omitempty"` // Content hash, empty when the content is hidden
+	Comment   string   `json:"comment"`
+	Minor     string   `json:"minor,omitempty"`
+	Anon      string   `json:"anon,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+
+	// Revision deletion markers: the hidden fields are not returned by the API
+	UserHidden    bool `json:"userhidden,omitempty"`
+	CommentHidden bool `json:"commenthidden,omitempty"`
+	Suppressed    bool `json:"suppressed,omitempty"` // Hidden by oversighters, not just admins
 }
 
+// HiddenUser and HiddenComment stand in for revision-deleted fields
+const (
+	HiddenUser    = "(hidden)"
+	HiddenComment = "(comment hidden)"
+)
+
 // WikiContributor represents a contributor from the API
 type WikiContributor struct {
 	UserID    int    `json:"userid,omitempty"`
@@ -156,13 +420,50 @@ type Discrepancy struct {
 
 // SourceAnalysis contains analysis of page sources and references
 type SourceAnalysis struct {
-	TotalReferences    int                    `json:"total_references"`
-	UniqueReferences   int                    `json:"unique_references"`
-	DomainDistribution map[string]int         `json:"domain_distribution"`
-	TemplateUsage      map[string]int         `json:"template_usage"`
-	ReliabilityScore   float64                `json:"reliability_score"`
-	UnreliableSources  []UnreliableSource     `json:"unreliable_sources"`
-	DeadLinks          []DeadLink             `json:"dead_links"`
+	TotalReferences    int                `json:"total_references"`
+	UniqueReferences   int                `json:"unique_references"`
+	DomainDistribution map[string]int     `json:"domain_distribution"`
+	TemplateUsage      map[string]int     `json:"template_usage"`
+	ReliabilityScore   float64            `json:"reliability_score"`
+	UnreliableSources  []UnreliableSource `json:"unreliable_sources"`
+	DeadLinks          []DeadLink         `json:"dead_links"`
+	ReferenceChurn     *ReferenceChurn    `json:"reference_churn,omitempty"`
+}
+
+// ReferenceChurn tracks references added and removed across successive revisions
+type ReferenceChurn struct {
+	RevisionsAnalyzed int                       `json:"revisions_analyzed"`
+	TotalAdded        int                       `json:"total_added"`
+	TotalRemoved      int                       `json:"total_removed"`
+	Changes           []RevisionReferenceChange `json:"changes"`
+	CitationRemovers  []CitationRemover         `json:"citation_removers"`
+	UnsourcedAdders   []UnsourcedAdder          `json:"unsourced_adders"`
+}
+
+// RevisionReferenceChange is the reference delta introduced by one revision
+type RevisionReferenceChange struct {
+	RevID       int       `json:"rev_id"`
+	Username    string    `json:"username"`
+	Timestamp   time.Time `json:"timestamp"`
+	RefsAdded   int       `json:"refs_added"`
+	RefsRemoved int       `json:"refs_removed"`
+}
+
+// CitationRemover is a user who repeatedly strips references from the page
+type CitationRemover struct {
+	Username     string `json:"username"`
+	RemovalEdits int    `json:"removal_edits"`
+	RefsRemoved  int    `json:"refs_removed"`
+	RefsAdded    int    `json:"refs_added"`
+}
+
+// UnsourcedAdder is a user whose substantial additions to the page carry no citations
+type UnsourcedAdder struct {
+	Username           string `json:"username"`
+	Additions          int    `json:"additions"`           // Revisions adding substantial prose
+	UnsourcedAdditions int    `json:"unsourced_additions"` // Of which adding no reference
+	UnsourcedChars     int    `json:"unsourced_chars"`     // Prose added by the unsourced revisions
+	RefsAdded          int    `json:"refs_added"`
 }
 
 // Reference represents a single reference in the page
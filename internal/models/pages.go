@@ -2,6 +2,7 @@
 package models
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -15,12 +16,31 @@ type CrossPageAnalysis struct {
 	CoordinatedPatterns CoordinatedPatterns     `json:"coordinated_patterns"`
 	TemporalPatterns    TemporalPatterns        `json:"temporal_patterns"`
 	SockpuppetNetworks  []SockpuppetNetwork     `json:"sockpuppet_networks"`
+	FootprintClusters   []FootprintCluster      `json:"footprint_clusters"`
+	CadenceGroups       []CadenceGroup          `json:"cadence_groups"`
+	SummaryAdoptions    []SummaryAdoption       `json:"summary_adoptions,omitempty"`  // Distinctive edit summaries taken up by another account
+	AccountHandoffs     []AccountHandoff        `json:"account_handoffs,omitempty"`   // Accounts taking over as another goes quiet
+	LoggedOutEditing    []LoggedOutEditing      `json:"logged_out_editing,omitempty"` // IP addresses editing like an account
+	Renames             []UserRename            `json:"renames,omitempty"`            // Renamed accounts, merged under their current name
 	SuspicionScore      int                     `json:"suspicion_score"`
 	SuspicionFlags      []string                `json:"suspicion_flags"`
+	OverallRisk         InvestigationRisk       `json:"overall_risk"` // Page scores and coordination reconciled
 	AnalysisTimestamp   time.Time               `json:"analysis_timestamp"`
 	PageProfiles        map[string]*PageProfile `json:"page_profiles"`
 }
 
+// InvestigationRisk reconciles the per-page suspicion scores with the
+// cross-page coordination score: coordination spread thin over several pages
+// can weigh more than any page shows on its own
+type InvestigationRisk struct {
+	Score             int      `json:"score"`
+	HighestPageScore  int      `json:"highest_page_score"`
+	HighestPage       string   `json:"highest_page,omitempty"`
+	CoordinationScore int      `json:"coordination_score"` // The cross-page suspicion score
+	Elevation         int      `json:"elevation"`          // Points added above the highest page score
+	Explanation       []string `json:"explanation"`
+}
+
 // CommonContributor represents a user who edited multiple pages
 type CommonContributor struct {
 	Username            string               `json:"username"`
@@ -34,6 +54,49 @@ type CommonContributor struct {
 	SuspicionFlags      []string             `json:"suspicion_flags"`
 	MutualSupportEvents []MutualSupportEvent `json:"mutual_support_events"`
 	IsAnonymous         bool                 `json:"is_anonymous"`
+	Cadence             *EditCadence         `json:"cadence,omitempty"`
+	FormerNames         []string             `json:"former_names,omitempty"` // Names before a rename, whose edits are merged in
+}
+
+// UserRename records an account renamed from OldName to NewName
+type UserRename struct {
+	OldName   string    `json:"old_name"`
+	NewName   string    `json:"new_name"`
+	RenamedBy string    `json:"renamed_by"`
+	RenamedAt time.Time `json:"renamed_at"`
+}
+
+// EditCadence describes the spacing of a contributor's edits across the analyzed pages
+type EditCadence struct {
+	Intervals              int     `json:"intervals"`                // Gaps between consecutive edits
+	MeanGapMinutes         float64 `json:"mean_gap_minutes"`         // Average gap
+	CoefficientOfVariation float64 `json:"coefficient_of_variation"` // Standard deviation of the gaps over their mean
+	Regular                bool    `json:"regular"`                  // Near-constant gaps, typical of automation
+}
+
+// SummaryAdoption is an account taking up the distinctive edit summary of
+// another account shortly after that account appeared
+type SummaryAdoption struct {
+	Originator     string    `json:"originator"`
+	Adopter        string    `json:"adopter"`
+	Summary        string    `json:"summary"` // As first written by the originator
+	OriginatorUses int       `json:"originator_uses"`
+	AdopterUses    int       `json:"adopter_uses"`
+	FirstUsed      time.Time `json:"first_used"` // By the originator
+	AdoptedAt      time.Time `json:"adopted_at"`
+	ReactionHours  float64   `json:"reaction_hours"` // From the originator's first analyzed edit
+	Pages          []string  `json:"pages"`
+}
+
+// AccountHandoff is a registered account starting to edit the analyzed pages as
+// another one, with which it shares pages, stops
+type AccountHandoff struct {
+	Predecessor    string    `json:"predecessor"`
+	Successor      string    `json:"successor"`
+	LastEdit       time.Time `json:"last_edit"`       // Predecessor's last analyzed edit
+	ChangeoverDate time.Time `json:"changeover_date"` // Successor's first analyzed edit
+	GapHours       float64   `json:"gap_hours"`       // Negative when the active periods overlap
+	SharedPages    []string  `json:"shared_pages"`
 }
 
 // CoordinatedPatterns contains detected coordination patterns
@@ -191,6 +254,22 @@ type SockpuppetAccount struct {
 	SimilarityScore   float64   `json:"similarity_score"`
 }
 
+// FootprintCluster groups contributors whose sets of edited pages overlap heavily
+type FootprintCluster struct {
+	ClusterID         string   `json:"cluster_id"`
+	Users             []string `json:"users"`
+	SharedPages       []string `json:"shared_pages"`       // Pages edited by every member
+	AverageSimilarity float64  `json:"average_similarity"` // Mean Jaccard similarity between members
+}
+
+// CadenceGroup is a set of accounts editing at the same regular interval,
+// a sign of one script driving several accounts
+type CadenceGroup struct {
+	GroupID        string   `json:"group_id"`
+	Users          []string `json:"users"`
+	MeanGapMinutes float64  `json:"mean_gap_minutes"` // Average of the members' mean gaps
+}
+
 // BehaviorPattern represents a pattern of suspicious behavior
 type BehaviorPattern struct {
 	PatternType   string    `json:"pattern_type"`
@@ -211,12 +290,82 @@ type CrossPageAnalysisOptions struct {
 	MinCommonEdits         int     `json:"min_common_edits"`         // Minimum edits to be considered common contributor
 	MaxReactionTime        int     `json:"max_reaction_time"`        // Max minutes for support reaction to be suspicious
 	MinMutualSupportRatio  float64 `json:"min_mutual_support_ratio"` // Min ratio for mutual support detection
+	MinFootprintSimilarity float64 `json:"min_footprint_similarity"` // Min Jaccard similarity of page sets to cluster two users
 	EnableDeepAnalysis     bool    `json:"enable_deep_analysis"`     // Enable resource-intensive analysis
 }
 
+// Validate rejects option values that would make the analysis silently find
+// nothing. Zero values select the analyzer defaults.
+func (o CrossPageAnalysisOptions) Validate() error {
+	if o.MaxRevisionsPerPage < 0 {
+		return fmt.Errorf("max revisions per page cannot be negative (got %d)", o.MaxRevisionsPerPage)
+	}
+	if o.MaxContributorsPerPage < 0 {
+		return fmt.Errorf("max contributors per page cannot be negative (got %d)", o.MaxContributorsPerPage)
+	}
+	if o.HistoryDays < 0 {
+		return fmt.Errorf("history days cannot be negative (got %d)", o.HistoryDays)
+	}
+	if o.MinCommonEdits < 0 {
+		return fmt.Errorf("min common edits cannot be negative (got %d)", o.MinCommonEdits)
+	}
+	if o.MaxReactionTime < 0 {
+		return fmt.Errorf("max reaction time cannot be negative (got %d minutes): no support reaction would ever match", o.MaxReactionTime)
+	}
+	if o.MinMutualSupportRatio < 0 || o.MinMutualSupportRatio > 1 {
+		return fmt.Errorf("min mutual support ratio must be between 0 and 1 (got %.2f)", o.MinMutualSupportRatio)
+	}
+	if o.MinFootprintSimilarity < 0 || o.MinFootprintSimilarity > 1 {
+		return fmt.Errorf("min footprint similarity must be between 0 and 1 (got %.2f)", o.MinFootprintSimilarity)
+	}
+	return nil
+}
+
 // CrossPageAnalysisRequest represents a request for cross-page analysis
 type CrossPageAnalysisRequest struct {
 	Pages    []string                 `json:"pages"`
 	Language string                   `json:"language"`
 	Options  CrossPageAnalysisOptions `json:"options"`
 }
+
+// InteractionEdge is a directed, weighted interaction between two users, the row
+// format of edge lists imported into network analysis tools (Gephi, NetworkX)
+type InteractionEdge struct {
+	Source    string    `json:"source"`
+	Target    string    `json:"target"`
+	Type      string    `json:"type"`   // "reverted" or "defended"
+	Weight    int       `json:"weight"` // Number of interactions
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	Pages     []string  `json:"pages"`
+}
+
+// EvidenceExport is a citeable record of every pattern detected by a cross-page analysis
+type EvidenceExport struct {
+	Pages          []string          `json:"pages"`
+	Language       string            `json:"language"`
+	SuspicionScore int               `json:"suspicion_score"`
+	SuspicionFlags []string          `json:"suspicion_flags"`
+	Patterns       []EvidencePattern `json:"patterns"`
+	GeneratedAt    time.Time         `json:"generated_at"`
+}
+
+// EvidencePattern is one detected pattern and the revisions supporting it
+type EvidencePattern struct {
+	Type           string             `json:"type"` // "mutual_support", "tag_team", "coordinated_revert", "support_network", "synchronized_editing"
+	Description    string             `json:"description"`
+	Users          []string           `json:"users"`
+	Pages          []string           `json:"pages"`
+	SuspicionLevel string             `json:"suspicion_level,omitempty"`
+	Revisions      []EvidenceRevision `json:"revisions"`
+}
+
+// EvidenceRevision is a revision cited as evidence, with a direct link to its diff
+type EvidenceRevision struct {
+	RevisionID int       `json:"revision_id"`
+	PageTitle  string    `json:"page_title"`
+	Username   string    `json:"username"`
+	Timestamp  time.Time `json:"timestamp"`
+	Comment    string    `json:"comment,omitempty"`
+	DiffURL    string    `json:"diff_url"`
+}
@@ -0,0 +1,31 @@
+// internal/models/score.go
+package models
+
+// ScoreBreakdown itemizes how a suspicion score was reached
+type ScoreBreakdown struct {
+	Items      []ScoreItem `json:"items"`
+	RawScore   int         `json:"raw_score"`   // Sum of the items, before the cap
+	FinalScore int         `json:"final_score"` // Reported score, capped at 100
+}
+
+// ScoreItem is one scoring rule that fired, with the points it added
+type ScoreItem struct {
+	Rule     string `json:"rule"`
+	Points   int    `json:"points"`
+	Evidence string `json:"evidence"`
+}
+
+// Coverage check statuses
+const (
+	CheckRan         = "ran"
+	CheckSkipped     = "skipped"     // Not requested, or not applicable to the entity
+	CheckUnavailable = "unavailable" // Requested but could not run (rights, failed request)
+)
+
+// CoverageCheck tells whether one optional analysis ran, so that a report
+// without findings reads as "clean" or "not checked"
+type CoverageCheck struct {
+	Check  string `json:"check"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
@@ -6,25 +6,121 @@ import (
 )
 
 type UserProfile struct {
-	Username         string                `json:"username"`
-	UserID           int                   `json:"user_id"`
-	RegistrationDate *time.Time            `json:"registration_date"`
-	EditCount        int                   `json:"edit_count"`
-	Groups           []string              `json:"groups"`
-	ImplicitGroups   []string              `json:"implicit_groups"`
-	RightsInfo       []string              `json:"rights_info"`
-	BlockInfo        *BlockInfo            `json:"block_info,omitempty"`
-	RecentContribs   []Contribution        `json:"recent_contributions"`
-	TopPages         []PageEditSummary     `json:"top_edited_pages"`
-	ActivityStats    ActivityStats         `json:"activity_stats"`
-	RevokedContribs  []RevokedContribution `json:"revoked_contributions"`
-	RevokedCount     int                   `json:"revoked_count"`
-	RevokedRatio     float64               `json:"revoked_ratio"`
-	RevertedByUsers  map[string]int        `json:"reverted_by_users"`
-	SuspicionScore   int                   `json:"suspicion_score"`
-	SuspicionFlags   []string              `json:"suspicion_flags"`
-	Language         string                `json:"language"`
-	RetrievedAt      time.Time             `json:"retrieved_at"`
+	Username           string                `json:"username"`
+	UserID             int                   `json:"user_id"`
+	RegistrationDate   *time.Time            `json:"registration_date"`
+	RegistrationEst    bool                  `json:"registration_estimated,omitempty"` // Date taken from the earliest contribution
+	EditCount          int                   `json:"edit_count"`                       // Lifetime total reported by the wiki
+	SampleSize         int                   `json:"sample_size"`                      // Recent contributions analyzed; ratios are over this sample
+	Namespaces         []int                 `json:"namespaces,omitempty"`             // Namespace IDs the sample is limited to (--namespace)
+	Groups             []string              `json:"groups"`
+	ImplicitGroups     []string              `json:"implicit_groups"`
+	RightsInfo         []string              `json:"rights_info"`
+	BlockInfo          *BlockInfo            `json:"block_info,omitempty"`
+	RecentContribs     []Contribution        `json:"recent_contributions"`
+	TopPages           []PageEditSummary     `json:"top_edited_pages"`
+	ActivityStats      ActivityStats         `json:"activity_stats"`
+	RevokedContribs    []RevokedContribution `json:"revoked_contributions"`
+	RevokedCount       int                   `json:"revoked_count"`
+	RevokedRatio       float64               `json:"revoked_ratio"`
+	RevokedSkipped     bool                  `json:"revoked_analysis_skipped,omitempty"`
+	RevertedByUsers    map[string]int        `json:"reverted_by_users"`
+	TopicClusters      []TopicCluster        `json:"topic_clusters,omitempty"`
+	CosmeticStats      *CosmeticEditStats    `json:"cosmetic_edits,omitempty"`
+	AutoconfirmedJump  *AutoconfirmedJump    `json:"autoconfirmed_jump,omitempty"`
+	Reactivation       *Reactivation         `json:"reactivation,omitempty"`
+	Concentration      *EditConcentration    `json:"edit_concentration,omitempty"`
+	ConflictOfInterest *ConflictOfInterest   `json:"conflict_of_interest,omitempty"`
+	MassCreation       *MassCreation         `json:"mass_creation,omitempty"`
+	LowProfileCampaign *LowProfileCampaign   `json:"low_profile_campaign,omitempty"`
+	DeletedContribs    []Contribution        `json:"deleted_contributions,omitempty"`
+	DeletedCount       int                   `json:"deleted_count,omitempty"`
+	SuspicionScore     int                   `json:"suspicion_score"`
+	SuspicionFlags     []string              `json:"suspicion_flags"`
+	ScoreBreakdown     *ScoreBreakdown       `json:"score_breakdown,omitempty"`
+	Coverage           []CoverageCheck       `json:"coverage,omitempty"`
+	Language           string                `json:"language"`
+	RetrievedAt        time.Time             `json:"retrieved_at"`
+}
+
+// AutoconfirmedJump describes the first edit of a young account to a semi-protected page
+type AutoconfirmedJump struct {
+	PageTitle      string    `json:"page_title"`
+	Timestamp      time.Time `json:"timestamp"`
+	EditsBefore    int       `json:"edits_before"`
+	AccountAgeDays float64   `json:"account_age_days"`
+}
+
+// Reactivation describes the longest inactivity gap in a user's contributions and
+// the burst of edits that followed it
+type Reactivation struct {
+	DormantSince     time.Time `json:"dormant_since"`
+	ReactivatedAt    time.Time `json:"reactivated_at"`
+	GapDays          int       `json:"gap_days"`
+	WindowDays       int       `json:"window_days"` // burst window after the return
+	BurstEdits       int       `json:"burst_edits"`
+	BurstPages       []string  `json:"burst_pages"`
+	ContentiousEdits int       `json:"contentious_edits"` // burst edits that were reverted
+}
+
+// ConflictOfInterest describes a user whose edits center on a single real-world
+// entity (a person or an organization) and the tone of what they added to it
+type ConflictOfInterest struct {
+	Entity           string   `json:"entity"`
+	EntityEdits      int      `json:"entity_edits"` // Edits to the entity page, its talk page and related pages
+	TotalEdits       int      `json:"total_edits"`
+	Ratio            float64  `json:"ratio"`
+	RelatedPages     []string `json:"related_pages"`
+	MatchesUsername  bool     `json:"matches_username,omitempty"` // Entity named like the account: autobiography
+	SampledEdits     int      `json:"sampled_edits"`
+	PromotionalEdits int      `json:"promotional_edits"` // Sampled edits adding promotional wording
+	PromotionalTerms []string `json:"promotional_terms,omitempty"`
+}
+
+// MassCreation describes the stubs a user created and the largest group of them
+// built on a common boilerplate skeleton
+type MassCreation struct {
+	CreatedPages       int      `json:"created_pages"`       // Main namespace creations in the analyzed sample
+	SampledPages       int      `json:"sampled_pages"`       // Stub creations whose content was compared
+	TemplatePages      []string `json:"template_pages"`      // Stubs sharing the skeleton
+	TemplateSimilarity float64  `json:"template_similarity"` // Average pairwise skeleton similarity within TemplatePages
+	AverageStubBytes   int      `json:"average_stub_bytes"`
+}
+
+// LowProfileCampaign describes a user's many small, spread-out edits to their
+// most edited article
+type LowProfileCampaign struct {
+	PageTitle     string    `json:"page_title"`
+	Edits         int       `json:"edits"`
+	Share         float64   `json:"share"` // Of the analyzed sample
+	FirstEdit     time.Time `json:"first_edit"`
+	LastEdit      time.Time `json:"last_edit"`
+	SpanDays      int       `json:"span_days"`
+	MaxDailyEdits int       `json:"max_daily_edits"`
+	AverageBytes  int       `json:"average_bytes"` // Bytes added or removed per edit
+	ChangedBytes  int       `json:"changed_bytes"` // Bytes added or removed over all the edits
+	NetBytes      int       `json:"net_bytes"`
+	RevertedEdits int       `json:"reverted_edits"`
+	Disputed      bool      `json:"disputed"`    // Found disputed by the top pages conflict scan
+	Contentious   bool      `json:"contentious"` // Disputed, or some of the edits were reverted
+}
+
+// EditConcentration measures the share of a user's edits falling within their
+// densest calendar window
+type EditConcentration struct {
+	WindowDays  int       `json:"window_days"`
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+	WindowEdits int       `json:"window_edits"`
+	TotalEdits  int       `json:"total_edits"`
+	Ratio       float64   `json:"ratio"`
+}
+
+// CosmeticEditStats measures edits that leave the rendered text unchanged
+type CosmeticEditStats struct {
+	SampledEdits  int     `json:"sampled_edits"`
+	CosmeticEdits int     `json:"cosmetic_edits"`
+	CosmeticRatio float64 `json:"cosmetic_ratio"`
 }
 
 type BlockInfo struct {
@@ -68,6 +164,27 @@ type PageEditSummary struct {
 	FirstEdit     time.Time `json:"first_edit"`
 	LastEdit      time.Time `json:"last_edit"`
 	TotalSizeDiff int       `json:"total_size_diff"`
+
+	Controversy *PageControversy `json:"controversy,omitempty"` // Set when the top pages conflict scan ran
+}
+
+// PageControversy is the outcome of a quick conflict scan of a page's latest
+// revisions
+type PageControversy struct {
+	ScannedRevisions int      `json:"scanned_revisions"`
+	Reverts          int      `json:"reverts"`
+	Reverters        []string `json:"reverters"`
+	StabilityScore   float64  `json:"stability_score"`   // Share of scanned revisions that are not reverts
+	ControversyScore float64  `json:"controversy_score"` // 0 to 1, the page analysis's conflict formula
+	Disputed         bool     `json:"disputed"`
+}
+
+// TopicCluster groups topically-related pages edited by a user
+type TopicCluster struct {
+	Pages       []string `json:"pages"`
+	SharedTerms []string `json:"shared_terms"`
+	EditCount   int      `json:"edit_count"`
+	EditRatio   float64  `json:"edit_ratio"` // share of the user's main namespace edits
 }
 
 type ActivityStats struct {
@@ -76,7 +193,8 @@ type ActivityStats struct {
 	LongestStreak      int             `json:"longest_streak_days"`
 	MostActiveHour     int             `json:"most_active_hour"`
 	MostActiveDay      string          `json:"most_active_day"`
-	NamespaceDistrib   map[string]int  `json:"namespace_distribution"`
+	NamespaceDistrib   map[string]int  `json:"namespace_distribution"` // Keyed by localized namespace name
+	NamespaceCounts    map[int]int     `json:"namespace_counts"`       // Keyed by namespace ID
 	RecentActivity     []DailyActivity `json:"recent_activity"`
 }
 
@@ -98,6 +216,61 @@ type WikiUserInfo struct {
 	BlockedBy      string   `json:"blockedby,omitempty"`
 }
 
+// AdversaryReport ranks the users who revert a user most, from the revoked
+// contributions found in the analyzed sample
+type AdversaryReport struct {
+	Username        string      `json:"username"`
+	SampleSize      int         `json:"sample_size"`
+	RevokedCount    int         `json:"revoked_count"`
+	Adversaries     []Adversary `json:"adversaries"`
+	ReciprocalCount int         `json:"reciprocal_count"` // Adversaries the user reverts back
+	Language        string      `json:"language"`
+	RetrievedAt     time.Time   `json:"retrieved_at"`
+}
+
+// UserFootprint is a user-centric cross-page view: the user's profile summary
+// and their role on each of the pages they edit most
+type UserFootprint struct {
+	Username       string            `json:"username"`
+	EditCount      int               `json:"edit_count"`
+	SampleSize     int               `json:"sample_size"`
+	SuspicionScore int               `json:"suspicion_score"`
+	SuspicionFlags []string          `json:"suspicion_flags"`
+	Pages          []PageInvolvement `json:"pages"`            // Most edited pages first
+	Errors         []string          `json:"errors,omitempty"` // Pages whose analysis failed
+	Language       string            `json:"language"`
+	RetrievedAt    time.Time         `json:"retrieved_at"`
+}
+
+// PageInvolvement describes a user's role on one page, over the page revisions
+// analyzed
+type PageInvolvement struct {
+	PageTitle          string  `json:"page_title"`
+	SampleEdits        int     `json:"sample_edits"`   // Edits to the page in the user's contribution sample
+	UserRevisions      int     `json:"user_revisions"` // User's revisions among the page revisions analyzed
+	PageRevisions      int     `json:"page_revisions"` // Page revisions analyzed
+	ContributorShare   float64 `json:"contributor_share"`
+	ContributorRank    int     `json:"contributor_rank,omitempty"` // Position among the page's top contributors, 0 when not listed
+	RevertsMade        int     `json:"reverts_made"`               // Others' edits the user reverted
+	RevertsReceived    int     `json:"reverts_received"`           // User's edits reverted by others
+	ConflictingUser    bool    `json:"conflicting_user,omitempty"` // Among the page's conflicting users
+	EditWarPeriods     int     `json:"edit_war_periods,omitempty"` // Edit war periods the user took part in
+	PageOwner          bool    `json:"page_owner,omitempty"`       // The editor reverting most others' changes
+	PageSuspicionScore int     `json:"page_suspicion_score"`
+	ControversyScore   float64 `json:"controversy_score"`
+}
+
+// Adversary is a user reverting the profiled user's edits
+type Adversary struct {
+	Username     string    `json:"username"`
+	RevertCount  int       `json:"revert_count"`  // Profiled user's edits reverted by this user
+	RevertRatio  float64   `json:"revert_ratio"`  // Share of the profiled user's revoked contributions
+	RevertedBack int       `json:"reverted_back"` // This user's edits reverted by the profiled user
+	Reciprocal   bool      `json:"reciprocal"`
+	Pages        []string  `json:"pages"`
+	LastRevert   time.Time `json:"last_revert"`
+}
+
 type WikiContribution struct {
 	UserID    int      `json:"userid"`
 	User      string   `json:"user"`
@@ -113,6 +286,15 @@ type WikiContribution struct {
 	Minor     string   `json:"minor,omitempty"`
 	Top       string   `json:"top,omitempty"`
 	Tags      []string `json:"tags,omitempty"`
+	Deleted   bool     `json:"deleted,omitempty"` // From deleted revisions (admin access)
+}
+
+// WikiRenameEvent is a renameuser log entry: an account renamed from OldUser to NewUser
+type WikiRenameEvent struct {
+	OldUser   string `json:"olduser"`
+	NewUser   string `json:"newuser"`
+	User      string `json:"user"` // Who performed the rename
+	Timestamp string `json:"timestamp"`
 }
 
 type WikiResponse struct {
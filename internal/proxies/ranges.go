@@ -0,0 +1,150 @@
+// internal/proxies/ranges.go
+package proxies
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Range kinds
+const (
+	KindHosting = "hosting"
+	KindVPN     = "vpn"
+	KindProxy   = "proxy"
+)
+
+//go:embed ranges.yaml
+var defaultDataset []byte
+
+// Match is the proxy range an IP address belongs to
+type Match struct {
+	Range    string `yaml:"range"`
+	Kind     string `yaml:"kind"`
+	Provider string `yaml:"provider,omitempty"`
+}
+
+// Resolver tells whether an IP address belongs to a hosting, VPN or open proxy
+// range. Resolve returns nil for addresses outside such ranges (residential,
+// mobile) or that are not IP addresses at all.
+type Resolver interface {
+	Resolve(ip string) (*Match, error)
+}
+
+// Fingerprinter is a Resolver whose answers can be identified, so that the
+// profiles built with it can be cached. Profiles built with other resolvers are
+// never cached.
+type Fingerprinter interface {
+	Fingerprint() string
+}
+
+// rangeEntry is a parsed line of a range dataset
+type rangeEntry struct {
+	prefix netip.Prefix
+	match  Match
+}
+
+// RangeList resolves IP addresses against a list of address ranges
+type RangeList struct {
+	entries []rangeEntry // Most specific range first
+}
+
+// NewRangeList creates a range list loaded with the default dataset
+func NewRangeList() *RangeList {
+	list := &RangeList{}
+	if err := list.load(defaultDataset); err != nil {
+		panic(fmt.Sprintf("invalid embedded proxy range dataset: %v", err))
+	}
+	return list
+}
+
+// LoadFile merges a YAML range dataset (range, kind, provider entries) into the list
+func (rl *RangeList) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read proxy range list: %w", err)
+	}
+	if err := rl.load(data); err != nil {
+		return fmt.Errorf("invalid proxy range list %s: %w", path, err)
+	}
+	return nil
+}
+
+// load merges a YAML dataset into the list
+func (rl *RangeList) load(data []byte) error {
+	var entries []Match
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := rl.Add(entry.Range, entry.Kind, entry.Provider); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add registers a range in CIDR notation, or a single address
+func (rl *RangeList) Add(cidr, kind, provider string) error {
+	cidr = strings.TrimSpace(cidr)
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		addr, addrErr := netip.ParseAddr(cidr)
+		if addrErr != nil {
+			return fmt.Errorf("invalid range %q: %w", cidr, err)
+		}
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	switch kind {
+	case KindHosting, KindVPN, KindProxy:
+	default:
+		return fmt.Errorf("unknown range kind %q for %s (expected hosting, vpn or proxy)", kind, cidr)
+	}
+
+	prefix = prefix.Masked()
+	rl.entries = append(rl.entries, rangeEntry{
+		prefix: prefix,
+		match:  Match{Range: prefix.String(), Kind: kind, Provider: strings.TrimSpace(provider)},
+	})
+	sort.SliceStable(rl.entries, func(i, j int) bool {
+		return rl.entries[i].prefix.Bits() > rl.entries[j].prefix.Bits()
+	})
+	return nil
+}
+
+// Fingerprint identifies the list's ranges: two lists resolving every address
+// alike have the same fingerprint
+func (rl *RangeList) Fingerprint() string {
+	hash := sha256.New()
+	for _, entry := range rl.entries {
+		fmt.Fprintf(hash, "%s\x00%s\x00%s\n", entry.match.Range, entry.match.Kind, entry.match.Provider)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Resolve returns the most specific range containing ip, or nil
+func (rl *RangeList) Resolve(ip string) (*Match, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return nil, nil
+	}
+	addr = addr.Unmap()
+
+	for _, entry := range rl.entries {
+		if entry.prefix.Contains(addr) {
+			match := entry.match
+			return &match, nil
+		}
+	}
+	return nil, nil
+}
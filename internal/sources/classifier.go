@@ -0,0 +1,147 @@
+// internal/sources/classifier.go
+package sources
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Reliability levels, from the perennial sources classification
+const (
+	LevelReliable     = "reliable"
+	LevelQuestionable = "questionable"
+	LevelUnreliable   = "unreliable"
+	LevelDeprecated   = "deprecated"
+)
+
+//go:embed domains.yaml
+var defaultDataset []byte
+
+// Classification is the reliability assessment of a domain
+type Classification struct {
+	Level  string `yaml:"level"`
+	Reason string `yaml:"reason,omitempty"`
+}
+
+// domainEntry is one line of a reliability dataset
+type domainEntry struct {
+	Domain         string `yaml:"domain"`
+	Classification `yaml:",inline"`
+}
+
+// DomainClassifier rates source domains against a reliability dataset
+type DomainClassifier struct {
+	domains map[string]Classification
+}
+
+// NewDomainClassifier creates a classifier loaded with the default dataset
+func NewDomainClassifier() *DomainClassifier {
+	classifier := &DomainClassifier{domains: make(map[string]Classification)}
+	if err := classifier.load(defaultDataset); err != nil {
+		panic(fmt.Sprintf("invalid embedded source reliability dataset: %v", err))
+	}
+	return classifier
+}
+
+// load merges a YAML dataset into the classifier
+func (dc *DomainClassifier) load(data []byte) error {
+	var entries []domainEntry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := dc.set(entry.Domain, entry.Classification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Override sets the level of a domain, replacing the default dataset entry
+func (dc *DomainClassifier) Override(domain, level string) error {
+	return dc.set(domain, Classification{Level: strings.ToLower(strings.TrimSpace(level))})
+}
+
+func (dc *DomainClassifier) set(domain string, classification Classification) error {
+	domain = normalizeDomain(domain)
+	if domain == "" {
+		return fmt.Errorf("empty domain in source reliability dataset")
+	}
+
+	switch classification.Level {
+	case LevelReliable, LevelQuestionable, LevelUnreliable, LevelDeprecated:
+	default:
+		return fmt.Errorf("unknown reliability level %q for %s (expected reliable, questionable, unreliable or deprecated)", classification.Level, domain)
+	}
+
+	dc.domains[domain] = classification
+	return nil
+}
+
+// Classify returns the reliability of a domain, looking up its parent domains
+// (news.bbc.co.uk, then bbc.co.uk...) down to the top-level domain. The bool is
+// false for domains the dataset does not cover.
+func (dc *DomainClassifier) Classify(domain string) (Classification, bool) {
+	domain = normalizeDomain(domain)
+	for domain != "" {
+		if classification, exists := dc.domains[domain]; exists {
+			if classification.Reason == "" {
+				classification.Reason = levelReason(classification.Level)
+			}
+			return classification, true
+		}
+
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			break
+		}
+		domain = domain[dot+1:]
+	}
+	return Classification{}, false
+}
+
+// Fingerprint identifies the classifier's dataset: two classifiers rating every
+// domain alike have the same fingerprint
+func (dc *DomainClassifier) Fingerprint() string {
+	domains := make([]string, 0, len(dc.domains))
+	for domain := range dc.domains {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	hash := sha256.New()
+	for _, domain := range domains {
+		classification := dc.domains[domain]
+		fmt.Fprintf(hash, "%s\x00%s\x00%s\n", domain, classification.Level, classification.Reason)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// normalizeDomain lowercases a domain and strips the www. prefix
+func normalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	return strings.TrimPrefix(domain, "www.")
+}
+
+// levelReason describes a level for entries that carry no specific reason
+func levelReason(level string) string {
+	switch level {
+	case LevelReliable:
+		return "Generally considered reliable"
+	case LevelUnreliable:
+		return "Generally considered unreliable"
+	case LevelQuestionable:
+		return "Reliability depends on context"
+	case LevelDeprecated:
+		return "Deprecated source"
+	default:
+		return "Unknown reliability level"
+	}
+}
@@ -0,0 +1,215 @@
+// internal/tui/model.go
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Item is one selectable line of a panel, with the text shown when drilling into it
+type Item struct {
+	Label  string
+	Detail string
+}
+
+// Panel is a named, navigable list of items
+type Panel struct {
+	Name  string
+	Items []Item
+}
+
+// Model is the terminal UI state: a set of panels, the selected panel and item
+// in each, and whether the detail view of the selected item is open.
+// State transitions are plain methods so they can be driven without a terminal.
+type Model struct {
+	Title      string
+	Panels     []Panel
+	Active     int
+	Cursors    []int
+	ShowDetail bool
+	Height     int
+	Quitting   bool
+}
+
+// NewModel creates a view-model over the given panels
+func NewModel(title string, panels []Panel) Model {
+	return Model{
+		Title:   title,
+		Panels:  panels,
+		Cursors: make([]int, len(panels)),
+		Height:  24,
+	}
+}
+
+// NextPanel selects the next panel, wrapping around
+func (m *Model) NextPanel() {
+	if len(m.Panels) == 0 {
+		return
+	}
+	m.Active = (m.Active + 1) % len(m.Panels)
+	m.ShowDetail = false
+}
+
+// PreviousPanel selects the previous panel, wrapping around
+func (m *Model) PreviousPanel() {
+	if len(m.Panels) == 0 {
+		return
+	}
+	m.Active = (m.Active - 1 + len(m.Panels)) % len(m.Panels)
+	m.ShowDetail = false
+}
+
+// MoveCursor moves the selection in the active panel by delta, clamped to its items
+func (m *Model) MoveCursor(delta int) {
+	if m.ShowDetail || len(m.Panels) == 0 {
+		return
+	}
+	count := len(m.Panels[m.Active].Items)
+	if count == 0 {
+		return
+	}
+
+	cursor := m.Cursors[m.Active] + delta
+	if cursor < 0 {
+		cursor = 0
+	}
+	if cursor >= count {
+		cursor = count - 1
+	}
+	m.Cursors[m.Active] = cursor
+}
+
+// OpenDetail drills into the selected item
+func (m *Model) OpenDetail() {
+	if selected := m.Selected(); selected != nil {
+		m.ShowDetail = true
+	}
+}
+
+// CloseDetail returns from the detail view to the list
+func (m *Model) CloseDetail() {
+	m.ShowDetail = false
+}
+
+// Selected returns the selected item of the active panel, or nil if it is empty
+func (m *Model) Selected() *Item {
+	if len(m.Panels) == 0 {
+		return nil
+	}
+	panel := m.Panels[m.Active]
+	if len(panel.Items) == 0 {
+		return nil
+	}
+	return &panel.Items[m.Cursors[m.Active]]
+}
+
+// Init implements tea.Model
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model: keyboard input is mapped to state transitions
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.Height = msg.Height
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			m.Quitting = true
+			return m, tea.Quit
+		case "tab", "right", "l":
+			m.NextPanel()
+		case "shift+tab", "left", "h":
+			m.PreviousPanel()
+		case "up", "k":
+			m.MoveCursor(-1)
+		case "down", "j":
+			m.MoveCursor(1)
+		case "pgup":
+			m.MoveCursor(-10)
+		case "pgdown":
+			m.MoveCursor(10)
+		case "enter":
+			m.OpenDetail()
+		case "esc", "backspace":
+			m.CloseDetail()
+		}
+	}
+
+	return m, nil
+}
+
+// View implements tea.Model
+func (m Model) View() string {
+	if m.Quitting {
+		return ""
+	}
+
+	var output strings.Builder
+
+	// Header and panel tabs
+	output.WriteString(m.Title + "\n")
+	for i, panel := range m.Panels {
+		name := fmt.Sprintf(" %s (%d) ", panel.Name, len(panel.Items))
+		if i == m.Active {
+			name = "[" + strings.TrimSpace(name) + "]"
+		}
+		output.WriteString(name + " ")
+	}
+	output.WriteString("\n" + strings.Repeat("─", 60) + "\n")
+
+	// Body: detail of the selected item, or the visible window of the list
+	bodyHeight := m.Height - 5
+	if bodyHeight < 3 {
+		bodyHeight = 3
+	}
+
+	if m.ShowDetail {
+		if selected := m.Selected(); selected != nil {
+			output.WriteString(selected.Label + "\n\n")
+			output.WriteString(selected.Detail + "\n")
+		}
+	} else if len(m.Panels) > 0 {
+		items := m.Panels[m.Active].Items
+		cursor := m.Cursors[m.Active]
+
+		if len(items) == 0 {
+			output.WriteString("  (nothing to show)\n")
+		}
+
+		start := 0
+		if cursor >= bodyHeight {
+			start = cursor - bodyHeight + 1
+		}
+		for i := start; i < len(items) && i < start+bodyHeight; i++ {
+			marker := "  "
+			if i == cursor {
+				marker = "❯ "
+			}
+			output.WriteString(marker + items[i].Label + "\n")
+		}
+	}
+
+	// Footer
+	output.WriteString(strings.Repeat("─", 60) + "\n")
+	if m.ShowDetail {
+		output.WriteString("esc: back • tab: next panel • q: quit")
+	} else {
+		output.WriteString("↑/↓: select • enter: details • tab/←/→: panels • q: quit")
+	}
+
+	return output.String()
+}
+
+// Run starts the terminal UI and blocks until the user quits
+func Run(model Model) error {
+	program := tea.NewProgram(model, tea.WithAltScreen())
+	if _, err := program.Run(); err != nil {
+		return fmt.Errorf("terminal UI error: %w", err)
+	}
+	return nil
+}
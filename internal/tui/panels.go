@@ -0,0 +1,203 @@
+// internal/tui/panels.go
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/formatter"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+)
+
+// timestampLayout is the absolute layout of timestamps. List lines keep it in
+// their first column and append the relative time, when set, at the end.
+const timestampLayout = "2006-01-02 15:04"
+
+// NewUserModel builds the terminal UI for a user profile
+func NewUserModel(profile *models.UserProfile) Model {
+	summary := []Item{
+		{Label: fmt.Sprintf("Suspicion score: %d/100", profile.SuspicionScore), Detail: strings.Join(flagDescriptions("user", profile.SuspicionFlags), "\n")},
+		{Label: fmt.Sprintf("Edit count: %d", profile.EditCount), Detail: fmt.Sprintf("User ID: %d\nGroups: %s", profile.UserID, strings.Join(profile.Groups, ", "))},
+		{Label: fmt.Sprintf("Revoked contributions: %d (%.1f%% of %d sampled)", profile.RevokedCount, profile.RevokedRatio*100, profile.SampleSize), Detail: revertedByDetail(profile.RevertedByUsers)},
+	}
+	if profile.RegistrationDate != nil {
+		summary = append(summary, Item{Label: "Registered: " + formatter.FormatTimestamp(*profile.RegistrationDate, timestampLayout)})
+	}
+
+	var topPages []Item
+	for _, page := range profile.TopPages {
+		topPages = append(topPages, Item{
+			Label: fmt.Sprintf("%-40s %d edits", formatter.TruncateString(page.PageTitle, 40), page.EditCount),
+			Detail: fmt.Sprintf("First edit: %s\nLast edit:  %s\nSize change: %+d",
+				formatter.FormatTimestamp(page.FirstEdit, timestampLayout), formatter.FormatTimestamp(page.LastEdit, timestampLayout), page.TotalSizeDiff),
+		})
+	}
+
+	var timeline []Item
+	for _, contrib := range profile.RecentContribs {
+		marker := ""
+		if contrib.IsRevoked {
+			marker = " [reverted]"
+		}
+		timeline = append(timeline, Item{
+			Label: fmt.Sprintf("%s  %-35s %+6d%s%s", contrib.Timestamp.Format(timestampLayout), formatter.TruncateString(contrib.PageTitle, 35), contrib.SizeDiff, marker, formatter.TimestampSuffix(contrib.Timestamp)),
+			Detail: fmt.Sprintf("Revision: %d\nPage: %s\nSize change: %+d\nMinor: %t\nComment: %s",
+				contrib.RevID, contrib.PageTitle, contrib.SizeDiff, contrib.IsMinor, contrib.Comment),
+		})
+	}
+
+	var revoked []Item
+	for _, revokedContrib := range profile.RevokedContribs {
+		revoked = append(revoked, Item{
+			Label: fmt.Sprintf("%s  %-35s by %s%s", revokedContrib.RevokedAt.Format(timestampLayout), formatter.TruncateString(revokedContrib.PageTitle, 35), revokedContrib.RevokedBy, formatter.TimestampSuffix(revokedContrib.RevokedAt)),
+			Detail: fmt.Sprintf("Revision: %d\nRevert type: %s\nRevert comment: %s",
+				revokedContrib.OriginalContrib.RevID, revokedContrib.RevertType, revokedContrib.RevertComment),
+		})
+	}
+
+	return NewModel("👤 "+profile.Username+" ("+profile.Language+".wikipedia.org)", []Panel{
+		{Name: "Summary", Items: summary},
+		{Name: "Flags", Items: flagItems("user", profile.SuspicionFlags)},
+		{Name: "Top pages", Items: topPages},
+		{Name: "Timeline", Items: timeline},
+		{Name: "Reverted", Items: revoked},
+	})
+}
+
+// NewPageModel builds the terminal UI for a page profile
+func NewPageModel(profile *models.PageProfile) Model {
+	conflicts := profile.ConflictStats
+	summary := []Item{
+		{Label: fmt.Sprintf("Suspicion score: %d/100", profile.SuspicionScore), Detail: strings.Join(flagDescriptions("page", profile.SuspicionFlags), "\n")},
+		{Label: fmt.Sprintf("Revisions analyzed: %d", len(profile.RecentRevisions)), Detail: fmt.Sprintf("Page size: %d bytes\nLast modified: %s", profile.PageSize, formatter.FormatTimestamp(profile.LastModified, timestampLayout))},
+		{Label: fmt.Sprintf("Reversions: %d (controversy %.2f)", conflicts.ReversionsCount, conflicts.ControversyScore), Detail: "Conflicting users: " + strings.Join(conflicts.ConflictingUsers, ", ")},
+	}
+
+	var contributors []Item
+	for _, contributor := range profile.Contributors {
+		contributors = append(contributors, Item{
+			Label: fmt.Sprintf("%-30s %4d edits  score %3d", formatter.TruncateString(contributor.Username, 30), contributor.EditCount, contributor.SuspicionScore),
+			Detail: fmt.Sprintf("First edit: %s\nLast edit:  %s\nAnonymous: %t\n\n%s",
+				formatter.FormatTimestamp(contributor.FirstEdit, timestampLayout), formatter.FormatTimestamp(contributor.LastEdit, timestampLayout), contributor.IsAnonymous,
+				strings.Join(flagDescriptions("contributor", contributor.SuspicionFlags), "\n")),
+		})
+	}
+
+	var timeline []Item
+	for _, revision := range profile.RecentRevisions {
+		marker := ""
+		if revision.IsRevert {
+			marker = " [revert]"
+		}
+		timeline = append(timeline, Item{
+			Label: fmt.Sprintf("%s  %-25s %+6d%s%s", revision.Timestamp.Format(timestampLayout), formatter.TruncateString(revision.Username, 25), revision.SizeDiff, marker, formatter.TimestampSuffix(revision.Timestamp)),
+			Detail: fmt.Sprintf("Revision: %d (parent %d)\nUser: %s\nSize: %d (%+d)\nMinor: %t\nComment: %s",
+				revision.RevID, revision.ParentID, revision.Username, revision.NewSize, revision.SizeDiff, revision.IsMinor, revision.Comment),
+		})
+	}
+
+	var editWars []Item
+	for _, period := range conflicts.EditWarPeriods {
+		detail := "Participants: " + strings.Join(period.Participants, ", ")
+		for _, participant := range period.ParticipantStats {
+			detail += fmt.Sprintf("\n%s: %d edits, %d reverts", participant.Username, participant.Edits, participant.Reverts)
+		}
+		editWars = append(editWars, Item{
+			Label:  fmt.Sprintf("%s → %s  %d revisions", formatter.FormatTimestamp(period.StartTime, timestampLayout), formatter.FormatTimestamp(period.EndTime, timestampLayout), period.RevisionCount),
+			Detail: detail,
+		})
+	}
+
+	return NewModel("📄 "+profile.PageTitle+" ("+profile.Language+".wikipedia.org)", []Panel{
+		{Name: "Summary", Items: summary},
+		{Name: "Flags", Items: flagItems("page", profile.SuspicionFlags)},
+		{Name: "Contributors", Items: contributors},
+		{Name: "Timeline", Items: timeline},
+		{Name: "Edit wars", Items: editWars},
+	})
+}
+
+// NewCrossPageModel builds the terminal UI for a cross-page analysis
+func NewCrossPageModel(analysis *models.CrossPageAnalysis) Model {
+	summary := []Item{
+		{Label: fmt.Sprintf("Overall investigation risk: %d/100", analysis.OverallRisk.Score), Detail: strings.Join(analysis.OverallRisk.Explanation, "\n")},
+		{Label: fmt.Sprintf("Suspicion score: %d/100", analysis.SuspicionScore), Detail: strings.Join(flagDescriptions("cross_page", analysis.SuspicionFlags), "\n")},
+		{Label: fmt.Sprintf("Pages: %d", analysis.TotalPages), Detail: strings.Join(analysis.Pages, "\n")},
+		{Label: fmt.Sprintf("Contributors: %d (%d common)", analysis.TotalContributors, len(analysis.CommonContributors))},
+		{Label: fmt.Sprintf("Coordination score: %.1f", analysis.CoordinatedPatterns.CoordinationScore)},
+	}
+
+	var contributors []Item
+	for _, contributor := range analysis.CommonContributors {
+		var pages []string
+		for _, page := range contributor.PagesEdited {
+			pages = append(pages, fmt.Sprintf("%s (%d edits)", page, contributor.EditsByPage[page]))
+		}
+		contributors = append(contributors, Item{
+			Label: fmt.Sprintf("%-30s %d pages  %4d edits", formatter.TruncateString(contributor.Username, 30), len(contributor.PagesEdited), contributor.TotalEdits),
+			Detail: fmt.Sprintf("Pages:\n  %s\n\nFirst edit: %s\nLast edit:  %s",
+				strings.Join(pages, "\n  "), formatter.FormatTimestamp(contributor.FirstEdit, timestampLayout), formatter.FormatTimestamp(contributor.LastEdit, timestampLayout)),
+		})
+	}
+
+	var pairs []Item
+	for _, pair := range analysis.CoordinatedPatterns.MutualSupportPairs {
+		var events []string
+		for _, event := range pair.SupportEvents {
+			line := fmt.Sprintf("%s  %s defended %s on %s (%d min)",
+				formatter.FormatTimestamp(event.Timestamp, timestampLayout), event.DefenderUser, event.SupportedUser, event.PageTitle, event.ReactionTime)
+			if event.RevisionID != 0 {
+				line += "\n    " + client.DiffURL(analysis.Language, event.RevisionID)
+			}
+			events = append(events, line)
+		}
+		pairs = append(pairs, Item{
+			Label:  fmt.Sprintf("%s ⇄ %s  %s", pair.UserA, pair.UserB, pair.SuspicionLevel),
+			Detail: fmt.Sprintf("Support ratio: %.2f\nReciprocity: %.2f\nAverage reaction: %d min\n\n%s", pair.MutualSupportRatio, pair.ReciprocityScore, pair.AverageReactionTime, strings.Join(events, "\n")),
+		})
+	}
+
+	return NewModel("🔗 Cross-page analysis ("+analysis.Language+".wikipedia.org)", []Panel{
+		{Name: "Summary", Items: summary},
+		{Name: "Flags", Items: flagItems("cross_page", analysis.SuspicionFlags)},
+		{Name: "Contributors", Items: contributors},
+		{Name: "Mutual support", Items: pairs},
+	})
+}
+
+// flagItems lists flags with their readable description as detail
+func flagItems(source string, flags []string) []Item {
+	var items []Item
+	for _, flag := range flags {
+		items = append(items, Item{Label: flag, Detail: formatter.DescribeSuspicionFlag(source, flag)})
+	}
+	return items
+}
+
+// flagDescriptions returns the readable description of each flag
+func flagDescriptions(source string, flags []string) []string {
+	descriptions := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		descriptions = append(descriptions, "• "+formatter.DescribeSuspicionFlag(source, flag))
+	}
+	return descriptions
+}
+
+// revertedByDetail lists who reverts the user most often
+func revertedByDetail(revertedBy map[string]int) string {
+	users := make([]string, 0, len(revertedBy))
+	for user := range revertedBy {
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return revertedBy[users[i]] > revertedBy[users[j]]
+	})
+
+	lines := make([]string, 0, len(users))
+	for _, user := range users {
+		lines = append(lines, fmt.Sprintf("%s: %d", user, revertedBy[user]))
+	}
+	return "Reverted by:\n" + strings.Join(lines, "\n")
+}
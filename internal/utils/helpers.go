@@ -1,6 +1,12 @@
 // internal/utils/helpers.go
 package utils
 
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
 // SetOrDefault returns the value if it's not zero, otherwise returns the default
 func SetOrDefault[T comparable](value, defaultValue T) T {
 	var zero T
@@ -74,3 +80,35 @@ func CalculatePercentage(part, total int) float64 {
 	}
 	return (float64(part) / float64(total)) * 100.0
 }
+
+// NormalizeUsername returns the canonical form of a Wikipedia username:
+// underscores become spaces, repeated spaces collapse and the first letter is
+// uppercased, so "jean_Dupont" and "Jean Dupont" compare equal
+func NormalizeUsername(username string) string {
+	name := strings.Join(strings.Fields(strings.ReplaceAll(username, "_", " ")), " ")
+	if name == "" {
+		return name
+	}
+
+	first, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(first)) + name[size:]
+}
+
+// SplitEditSummary separates the "/* Section */" marker MediaWiki puts at the
+// start of section edit summaries from the text the editor wrote:
+// "/* History */ add dates" gives "History" and "add dates". A summary without
+// a marker is returned whole, with an empty section.
+func SplitEditSummary(comment string) (section, summary string) {
+	start := strings.Index(comment, "/*")
+	if start < 0 {
+		return "", comment
+	}
+	length := strings.Index(comment[start+2:], "*/")
+	if length < 0 {
+		return "", comment
+	}
+
+	section = strings.TrimSpace(comment[start+2 : start+2+length])
+	summary = strings.Join(strings.Fields(comment[:start]+" "+comment[start+2+length+2:]), " ")
+	return section, summary
+}
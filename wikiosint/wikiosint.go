@@ -0,0 +1,410 @@
+// wikiosint/wikiosint.go
+
+// Package wikiosint exposes the WikiOSINT analyses to other Go programs: user
+// profiles, adversaries and footprints, page profiles, contributions and their
+// scans, investigations and cross-page coordination, returned as the structs the
+// CLI formats.
+//
+//	profile, err := wikiosint.AnalyzePage(wikiosint.Config{Language: "fr"}, "Paris", wikiosint.PageOptions{})
+//
+// A Client keeps its session, capability probe and call budget across analyses:
+// create one with NewClient when running several of them against the same wiki.
+// The analyzers print progress messages and warnings to the standard output:
+// SetLogOutput redirects or silences them.
+package wikiosint
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/intMeric/wikipedia-analyser/internal/analyzer"
+	"github.com/intMeric/wikipedia-analyser/internal/client"
+	"github.com/intMeric/wikipedia-analyser/internal/models"
+	"github.com/intMeric/wikipedia-analyser/internal/proxies"
+	"github.com/intMeric/wikipedia-analyser/internal/sources"
+)
+
+// Config selects the wiki (Language, or APIURL for another MediaWiki wiki) and the
+// client settings: login, maxlag, call budget. The zero value is an anonymous
+// English Wikipedia client.
+type Config = client.Config
+
+// Analysis results
+type (
+	UserProfile         = models.UserProfile
+	AdversaryReport     = models.AdversaryReport
+	UserFootprint       = models.UserFootprint
+	PageProfile         = models.PageProfile
+	ContributionProfile = models.ContributionProfile
+	ScanResult          = analyzer.SuspiciousScanResult
+	InvestigationReport = models.InvestigationReport
+	CrossPageAnalysis   = models.CrossPageAnalysis
+	CallEstimate        = models.CallEstimate
+	Revision            = models.WikiRevision
+)
+
+// Analysis options. Zero values select the defaults.
+type (
+	PageOptions          = analyzer.PageAnalysisOptions
+	ContributionOptions  = analyzer.ContributionAnalysisOptions
+	ScanOptions          = analyzer.SuspiciousScanOptions
+	FootprintOptions     = analyzer.FootprintOptions
+	InvestigationOptions = analyzer.InvestigationOptions
+	CrossPageOptions     = models.CrossPageAnalysisOptions
+	RevokedOptions       = analyzer.RevokedAnalysisConfig
+)
+
+// Extension points of the page analysis (PageOptions.DomainClassifier and
+// PageOptions.ProxyResolver)
+type (
+	SourceClassifier = sources.DomainClassifier
+	ProxyResolver    = proxies.Resolver
+	ProxyMatch       = proxies.Match
+	ProxyRangeList   = proxies.RangeList
+)
+
+// NewSourceClassifier creates a source reliability classifier loaded with the
+// built-in perennial sources dataset
+func NewSourceClassifier() *SourceClassifier {
+	return sources.NewDomainClassifier()
+}
+
+// NewProxyRangeList creates a proxy resolver loaded with the built-in hosting and
+// VPN ranges
+func NewProxyRangeList() *ProxyRangeList {
+	return proxies.NewRangeList()
+}
+
+// DefaultRevokedOptions returns the revoked contributions settings of user profiles
+func DefaultRevokedOptions() RevokedOptions {
+	return analyzer.GetDefaultRevokedAnalysisConfig()
+}
+
+// ErrNoData is returned when the API answered but there was nothing to analyze
+// (e.g. a user without contributions)
+var ErrNoData = analyzer.ErrNoData
+
+// ErrDryRun is returned by every request of a client created with Config.DryRun
+var ErrDryRun = client.ErrDryRun
+
+// SetLogOutput redirects the progress messages and warnings of the analyses to
+// w, nil to silence them. They go to the standard output by default.
+func SetLogOutput(w io.Writer) {
+	analyzer.SetLogOutput(w)
+}
+
+// UserOptions configures a user profile
+type UserOptions struct {
+	IncludeDeleted bool  // Fold in deleted contributions (requires the deletedhistory right)
+	ScanTopPages   bool  // Quick conflict scan of the most edited pages
+	Namespaces     []int // Only analyze contributions in these namespace IDs
+
+	// Revoked contributions analysis, nil for the defaults
+	Revoked     *RevokedOptions
+	SkipRevoked bool // Skip the revoked contributions analysis altogether
+}
+
+// ContributionsOptions configures the analysis of a set of revisions
+type ContributionsOptions struct {
+	ContributionOptions
+
+	// OnRevisionAnalyzed is called after each revision, with the error of a failed
+	// analysis
+	OnRevisionAnalyzed func(index, total, revisionID int, profile *ContributionProfile, err error)
+}
+
+// PagesOptions configures a cross-page coordination analysis
+type PagesOptions struct {
+	CrossPageOptions
+
+	// DomainClassifier rates reference domains (default: the embedded perennial sources dataset)
+	DomainClassifier *SourceClassifier
+
+	// Page profiles are cached in CacheDir for CacheTTL when set
+	CacheDir string
+	CacheTTL time.Duration
+
+	// OnPageAnalyzed is called after each page profile is built, or read from the cache
+	OnPageAnalyzed func(index, total int, profile *PageProfile)
+}
+
+// Client runs analyses against one wiki
+type Client struct {
+	wiki *client.WikipediaClient
+}
+
+// NewClient creates a client configured from config, logging in when credentials
+// are given
+func NewClient(config Config) (*Client, error) {
+	wiki, err := client.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{wiki: wiki}, nil
+}
+
+// Language returns the language edition the client analyzes
+func (c *Client) Language() string {
+	return c.wiki.Language()
+}
+
+// APICalls returns the number of API requests sent so far, retries included
+func (c *Client) APICalls() int {
+	return c.wiki.APICalls()
+}
+
+// BudgetExhausted reports whether Config.MaxAPICalls cut an analysis short:
+// results are then partial
+func (c *Client) BudgetExhausted() bool {
+	return c.wiki.BudgetExhausted()
+}
+
+// AnalyzeUser builds the profile of a user
+func (c *Client) AnalyzeUser(username string, options UserOptions) (*UserProfile, error) {
+	userAnalyzer, revoked := c.userAnalyzer(options)
+	return userAnalyzer.GetUserProfileWithConfig(username, revoked)
+}
+
+// EstimateUser estimates the API requests of AnalyzeUser, without sending any
+func (c *Client) EstimateUser(options UserOptions) (CallEstimate, error) {
+	userAnalyzer, revoked := c.userAnalyzer(options)
+	if revoked != nil {
+		if err := revoked.Validate(); err != nil {
+			return CallEstimate{}, fmt.Errorf("invalid revoked analysis configuration: %w", err)
+		}
+	}
+	return userAnalyzer.EstimateProfileCalls(revoked), nil
+}
+
+// AnalyzeAdversaries ranks the users reverting username, from the revoked
+// contributions analysis configured by options
+func (c *Client) AnalyzeAdversaries(username string, options UserOptions) (*AdversaryReport, error) {
+	options.SkipRevoked = false
+	userAnalyzer, revoked := c.userAnalyzer(options)
+	return userAnalyzer.GetAdversaries(username, *revoked)
+}
+
+// AnalyzeFootprint builds the profile of a user and analyzes their most edited pages
+func (c *Client) AnalyzeFootprint(username string, options FootprintOptions) (*UserFootprint, error) {
+	if options.MaxPages < 0 {
+		return nil, fmt.Errorf("invalid footprint options: number of pages cannot be negative (got %d)", options.MaxPages)
+	}
+	if err := validatePageLimits(options.NumberOfPageRevisions, options.NumberOfDaysHistory, options.NumberOfContributors); err != nil {
+		return nil, fmt.Errorf("invalid footprint options: %w", err)
+	}
+	return analyzer.NewFootprintAnalyzer(c.wiki, options).GetFootprint(username)
+}
+
+// userAnalyzer creates a user analyzer and the revoked analysis configuration of options
+func (c *Client) userAnalyzer(options UserOptions) (*analyzer.UserAnalyzer, *analyzer.RevokedAnalysisConfig) {
+	userAnalyzer := analyzer.NewUserAnalyzer(c.wiki)
+	userAnalyzer.SetIncludeDeleted(options.IncludeDeleted)
+	userAnalyzer.SetSkipRevoked(options.SkipRevoked)
+	userAnalyzer.SetNamespaces(options.Namespaces)
+	userAnalyzer.SetScanTopPages(options.ScanTopPages)
+
+	if options.SkipRevoked {
+		return userAnalyzer, nil
+	}
+	revoked := analyzer.GetDefaultRevokedAnalysisConfig()
+	if options.Revoked != nil {
+		revoked = *options.Revoked
+	}
+	return userAnalyzer, &revoked
+}
+
+// AnalyzePage builds the profile of a page
+func (c *Client) AnalyzePage(title string, options PageOptions) (*PageProfile, error) {
+	pageAnalyzer, err := c.pageAnalyzer(options)
+	if err != nil {
+		return nil, err
+	}
+	return pageAnalyzer.GetPageProfile(title)
+}
+
+// EstimatePage estimates the API requests of AnalyzePage, without sending any
+func (c *Client) EstimatePage(options PageOptions) (CallEstimate, error) {
+	pageAnalyzer, err := c.pageAnalyzer(options)
+	if err != nil {
+		return CallEstimate{}, err
+	}
+	return pageAnalyzer.EstimateProfileCalls(), nil
+}
+
+// pageAnalyzer validates options and creates a page analyzer
+func (c *Client) pageAnalyzer(options PageOptions) (*analyzer.PageAnalyzer, error) {
+	if err := options.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid analysis options: %w", err)
+	}
+	return analyzer.NewPageAnalyzer(c.wiki, options), nil
+}
+
+// AnalyzeContribution analyzes one revision. pageTitle is optional (it is
+// looked up from the revision), unless revisionID is 0: the latest revision
+// of the page is analyzed then.
+func (c *Client) AnalyzeContribution(revisionID int, pageTitle string, options ContributionOptions) (*ContributionProfile, error) {
+	if err := validateDepth(options.AnalysisDepth); err != nil {
+		return nil, err
+	}
+	if revisionID == 0 && pageTitle == "" {
+		return nil, fmt.Errorf("a revision ID or a page title is required")
+	}
+	return analyzer.NewContributionAnalyzer(c.wiki, options).GetContributionProfile(revisionID, pageTitle)
+}
+
+// AnalyzeContributions analyzes revisions of pageTitle in order, sharing the
+// author profiles (and their MaxAuthorProfiles budget) between them. Failed
+// analyses are left out, and reported to OnRevisionAnalyzed.
+func (c *Client) AnalyzeContributions(revisionIDs []int, pageTitle string, options ContributionsOptions) ([]*ContributionProfile, error) {
+	if err := validateDepth(options.AnalysisDepth); err != nil {
+		return nil, err
+	}
+
+	contributionAnalyzer := analyzer.NewContributionAnalyzer(c.wiki, options.ContributionOptions)
+	var profiles []*ContributionProfile
+	for i, revisionID := range revisionIDs {
+		profile, err := contributionAnalyzer.GetContributionProfile(revisionID, pageTitle)
+		if options.OnRevisionAnalyzed != nil {
+			options.OnRevisionAnalyzed(i+1, len(revisionIDs), revisionID, profile, err)
+		}
+		if err == nil {
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles, nil
+}
+
+// ScanContributions analyzes revisions of pageTitle concurrently, keeping those
+// scoring at least the threshold of scan, highest suspicion first
+func (c *Client) ScanContributions(pageTitle string, revisions []Revision, options ContributionOptions, scan ScanOptions) (*ScanResult, error) {
+	if err := validateDepth(options.AnalysisDepth); err != nil {
+		return nil, err
+	}
+	contributionAnalyzer := analyzer.NewContributionAnalyzer(c.wiki, options)
+	return analyzer.ScanSuspiciousContributions(contributionAnalyzer, pageTitle, revisions, scan), nil
+}
+
+// Investigate cross-references a revision, its author and its page
+func (c *Client) Investigate(revisionID int, pageTitle string, options InvestigationOptions) (*InvestigationReport, error) {
+	if err := validateDepth(options.AnalysisDepth); err != nil {
+		return nil, err
+	}
+	if err := validatePageLimits(options.NumberOfPageRevisions, options.NumberOfDaysHistory, options.NumberOfContributors); err != nil {
+		return nil, fmt.Errorf("invalid investigation options: %w", err)
+	}
+	return analyzer.NewInvestigationAnalyzer(c.wiki, options).Investigate(revisionID, pageTitle)
+}
+
+// validatePageLimits rejects the page analysis limits PageOptions would reject
+func validatePageLimits(revisions, days, contributors int) error {
+	return PageOptions{
+		NumberOfPageRevisions: revisions,
+		NumberOfDaysHistory:   days,
+		NumberOfContributors:  contributors,
+	}.Validate()
+}
+
+// validateDepth rejects an unknown contribution analysis depth
+func validateDepth(depth string) error {
+	switch depth {
+	case "", "basic", "standard", "deep":
+		return nil
+	default:
+		return fmt.Errorf("invalid analysis depth: %s (must be: basic, standard, deep)", depth)
+	}
+}
+
+// RecentRevisions returns the latest revisions of a page, newest first
+func (c *Client) RecentRevisions(pageTitle string, limit int) ([]Revision, error) {
+	return c.wiki.GetPageRevisions(pageTitle, limit)
+}
+
+// PageHistory returns the revisions of a page made in the last days, newest first
+func (c *Client) PageHistory(pageTitle string, days int) ([]Revision, error) {
+	return c.wiki.GetPageHistory(pageTitle, days)
+}
+
+// RevisionsSince returns every revision of a page newer than lastSeen, oldest first
+func (c *Client) RevisionsSince(pageTitle string, lastSeen int) ([]Revision, error) {
+	return c.wiki.GetPageRevisionsSince(pageTitle, lastSeen)
+}
+
+// AnalyzePages looks for coordinated editing across pages
+func (c *Client) AnalyzePages(titles []string, options PagesOptions) (*CrossPageAnalysis, error) {
+	crossPageAnalyzer, err := c.crossPageAnalyzer(options)
+	if err != nil {
+		return nil, err
+	}
+	return crossPageAnalyzer.AnalyzePages(titles)
+}
+
+// EstimatePages estimates the API requests of AnalyzePages, without sending any.
+// Pages already in the cache cost nothing.
+func (c *Client) EstimatePages(titles []string, options PagesOptions) (CallEstimate, error) {
+	crossPageAnalyzer, err := c.crossPageAnalyzer(options)
+	if err != nil {
+		return CallEstimate{}, err
+	}
+	return crossPageAnalyzer.EstimateCalls(titles), nil
+}
+
+// crossPageAnalyzer validates options and creates a cross-page analyzer with
+// their cache and progress handler
+func (c *Client) crossPageAnalyzer(options PagesOptions) (*analyzer.CrossPageAnalyzer, error) {
+	if err := options.CrossPageOptions.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cross-page analysis options: %w", err)
+	}
+	crossPageAnalyzer := analyzer.NewCrossPageAnalyzer(c.wiki, options.CrossPageOptions)
+	if options.DomainClassifier != nil {
+		crossPageAnalyzer.SetDomainClassifier(options.DomainClassifier)
+	}
+	if options.CacheDir != "" {
+		cache, err := analyzer.NewProfileCache(options.CacheDir, options.CacheTTL)
+		if err != nil {
+			return nil, err
+		}
+		crossPageAnalyzer.SetProfileCache(cache)
+	}
+	if options.OnPageAnalyzed != nil {
+		crossPageAnalyzer.OnPageAnalyzed(options.OnPageAnalyzed)
+	}
+	return crossPageAnalyzer, nil
+}
+
+// AnalyzeUser builds the profile of a user with a client created from config
+func AnalyzeUser(config Config, username string, options UserOptions) (*UserProfile, error) {
+	c, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return c.AnalyzeUser(username, options)
+}
+
+// AnalyzePage builds the profile of a page with a client created from config
+func AnalyzePage(config Config, title string, options PageOptions) (*PageProfile, error) {
+	c, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return c.AnalyzePage(title, options)
+}
+
+// AnalyzeContribution analyzes one revision with a client created from config
+func AnalyzeContribution(config Config, revisionID int, pageTitle string, options ContributionOptions) (*ContributionProfile, error) {
+	c, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return c.AnalyzeContribution(revisionID, pageTitle, options)
+}
+
+// AnalyzePages looks for coordinated editing across pages with a client created
+// from config
+func AnalyzePages(config Config, titles []string, options PagesOptions) (*CrossPageAnalysis, error) {
+	c, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return c.AnalyzePages(titles, options)
+}